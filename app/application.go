@@ -2,50 +2,306 @@ package app
 
 import (
 	"database/sql"
+	"fmt"
 	"log/slog"
 
 	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/contractdocs"
 	empPostgres "github.com/kevinsoras/employee-management/contexts/employee/infrastructure/datasource/postgres"
 	repository "github.com/kevinsoras/employee-management/contexts/employee/infrastructure/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/webhooks"
 	"github.com/kevinsoras/employee-management/contexts/employee/interfaces"
+	payrollUsecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	payrollServices "github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	payrollValueObjects "github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/afpnet"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/bankfiles"
+	payrollPostgres "github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/datasource/postgres"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/payslippdf"
+	payrollRepository "github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/sunatfiles"
+	payrollInterfaces "github.com/kevinsoras/employee-management/contexts/payroll/interfaces"
 	"github.com/kevinsoras/employee-management/shared/application"
-	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	sharedUsecases "github.com/kevinsoras/employee-management/shared/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	sharedServices "github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/auth"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
 	sharedPostgres "github.com/kevinsoras/employee-management/shared/infrastructure/datasource/postgres"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/lookup"
 	sharedRepository "github.com/kevinsoras/employee-management/shared/infrastructure/repositories"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/storage"
+	sharedInterfaces "github.com/kevinsoras/employee-management/shared/interfaces"
 )
 
 // Application agrupa todos los componentes principales de tu aplicación.
 type Application struct {
-	EmployeeController *interfaces.EmployeeController
+	EmployeeController    *interfaces.EmployeeController
+	PersonController      *sharedInterfaces.PersonController
+	PersonMergeController *interfaces.PersonMergeController
+	ImportController      *interfaces.EmployeeImportController
+	ExportController      *interfaces.EmployeeExportController
+	PayrollController     *payrollInterfaces.PayrollController
+	SunatController       *payrollInterfaces.SunatController
+	PayslipController     *payrollInterfaces.PayslipController
+	ContractController    *interfaces.ContractController
+	DocumentController    *interfaces.EmployeeDocumentController
+	WebhookController     *interfaces.WebhookController
 	// Aquí podrías añadir otros controladores, servicios, etc.
+
+	logger        *slog.Logger
+	config        Config
+	tokenVerifier security.TokenVerifier
 }
 
+// Roles autorizados por operación; la verificación ocurre en la capa de casos de uso
+var (
+	hrStaffRoles = []security.Role{security.RoleHRAdmin, security.RoleHRAnalyst}
+	hrAdminRoles = []security.Role{security.RoleHRAdmin}
+	// Los managers consultan empleados, pero con los datos sensibles enmascarados
+	employeeReaderRoles = []security.Role{security.RoleHRAdmin, security.RoleHRAnalyst, security.RoleManager}
+	// Los trabajadores acceden a sus propias boletas; el caso de uso restringe cuáles
+	payslipReaderRoles = []security.Role{security.RoleHRAdmin, security.RoleHRAnalyst, security.RoleEmployee}
+)
+
 // NewApplication es la función central de ensamblaje de dependencias.
 // Recibe las dependencias de nivel más bajo (DB, Logger) y construye el resto.
-func NewApplication(dbConn *sql.DB, logger *slog.Logger) *Application {
-	// 1. DataSources
-	dataSource := empPostgres.NewEmployeeDataSourcePostgres(dbConn)
-	dataSourcePerson := sharedPostgres.NewPersonDataSourcePostgres(dbConn)
+func NewApplication(dbConn *sql.DB, logger *slog.Logger, cfg Config) (*Application, error) {
+	// 1. DataSources (con cifrado en reposo de los datos sensibles)
+	encrypter, err := crypto.NewFieldEncrypter(cfg.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring field encryption: %w", err)
+	}
+	dataSource := empPostgres.NewEmployeeDataSourcePostgres(dbConn, encrypter)
+	dataSourcePerson := sharedPostgres.NewPersonDataSourcePostgres(dbConn, encrypter)
+	dataSourceIdempotency := sharedPostgres.NewIdempotencyDataSourcePostgres(dbConn, encrypter)
+	dataSourcePayroll := payrollPostgres.NewPayrollRunDataSourcePostgres(dbConn, encrypter)
+	dataSourcePayslip := payrollPostgres.NewPayslipDataSourcePostgres(dbConn, encrypter)
+	dataSourceContractTemplate := empPostgres.NewContractTemplateDataSourcePostgres(dbConn)
+	dataSourceContractDocument := empPostgres.NewContractDocumentDataSourcePostgres(dbConn, encrypter)
+	dataSourceEmployeeDocument := empPostgres.NewEmployeeDocumentDataSourcePostgres(dbConn)
+	dataSourceOutbox := sharedPostgres.NewOutboxDataSourcePostgres(dbConn)
+	dataSourceWebhookSubscription := empPostgres.NewWebhookSubscriptionDataSourcePostgres(dbConn, encrypter)
+	dataSourceWebhookDelivery := empPostgres.NewWebhookDeliveryDataSourcePostgres(dbConn)
+	documentStore, err := newDocumentStore(cfg, encrypter)
+	if err != nil {
+		return nil, err
+	}
 
 	// 2. Repositorios
 	repo := repository.NewEmployeeRepositoryImpl(dataSource)
 	repoPerson := sharedRepository.NewPersonRepositoryImpl(dataSourcePerson)
+	repoIdempotency := sharedRepository.NewIdempotencyRepositoryImpl(dataSourceIdempotency)
+	repoPayroll := payrollRepository.NewPayrollRunRepositoryImpl(dataSourcePayroll)
+	repoPayslip := payrollRepository.NewPayslipRepositoryImpl(dataSourcePayslip)
+	repoContractTemplate := repository.NewContractTemplateRepositoryImpl(dataSourceContractTemplate)
+	repoContractDocument := repository.NewContractDocumentRepositoryImpl(dataSourceContractDocument)
+	repoEmployeeDocument := repository.NewEmployeeDocumentRepositoryImpl(dataSourceEmployeeDocument)
+	repoOutbox := sharedRepository.NewOutboxRepositoryImpl(dataSourceOutbox)
+	repoWebhookSubscription := repository.NewWebhookSubscriptionRepositoryImpl(dataSourceWebhookSubscription)
+	repoWebhookDelivery := repository.NewWebhookDeliveryRepositoryImpl(dataSourceWebhookDelivery)
 
 	// 3. Servicios de Dominio
 	laborService := services.NewPeruvianLaborService()
+	lookupService := newPersonLookupService(cfg, logger)
+	duplicateDetector := sharedServices.NewDuplicatePersonDetector()
+	payrollCalculator := payrollServices.NewPeruvianPayrollCalculator()
+	sunatValidator := payrollServices.NewSunatValidator()
+	payslipRenderer := payslippdf.NewRenderer()
+	tokenVerifier, err := newTokenVerifier(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
 
-	// 4. Unit of Work
+	// 4. Unit of Work y eventos de dominio (se guardan en el outbox dentro de la transacción y se
+	// despachan al confirmarse; cmd/outbox-relay los publica y los reparte a los webhooks)
 	uow := db.NewPostgresUoW(dbConn)
+	dispatcher := application.NewEventDispatcher(logger)
+	dispatcher.UseOutbox(repoOutbox)
+	registerEventSubscribers(dispatcher, logger)
 
-	// 5. Casos de Uso (puros y decorados)
+	// 5. Casos de Uso (puros y decorados: autorización > transacción > idempotencia > caso de uso)
 	registerUC := usecases.NewRegisterEmployeeUseCase(repo, repoPerson, laborService)
-	transactionalRegisterUC := application.NewTransactionalDecorator(registerUC, uow)
+	idempotentRegisterUC := application.NewIdempotencyDecorator(registerUC, repoIdempotency, "employee.register", cfg.IdempotencyKeyTTL)
+	transactionalRegisterUC := application.NewTransactionalDecorator(idempotentRegisterUC, uow, dispatcher)
+	authorizedRegisterUC := application.NewAuthorizationDecorator(transactionalRegisterUC, hrStaffRoles...)
+	// La importación abre su propia transacción según el modo; cada fila se registra en un savepoint
+	importEmployeesUC := usecases.NewImportEmployeesUseCase(application.NewTransactionalDecorator(registerUC, uow, dispatcher), uow, dispatcher)
+	authorizedImportEmployeesUC := application.NewAuthorizationDecorator(importEmployeesUC, hrStaffRoles...)
+	exportEmployeesUC := usecases.NewExportEmployeesUseCase(repo)
+	authorizedExportEmployeesUC := application.NewAuthorizationDecorator(exportEmployeesUC, hrStaffRoles...)
+	getEmployeeUC := usecases.NewGetEmployeeUseCase(repo, repoPerson)
+	authorizedGetEmployeeUC := application.NewAuthorizationDecorator(getEmployeeUC, employeeReaderRoles...)
+	updateEmployeeUC := usecases.NewUpdateEmployeeUseCase(repo, repoPerson, laborService)
+	transactionalUpdateEmployeeUC := application.NewTransactionalDecorator(updateEmployeeUC, uow, dispatcher)
+	authorizedUpdateEmployeeUC := application.NewAuthorizationDecorator(transactionalUpdateEmployeeUC, hrStaffRoles...)
+	getPersonUC := sharedUsecases.NewGetPersonUseCase(repoPerson)
+	authorizedGetPersonUC := application.NewAuthorizationDecorator(getPersonUC, hrStaffRoles...)
+	lookupPersonUC := sharedUsecases.NewLookupPersonUseCase(lookupService)
+	authorizedLookupPersonUC := application.NewAuthorizationDecorator(lookupPersonUC, hrStaffRoles...)
+	updatePersonUC := sharedUsecases.NewUpdatePersonUseCase(repoPerson)
+	transactionalUpdatePersonUC := application.NewTransactionalDecorator(updatePersonUC, uow, dispatcher)
+	authorizedUpdatePersonUC := application.NewAuthorizationDecorator(transactionalUpdatePersonUC, hrStaffRoles...)
+	findDuplicatesUC := sharedUsecases.NewFindDuplicatePersonsUseCase(repoPerson, duplicateDetector)
+	authorizedFindDuplicatesUC := application.NewAuthorizationDecorator(findDuplicatesUC, hrStaffRoles...)
+	mergePersonsUC := usecases.NewMergePersonsUseCase(repo, repoPerson)
+	idempotentMergePersonsUC := application.NewIdempotencyDecorator(mergePersonsUC, repoIdempotency, "persons.merge", cfg.IdempotencyKeyTTL)
+	transactionalMergePersonsUC := application.NewTransactionalDecorator(idempotentMergePersonsUC, uow, dispatcher)
+	authorizedMergePersonsUC := application.NewAuthorizationDecorator(transactionalMergePersonsUC, hrAdminRoles...)
+	createPayrollRunUC := payrollUsecases.NewCreatePayrollRunUseCase(repo, repoPayroll, payrollCalculator)
+	idempotentCreatePayrollRunUC := application.NewIdempotencyDecorator(createPayrollRunUC, repoIdempotency, "payroll.create", cfg.IdempotencyKeyTTL)
+	transactionalCreatePayrollRunUC := application.NewTransactionalDecorator(idempotentCreatePayrollRunUC, uow, dispatcher)
+	authorizedCreatePayrollRunUC := application.NewAuthorizationDecorator(transactionalCreatePayrollRunUC, hrStaffRoles...)
+	getPayrollRunUC := payrollUsecases.NewGetPayrollRunUseCase(repoPayroll)
+	authorizedGetPayrollRunUC := application.NewAuthorizationDecorator(getPayrollRunUC, hrStaffRoles...)
+	// Los archivos de pago llevan las cuentas completas: solo HR_ADMIN los descarga
+	generateBankFileUC := payrollUsecases.NewGenerateBankFileUseCase(repoPayroll, debitAccounts(cfg),
+		bankfiles.NewBCPGenerator(), bankfiles.NewInterbankGenerator(), bankfiles.NewBBVAGenerator(), bankfiles.NewCCIGenerator())
+	authorizedGenerateBankFileUC := application.NewAuthorizationDecorator(generateBankFileUC, hrAdminRoles...)
+	exportTRegistroUC := payrollUsecases.NewExportTRegistroUseCase(repo, sunatValidator, sunatfiles.NewGenerator(), cfg.EmployerRUC)
+	authorizedExportTRegistroUC := application.NewAuthorizationDecorator(exportTRegistroUC, hrStaffRoles...)
+	exportPlameUC := payrollUsecases.NewExportPlameUseCase(repoPayroll, sunatValidator, sunatfiles.NewGenerator(), cfg.EmployerRUC)
+	authorizedExportPlameUC := application.NewAuthorizationDecorator(exportPlameUC, hrStaffRoles...)
+	exportAFPnetUC := payrollUsecases.NewExportAFPnetUseCase(repoPayroll, afpnet.NewGenerator())
+	authorizedExportAFPnetUC := application.NewAuthorizationDecorator(exportAFPnetUC, hrStaffRoles...)
+	generatePayslipsUC := payrollUsecases.NewGeneratePayslipsUseCase(repoPayroll, repoPayslip, repoPerson, payslipRenderer, cfg.EmployerRUC)
+	transactionalGeneratePayslipsUC := application.NewTransactionalDecorator(generatePayslipsUC, uow, dispatcher)
+	authorizedGeneratePayslipsUC := application.NewAuthorizationDecorator(transactionalGeneratePayslipsUC, hrStaffRoles...)
+	listPayslipsUC := payrollUsecases.NewListPayslipsUseCase(repoPayroll, repoPayslip)
+	authorizedListPayslipsUC := application.NewAuthorizationDecorator(listPayslipsUC, hrStaffRoles...)
+	downloadPayslipUC := payrollUsecases.NewDownloadPayslipUseCase(repoPayslip, payslipRenderer)
+	authorizedDownloadPayslipUC := application.NewAuthorizationDecorator(downloadPayslipUC, payslipReaderRoles...)
+	// La constancia de recepción solo la da el propio trabajador
+	acknowledgePayslipUC := payrollUsecases.NewAcknowledgePayslipUseCase(repoPayslip)
+	transactionalAcknowledgePayslipUC := application.NewTransactionalDecorator(acknowledgePayslipUC, uow, dispatcher)
+	authorizedAcknowledgePayslipUC := application.NewAuthorizationDecorator(transactionalAcknowledgePayslipUC, security.RoleEmployee)
+	// Las plantillas son el texto legal de la empresa: solo HR_ADMIN publica versiones nuevas
+	createContractTemplateUC := usecases.NewCreateContractTemplateUseCase(repoContractTemplate)
+	transactionalCreateContractTemplateUC := application.NewTransactionalDecorator(createContractTemplateUC, uow, dispatcher)
+	authorizedCreateContractTemplateUC := application.NewAuthorizationDecorator(transactionalCreateContractTemplateUC, hrAdminRoles...)
+	listContractTemplatesUC := usecases.NewListContractTemplatesUseCase(repoContractTemplate)
+	authorizedListContractTemplatesUC := application.NewAuthorizationDecorator(listContractTemplatesUC, hrStaffRoles...)
+	generateContractUC := usecases.NewGenerateContractUseCase(repo, repoPerson, repoContractTemplate, repoContractDocument, cfg.EmployerRUC,
+		contractdocs.NewPDFRenderer(), contractdocs.NewDOCXRenderer())
+	transactionalGenerateContractUC := application.NewTransactionalDecorator(generateContractUC, uow, dispatcher)
+	authorizedGenerateContractUC := application.NewAuthorizationDecorator(transactionalGenerateContractUC, hrStaffRoles...)
+	listEmployeeContractsUC := usecases.NewListEmployeeContractsUseCase(repo, repoContractDocument)
+	authorizedListEmployeeContractsUC := application.NewAuthorizationDecorator(listEmployeeContractsUC, hrStaffRoles...)
+	downloadContractUC := usecases.NewDownloadContractUseCase(repoContractDocument)
+	authorizedDownloadContractUC := application.NewAuthorizationDecorator(downloadContractUC, hrStaffRoles...)
+	uploadEmployeeDocumentUC := usecases.NewUploadEmployeeDocumentUseCase(repo, repoEmployeeDocument, documentStore)
+	transactionalUploadEmployeeDocumentUC := application.NewTransactionalDecorator(uploadEmployeeDocumentUC, uow, dispatcher)
+	authorizedUploadEmployeeDocumentUC := application.NewAuthorizationDecorator(transactionalUploadEmployeeDocumentUC, hrStaffRoles...)
+	listEmployeeDocumentsUC := usecases.NewListEmployeeDocumentsUseCase(repo, repoEmployeeDocument)
+	authorizedListEmployeeDocumentsUC := application.NewAuthorizationDecorator(listEmployeeDocumentsUC, hrStaffRoles...)
+	downloadEmployeeDocumentUC := usecases.NewDownloadEmployeeDocumentUseCase(repoEmployeeDocument, documentStore)
+	authorizedDownloadEmployeeDocumentUC := application.NewAuthorizationDecorator(downloadEmployeeDocumentUC, hrStaffRoles...)
+	// Las suscripciones envían datos del personal a otros sistemas: solo HR_ADMIN las administra
+	webhookSender := webhooks.NewHTTPSender(cfg.Webhooks)
+	createWebhookSubscriptionUC := usecases.NewCreateWebhookSubscriptionUseCase(repoWebhookSubscription, webhookSender)
+	transactionalCreateWebhookSubscriptionUC := application.NewTransactionalDecorator(createWebhookSubscriptionUC, uow, dispatcher)
+	authorizedCreateWebhookSubscriptionUC := application.NewAuthorizationDecorator(transactionalCreateWebhookSubscriptionUC, hrAdminRoles...)
+	listWebhookSubscriptionsUC := usecases.NewListWebhookSubscriptionsUseCase(repoWebhookSubscription)
+	authorizedListWebhookSubscriptionsUC := application.NewAuthorizationDecorator(listWebhookSubscriptionsUC, hrAdminRoles...)
+	getWebhookSubscriptionUC := usecases.NewGetWebhookSubscriptionUseCase(repoWebhookSubscription)
+	authorizedGetWebhookSubscriptionUC := application.NewAuthorizationDecorator(getWebhookSubscriptionUC, hrAdminRoles...)
+	updateWebhookSubscriptionUC := usecases.NewUpdateWebhookSubscriptionUseCase(repoWebhookSubscription, webhookSender)
+	transactionalUpdateWebhookSubscriptionUC := application.NewTransactionalDecorator(updateWebhookSubscriptionUC, uow, dispatcher)
+	authorizedUpdateWebhookSubscriptionUC := application.NewAuthorizationDecorator(transactionalUpdateWebhookSubscriptionUC, hrAdminRoles...)
+	deleteWebhookSubscriptionUC := usecases.NewDeleteWebhookSubscriptionUseCase(repoWebhookSubscription)
+	transactionalDeleteWebhookSubscriptionUC := application.NewTransactionalDecorator(deleteWebhookSubscriptionUC, uow, dispatcher)
+	authorizedDeleteWebhookSubscriptionUC := application.NewAuthorizationDecorator(transactionalDeleteWebhookSubscriptionUC, hrAdminRoles...)
+	listWebhookDeliveriesUC := usecases.NewListWebhookDeliveriesUseCase(repoWebhookSubscription, repoWebhookDelivery)
+	authorizedListWebhookDeliveriesUC := application.NewAuthorizationDecorator(listWebhookDeliveriesUC, hrAdminRoles...)
 
 	// 6. Controladores (ahora con constructores más simples)
-	employeeController := interfaces.NewEmployeeController(logger, transactionalRegisterUC)
+	employeeController := interfaces.NewEmployeeController(logger, authorizedRegisterUC, authorizedGetEmployeeUC, authorizedUpdateEmployeeUC)
+	personController := sharedInterfaces.NewPersonController(logger, authorizedGetPersonUC, authorizedLookupPersonUC, authorizedUpdatePersonUC, authorizedFindDuplicatesUC)
+	personMergeController := interfaces.NewPersonMergeController(logger, authorizedMergePersonsUC)
+	importController := interfaces.NewEmployeeImportController(logger, authorizedImportEmployeesUC)
+	exportController := interfaces.NewEmployeeExportController(logger, authorizedExportEmployeesUC, cfg.ExportTimeout)
+	payrollController := payrollInterfaces.NewPayrollController(logger, authorizedCreatePayrollRunUC, authorizedGetPayrollRunUC, authorizedGenerateBankFileUC, authorizedExportAFPnetUC)
+	sunatController := payrollInterfaces.NewSunatController(logger, authorizedExportTRegistroUC, authorizedExportPlameUC)
+	payslipController := payrollInterfaces.NewPayslipController(logger, authorizedGeneratePayslipsUC, authorizedListPayslipsUC, authorizedDownloadPayslipUC, authorizedAcknowledgePayslipUC)
+	contractController := interfaces.NewContractController(logger, authorizedCreateContractTemplateUC, authorizedListContractTemplatesUC,
+		authorizedGenerateContractUC, authorizedListEmployeeContractsUC, authorizedDownloadContractUC)
+	employeeDocumentController := interfaces.NewEmployeeDocumentController(logger, authorizedUploadEmployeeDocumentUC,
+		authorizedListEmployeeDocumentsUC, authorizedDownloadEmployeeDocumentUC)
+	webhookController := interfaces.NewWebhookController(logger, authorizedCreateWebhookSubscriptionUC, authorizedListWebhookSubscriptionsUC,
+		authorizedGetWebhookSubscriptionUC, authorizedUpdateWebhookSubscriptionUC, authorizedDeleteWebhookSubscriptionUC,
+		authorizedListWebhookDeliveriesUC)
 
 	return &Application{
-		EmployeeController: employeeController,
+		EmployeeController:    employeeController,
+		PersonController:      personController,
+		PersonMergeController: personMergeController,
+		ImportController:      importController,
+		ExportController:      exportController,
+		PayrollController:     payrollController,
+		SunatController:       sunatController,
+		PayslipController:     payslipController,
+		ContractController:    contractController,
+		DocumentController:    employeeDocumentController,
+		WebhookController:     webhookController,
+		logger:                logger,
+		config:                cfg,
+		tokenVerifier:         tokenVerifier,
+	}, nil
+}
+
+// debitAccounts asocia las cuentas de cargo configuradas a cada banco.
+func debitAccounts(cfg Config) map[payrollValueObjects.Bank]string {
+	accounts := make(map[payrollValueObjects.Bank]string, len(cfg.PayrollDebitAccounts))
+	for bank, account := range cfg.PayrollDebitAccounts {
+		accounts[payrollValueObjects.Bank(bank)] = account
 	}
-}
\ No newline at end of file
+	return accounts
+}
+
+// newTokenVerifier construye el validador de JWT. Sin clave HS256 ni JWKS toda petición será rechazada.
+func newTokenVerifier(cfg Config, logger *slog.Logger) (security.TokenVerifier, error) {
+	if cfg.JWT.HMACSecret == "" && cfg.JWT.JWKSFile == "" {
+		logger.Warn("JWT_HMAC_SECRET and JWT_JWKS_FILE not set, every request will be rejected as unauthenticated")
+	}
+	verifier, err := auth.NewJWTVerifier(cfg.JWT)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring JWT verifier: %w", err)
+	}
+	return verifier, nil
+}
+
+// newDocumentStore elige el almacenamiento de los documentos de los empleados. Los archivos se
+// guardan cifrados, como el resto de datos sensibles.
+func newDocumentStore(cfg Config, encrypter *crypto.FieldEncrypter) (sharedServices.BlobStore, error) {
+	var store sharedServices.BlobStore
+	switch cfg.DocumentStorage {
+	case "", "filesystem":
+		fsStore, err := storage.NewFileSystemBlobStore(cfg.DocumentStorageDir)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring document storage: %w", err)
+		}
+		store = fsStore
+	case "s3":
+		s3Store, err := storage.NewS3BlobStore(cfg.DocumentS3)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring document storage: %w", err)
+		}
+		store = s3Store
+	default:
+		return nil, fmt.Errorf("unknown DOCUMENT_STORAGE %q, use filesystem or s3", cfg.DocumentStorage)
+	}
+	return storage.NewEncryptedBlobStore(store, encrypter, crypto.PurposeEmployeeDocumentContent), nil
+}
+
+// newPersonLookupService elige el adaptador de consulta RENIEC/SUNAT según la configuración.
+// Sin proveedor configurado se usa un adaptador en memoria vacío (toda consulta devuelve NOT_FOUND).
+func newPersonLookupService(cfg Config, logger *slog.Logger) sharedServices.PersonLookupService {
+	if cfg.PersonLookup.BaseURL == "" {
+		logger.Warn("PERSON_LOOKUP_URL not set, person lookup will not find any document")
+		return lookup.NewInMemoryPersonLookupService()
+	}
+	httpLookup := lookup.NewHTTPPersonLookupService(cfg.PersonLookup)
+	return lookup.NewCachedPersonLookupService(httpLookup, cfg.PersonLookupCacheTTL, cfg.PersonLookupCacheSize)
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/webhooks"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/auth"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/lookup"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/messaging"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/storage"
+)
+
+// Config agrupa la configuración externa de la aplicación (proveedores, claves, etc.).
+type Config struct {
+	PersonLookup         lookup.HTTPLookupConfig
+	PersonLookupCacheTTL time.Duration
+	// PersonLookupCacheSize es el máximo de consultas guardadas; al llenarse se descarta la menos usada
+	PersonLookupCacheSize int
+	// RequestTimeout limita la duración de cada request HTTP
+	RequestTimeout time.Duration
+	// ExportTimeout limita las exportaciones, que no se rigen por RequestTimeout
+	ExportTimeout time.Duration
+	// JWT configura la validación de los tokens de acceso (HS256 y/o RS256 con JWKS local)
+	JWT auth.JWTConfig
+	// IdempotencyKeyTTL es la vigencia de los Idempotency-Key de las operaciones POST
+	IdempotencyKeyTTL time.Duration
+	// Encryption configura el cifrado en reposo de documentos de identidad y cuentas bancarias
+	Encryption crypto.Config
+	// PayrollDebitAccounts son las cuentas de cargo de la empresa por banco (BCP, INTERBANK, BBVA)
+	PayrollDebitAccounts map[string]string
+	// EmployerRUC es el RUC de la empresa, con el que se nombran los archivos de T-Registro y PLAME
+	EmployerRUC string
+	// DocumentStorage es dónde se guardan los documentos de los empleados: "filesystem" (por
+	// defecto, en DocumentStorageDir) o "s3" (DocumentS3, también MinIO o R2)
+	DocumentStorage    string
+	DocumentStorageDir string
+	DocumentS3         storage.S3Config
+	// OutboxPublisher es el transporte de los eventos de integración que publica cmd/outbox-relay:
+	// "stdout" (por defecto), "webhook" (OutboxWebhook) o "nats" (OutboxNATS)
+	OutboxPublisher string
+	OutboxWebhook   messaging.WebhookConfig
+	OutboxNATS      messaging.NATSConfig
+	// OutboxRelay fija la reserva y los reintentos del relay y OutboxPolling su lote y frecuencia
+	// de consulta
+	OutboxRelay   application.OutboxRelayConfig
+	OutboxPolling application.PollerConfig
+	// Webhooks configura el cliente HTTP de las suscripciones; WebhookDelivery y WebhookPolling,
+	// el envío de las entregas que hace cmd/outbox-relay junto al relay
+	Webhooks        webhooks.Config
+	WebhookDelivery usecases.DeliverWebhooksConfig
+	WebhookPolling  application.PollerConfig
+}
+
+// LoadConfig lee la configuración desde variables de entorno.
+func LoadConfig() Config {
+	return Config{
+		PersonLookup: lookup.HTTPLookupConfig{
+			BaseURL: os.Getenv("PERSON_LOOKUP_URL"),
+			Token:   os.Getenv("PERSON_LOOKUP_TOKEN"),
+			Timeout: durationFromEnv("PERSON_LOOKUP_TIMEOUT", 5*time.Second),
+		},
+		PersonLookupCacheTTL:  durationFromEnv("PERSON_LOOKUP_CACHE_TTL", 24*time.Hour),
+		PersonLookupCacheSize: intFromEnv("PERSON_LOOKUP_CACHE_SIZE", 10000),
+		RequestTimeout:        durationFromEnv("HTTP_REQUEST_TIMEOUT", 30*time.Second),
+		ExportTimeout:         durationFromEnv("EXPORT_TIMEOUT", 10*time.Minute),
+		IdempotencyKeyTTL:     durationFromEnv("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
+		JWT: auth.JWTConfig{
+			HMACSecret: os.Getenv("JWT_HMAC_SECRET"),
+			JWKSFile:   os.Getenv("JWT_JWKS_FILE"),
+			Issuer:     os.Getenv("JWT_ISSUER"),
+			Audience:   os.Getenv("JWT_AUDIENCE"),
+			Leeway:     durationFromEnv("JWT_LEEWAY", 30*time.Second),
+		},
+		Encryption: crypto.Config{
+			Keys:          os.Getenv("ENCRYPTION_KEYS"),
+			KeysFile:      os.Getenv("ENCRYPTION_KEYS_FILE"),
+			ActiveVersion: intFromEnv("ENCRYPTION_ACTIVE_KEY_VERSION", 0),
+			BlindIndexKey: os.Getenv("BLIND_INDEX_KEY"),
+		},
+		PayrollDebitAccounts: mapFromEnv("PAYROLL_DEBIT_ACCOUNTS"),
+		EmployerRUC:          os.Getenv("EMPLOYER_RUC"),
+		DocumentStorage:      strings.ToLower(os.Getenv("DOCUMENT_STORAGE")),
+		DocumentStorageDir:   stringFromEnv("DOCUMENT_STORAGE_DIR", "data/documents"),
+		DocumentS3: storage.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			ForcePathStyle:  boolFromEnv("S3_FORCE_PATH_STYLE", false),
+			Timeout:         durationFromEnv("S3_TIMEOUT", 30*time.Second),
+			MaxRetries:      intFromEnv("S3_MAX_RETRIES", 0),
+		},
+		OutboxPublisher: strings.ToLower(stringFromEnv("OUTBOX_PUBLISHER", "stdout")),
+		OutboxWebhook: messaging.WebhookConfig{
+			URL:     os.Getenv("OUTBOX_WEBHOOK_URL"),
+			Token:   os.Getenv("OUTBOX_WEBHOOK_TOKEN"),
+			Timeout: durationFromEnv("OUTBOX_WEBHOOK_TIMEOUT", 10*time.Second),
+		},
+		OutboxNATS: messaging.NATSConfig{
+			URL:           stringFromEnv("OUTBOX_NATS_URL", "nats://127.0.0.1:4222"),
+			SubjectPrefix: stringFromEnv("OUTBOX_NATS_SUBJECT_PREFIX", "employee-management"),
+			Timeout:       durationFromEnv("OUTBOX_NATS_TIMEOUT", 5*time.Second),
+			JetStream:     boolFromEnv("OUTBOX_NATS_JETSTREAM", false),
+			CredsFile:     stringFromEnv("OUTBOX_NATS_CREDS_FILE", ""),
+			TLSCAFile:     stringFromEnv("OUTBOX_NATS_TLS_CA_FILE", ""),
+			TLSCertFile:   stringFromEnv("OUTBOX_NATS_TLS_CERT_FILE", ""),
+			TLSKeyFile:    stringFromEnv("OUTBOX_NATS_TLS_KEY_FILE", ""),
+		},
+		OutboxRelay: application.OutboxRelayConfig{
+			LeaseDuration: durationFromEnv("OUTBOX_LEASE_DURATION", 5*time.Minute),
+			Retry: domain.RetryPolicy{
+				MaxAttempts: intFromEnv("OUTBOX_MAX_ATTEMPTS", 10),
+				BaseDelay:   durationFromEnv("OUTBOX_RETRY_BASE_DELAY", 5*time.Second),
+				MaxDelay:    durationFromEnv("OUTBOX_RETRY_MAX_DELAY", time.Hour),
+			},
+		},
+		OutboxPolling: application.PollerConfig{
+			BatchSize:    intFromEnv("OUTBOX_BATCH_SIZE", 100),
+			PollInterval: durationFromEnv("OUTBOX_POLL_INTERVAL", time.Second),
+		},
+		Webhooks: webhooks.Config{
+			Timeout:              durationFromEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+			AllowHTTP:            boolFromEnv("WEBHOOK_ALLOW_HTTP", false),
+			AllowPrivateNetworks: boolFromEnv("WEBHOOK_ALLOW_PRIVATE_NETWORKS", false),
+		},
+		WebhookDelivery: usecases.DeliverWebhooksConfig{
+			LeaseDuration: durationFromEnv("WEBHOOK_LEASE_DURATION", 5*time.Minute),
+			Retry: domain.RetryPolicy{
+				MaxAttempts: intFromEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+				BaseDelay:   durationFromEnv("WEBHOOK_RETRY_BASE_DELAY", 30*time.Second),
+				MaxDelay:    durationFromEnv("WEBHOOK_RETRY_MAX_DELAY", 6*time.Hour),
+			},
+		},
+		WebhookPolling: application.PollerConfig{
+			BatchSize:    intFromEnv("WEBHOOK_BATCH_SIZE", 50),
+			PollInterval: durationFromEnv("WEBHOOK_POLL_INTERVAL", time.Second),
+		},
+	}
+}
+
+// durationFromEnv interpreta valores como "5s" o "24h", usando fallback si no es válido.
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// intFromEnv interpreta un entero, usando fallback si no está definido o no es válido.
+func intFromEnv(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// stringFromEnv devuelve el valor de la variable, o fallback si no está definida.
+func stringFromEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// boolFromEnv interpreta "true"/"false" (o 1/0), usando fallback si no está definido o no es válido.
+func boolFromEnv(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// mapFromEnv interpreta pares "CLAVE=valor" separados por comas; las claves se pasan a mayúsculas.
+func mapFromEnv(key string) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		values[strings.ToUpper(strings.TrimSpace(name))] = strings.TrimSpace(value)
+	}
+	return values
+}
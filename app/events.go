@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	employeeEvents "github.com/kevinsoras/employee-management/contexts/employee/domain/events"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain/events"
+)
+
+// registerEventSubscribers suscribe los manejadores de los eventos de dominio. Cada manejador
+// corre después del commit, en el mismo proceso, y su error no afecta la operación que lo originó.
+// Los webhooks no se suscriben aquí: el relay del outbox reparte los eventos a las suscripciones.
+func registerEventSubscribers(dispatcher *application.EventDispatcher, logger *slog.Logger) {
+	// Registro de auditoría de los hechos del negocio, sin datos personales
+	application.Subscribe(dispatcher, func(ctx context.Context, event events.PersonRegistered) error {
+		logger.InfoContext(ctx, "Domain event", "event", event.EventName(), "personID", event.PersonID, "personType", event.PersonType)
+		return nil
+	})
+	application.Subscribe(dispatcher, func(ctx context.Context, event employeeEvents.EmployeeRegistered) error {
+		logger.InfoContext(ctx, "Domain event", "event", event.EventName(), "employeeID", event.EmployeeID, "personID", event.PersonID,
+			"contractType", event.ContractType, "startDate", event.StartDate)
+		return nil
+	})
+	application.Subscribe(dispatcher, func(ctx context.Context, event employeeEvents.SalaryChanged) error {
+		logger.InfoContext(ctx, "Domain event", "event", event.EventName(), "employeeID", event.EmployeeID)
+		return nil
+	})
+	application.Subscribe(dispatcher, func(ctx context.Context, event employeeEvents.EmployeeTerminated) error {
+		logger.InfoContext(ctx, "Domain event", "event", event.EventName(), "employeeID", event.EmployeeID, "endDate", event.EndDate)
+		return nil
+	})
+	application.Subscribe(dispatcher, func(ctx context.Context, event employeeEvents.EmployeeUpdated) error {
+		logger.InfoContext(ctx, "Domain event", "event", event.EventName(), "employeeID", event.EmployeeID, "version", event.Version,
+			"changedFields", event.ChangedFields)
+		return nil
+	})
+}
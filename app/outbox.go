@@ -0,0 +1,58 @@
+package app
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	sharedServices "github.com/kevinsoras/employee-management/shared/domain/services"
+	sharedPostgres "github.com/kevinsoras/employee-management/shared/infrastructure/datasource/postgres"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/messaging"
+	sharedRepository "github.com/kevinsoras/employee-management/shared/infrastructure/repositories"
+)
+
+// NewOutboxRelay arma el trabajo que publica los eventos guardados en el outbox con el publicador
+// configurado en OUTBOX_PUBLISHER. Antes de publicar cada evento del empleado encola sus entregas
+// a las suscripciones de webhooks, que envía NewWebhookDispatcher.
+func NewOutboxRelay(dbConn *sql.DB, logger *slog.Logger, cfg Config) (*application.Poller, error) {
+	publisher, err := newEventPublisher(cfg)
+	if err != nil {
+		return nil, err
+	}
+	repoWebhookSubscription, repoWebhookDelivery, err := newWebhookRepositories(dbConn, cfg)
+	if err != nil {
+		return nil, err
+	}
+	publisher = &webhookFanOutPublisher{
+		enqueue: usecases.NewEnqueueWebhookDeliveriesUseCase(repoWebhookSubscription, repoWebhookDelivery),
+		next:    publisher,
+	}
+	repoOutbox := sharedRepository.NewOutboxRepositoryImpl(sharedPostgres.NewOutboxDataSourcePostgres(dbConn))
+	relay := application.NewOutboxRelay(repoOutbox, publisher, cfg.OutboxRelay, logger)
+	return application.NewPoller("outbox", relay.RelayBatch, cfg.OutboxPolling, logger), nil
+}
+
+// newEventPublisher elige el transporte de los eventos de integración.
+func newEventPublisher(cfg Config) (sharedServices.EventPublisher, error) {
+	switch cfg.OutboxPublisher {
+	case "", "stdout":
+		return messaging.NewStdoutPublisher(os.Stdout), nil
+	case "webhook":
+		publisher, err := messaging.NewWebhookPublisher(cfg.OutboxWebhook)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring outbox publisher: %w", err)
+		}
+		return publisher, nil
+	case "nats":
+		publisher, err := messaging.NewNATSPublisher(cfg.OutboxNATS)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring outbox publisher: %w", err)
+		}
+		return publisher, nil
+	default:
+		return nil, fmt.Errorf("unknown OUTBOX_PUBLISHER %q, use stdout, webhook or nats", cfg.OutboxPublisher)
+	}
+}
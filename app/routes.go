@@ -0,0 +1,75 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/shared/interfaces/middleware"
+	"github.com/kevinsoras/employee-management/shared/interfaces/router"
+)
+
+// Routes registra todas las rutas de la API con su cadena de middlewares.
+// main y los tests e2e usan este mismo handler, así comparten exactamente el mismo cableado.
+func (a *Application) Routes() http.Handler {
+	r := router.New(
+		middleware.Locale(),
+		// RequestID va antes que Recovery para que el pánico se registre y responda con su traceId
+		middleware.RequestID(),
+		middleware.Recovery(a.logger),
+		middleware.AccessLog(a.logger),
+		middleware.Timeout(a.config.RequestTimeout),
+		middleware.Authenticate(a.tokenVerifier, a.logger),
+		middleware.IdempotencyKey(),
+	)
+
+	// Empleados
+	r.HandleFunc("POST /employee", a.EmployeeController.HandleRegister)
+	r.HandleFunc("POST /employees/import", a.ImportController.HandleImport)
+	r.HandleFunc("GET /employees/export", a.ExportController.HandleExport)
+	r.HandleFunc("GET /employees/{id}", a.EmployeeController.HandleGet)
+	r.HandleFunc("PATCH /employees/{id}", a.EmployeeController.HandleUpdate)
+	r.HandleFunc("POST /employees/{id}/contracts", a.ContractController.HandleGenerate)
+	r.HandleFunc("GET /employees/{id}/contracts", a.ContractController.HandleListByEmployee)
+	r.HandleFunc("POST /employees/{id}/documents", a.DocumentController.HandleUpload)
+	r.HandleFunc("GET /employees/{id}/documents", a.DocumentController.HandleListByEmployee)
+
+	// Contratos
+	r.HandleFunc("POST /contract-templates", a.ContractController.HandleCreateTemplate)
+	r.HandleFunc("GET /contract-templates", a.ContractController.HandleListTemplates)
+	r.HandleFunc("GET /contracts/{id}/file", a.ContractController.HandleDownload)
+
+	// Documentos de los empleados
+	r.HandleFunc("GET /documents/{id}/file", a.DocumentController.HandleDownload)
+
+	// Personas
+	r.HandleFunc("GET /persons/lookup", a.PersonController.HandleLookup)
+	r.HandleFunc("POST /persons/merge", a.PersonMergeController.HandleMerge)
+	r.HandleFunc("GET /persons/{id}", a.PersonController.HandleGet)
+	r.HandleFunc("PUT /persons/{id}", a.PersonController.HandleUpdate)
+	r.HandleFunc("GET /persons/{id}/duplicates", a.PersonController.HandleFindDuplicates)
+
+	// Planillas
+	r.HandleFunc("POST /payroll-runs", a.PayrollController.HandleCreate)
+	r.HandleFunc("GET /payroll-runs/{id}", a.PayrollController.HandleGet)
+	r.HandleFunc("GET /payroll-runs/{id}/bank-files/{bank}", a.PayrollController.HandleBankFile)
+	r.HandleFunc("GET /payroll-runs/{id}/plame/{file}", a.SunatController.HandlePlame)
+	r.HandleFunc("GET /payroll-runs/{id}/afpnet/{afp}", a.PayrollController.HandleAFPnet)
+	r.HandleFunc("POST /payroll-runs/{id}/payslips", a.PayslipController.HandleGenerate)
+	r.HandleFunc("GET /payroll-runs/{id}/payslips", a.PayslipController.HandleList)
+
+	// Boletas de pago
+	r.HandleFunc("GET /payslips/{id}/pdf", a.PayslipController.HandleDownload)
+	r.HandleFunc("POST /payslips/{id}/acknowledgement", a.PayslipController.HandleAcknowledge)
+
+	// SUNAT
+	r.HandleFunc("GET /sunat/t-registro/{file}", a.SunatController.HandleTRegistro)
+
+	// Webhooks
+	r.HandleFunc("POST /webhook-subscriptions", a.WebhookController.HandleCreate)
+	r.HandleFunc("GET /webhook-subscriptions", a.WebhookController.HandleList)
+	r.HandleFunc("GET /webhook-subscriptions/{id}", a.WebhookController.HandleGet)
+	r.HandleFunc("PUT /webhook-subscriptions/{id}", a.WebhookController.HandleUpdate)
+	r.HandleFunc("DELETE /webhook-subscriptions/{id}", a.WebhookController.HandleDelete)
+	r.HandleFunc("GET /webhook-subscriptions/{id}/deliveries", a.WebhookController.HandleListDeliveries)
+
+	return r
+}
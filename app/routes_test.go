@@ -0,0 +1,61 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/app"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/auth"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/interfaces/middleware"
+	"github.com/kevinsoras/employee-management/shared/interfaces/router"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+const testJWTSecret = "routes-test-secret"
+
+func TestRoutes_PanicProblemCarriesTheRequestID(t *testing.T) {
+	cfg := app.LoadConfig()
+	cfg.JWT = auth.JWTConfig{HMACSecret: testJWTSecret}
+	cfg.Encryption = crypto.Config{
+		Keys:          "1:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)),
+		BlindIndexKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)),
+	}
+	cfg.DocumentStorage = "filesystem"
+	cfg.DocumentStorageDir = t.TempDir()
+	// The routes are only wired here; no request reaches the database
+	application, err := app.NewApplication(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), cfg)
+	require.NoError(t, err)
+	routes, ok := application.Routes().(*router.Router)
+	require.True(t, ok)
+	routes.HandleFunc("GET /panic", func(w http.ResponseWriter, r *http.Request) { panic("boom") })
+	token, err := auth.SignHS256(map[string]any{
+		"sub":   "routes-test",
+		"roles": []string{"HR_ADMIN"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}, testJWTSecret)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/panic", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set(middleware.RequestIDHeader, "req-123")
+	rec := httptest.NewRecorder()
+	routes.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "req-123", rec.Header().Get(middleware.RequestIDHeader))
+	var problem utils.ProblemDetails
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, utils.CodeInternalError, problem.Code)
+	assert.Equal(t, "req-123", problem.TraceID)
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	empPostgres "github.com/kevinsoras/employee-management/contexts/employee/infrastructure/datasource/postgres"
+	repository "github.com/kevinsoras/employee-management/contexts/employee/infrastructure/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/webhooks"
+	"github.com/kevinsoras/employee-management/shared/application"
+	sharedEntities "github.com/kevinsoras/employee-management/shared/domain/entities"
+	sharedServices "github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+)
+
+// NewWebhookDispatcher arma el trabajo que envía las entregas pendientes de los webhooks, firmadas
+// con el secreto de cada suscripción. Corre en cmd/outbox-relay junto al relay, que las encola.
+func NewWebhookDispatcher(dbConn *sql.DB, logger *slog.Logger, cfg Config) (*application.Poller, error) {
+	repoWebhookSubscription, repoWebhookDelivery, err := newWebhookRepositories(dbConn, cfg)
+	if err != nil {
+		return nil, err
+	}
+	deliverWebhooksUC := usecases.NewDeliverWebhooksUseCase(repoWebhookSubscription, repoWebhookDelivery,
+		webhooks.NewHTTPSender(cfg.Webhooks), cfg.WebhookDelivery, logger)
+	return application.NewPoller("webhook-deliveries", deliverWebhooksUC.Execute, cfg.WebhookPolling, logger), nil
+}
+
+// newWebhookRepositories arma los repositorios de los webhooks. El secreto de las suscripciones se
+// guarda cifrado, de ahí la clave de cifrado.
+func newWebhookRepositories(dbConn *sql.DB, cfg Config) (repositories.WebhookSubscriptionRepository, repositories.WebhookDeliveryRepository, error) {
+	encrypter, err := crypto.NewFieldEncrypter(cfg.Encryption)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error configuring field encryption: %w", err)
+	}
+	repoWebhookSubscription := repository.NewWebhookSubscriptionRepositoryImpl(empPostgres.NewWebhookSubscriptionDataSourcePostgres(dbConn, encrypter))
+	repoWebhookDelivery := repository.NewWebhookDeliveryRepositoryImpl(empPostgres.NewWebhookDeliveryDataSourcePostgres(dbConn))
+	return repoWebhookSubscription, repoWebhookDelivery, nil
+}
+
+// webhookFanOutPublisher encola las entregas de los webhooks de cada mensaje antes de publicarlo en
+// el transporte configurado. Si la publicación falla, el relay reintenta el mensaje y las entregas
+// ya encoladas no se repiten: hay una por suscripción y evento.
+type webhookFanOutPublisher struct {
+	enqueue *usecases.EnqueueWebhookDeliveriesUseCase
+	next    sharedServices.EventPublisher
+}
+
+func (p *webhookFanOutPublisher) Publish(ctx context.Context, message *sharedEntities.OutboxMessage) error {
+	if _, err := p.enqueue.Execute(ctx, message); err != nil {
+		return err
+	}
+	return p.next.Publish(ctx, message)
+}
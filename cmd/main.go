@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"time"
 
 	_ "github.com/kevinsoras/employee-management/docs" // Importa los docs generados por Swag
 	"github.com/joho/godotenv"
@@ -38,17 +39,26 @@ func main() {
 	dbConn := db.NewPostgresConnection(dsn)
 
 	// Ensamblar toda la aplicación
-	application := app.NewApplication(dbConn, appLogger)
+	application, err := app.NewApplication(dbConn, appLogger, app.LoadConfig())
+	if err != nil {
+		appLogger.Error("Error assembling application", "error", err)
+		os.Exit(1)
+	}
 
-	// Inicializar API
-	http.HandleFunc("/employee", application.EmployeeController.HandleRegister)
+	// Inicializar API: las rutas de la aplicación y, aparte, la documentación de Swagger
+	mux := http.NewServeMux()
+	mux.Handle("/", application.Routes())
+	mux.Handle("/swagger/", httpSwagger.Handler(httpSwagger.URL("http://localhost:3000/swagger/doc.json")))
 
-	// Ruta para la documentación de Swagger
-	http.Handle("/swagger/", httpSwagger.Handler(httpSwagger.URL("http://localhost:3000/swagger/doc.json")))
+	server := &http.Server{
+		Addr:              ":3000",
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
 
 	// Iniciar servidor
 	appLogger.Info("Server started", "port", 3000)
-	if err := http.ListenAndServe(":3000", nil); err != nil {
+	if err := server.ListenAndServe(); err != nil {
 		appLogger.Error("Error starting server", "error", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,104 @@
+// Command outbox-relay publishes the integration events saved in the outbox table to the
+// transport selected by OUTBOX_PUBLISHER (stdout, webhook or nats) and fans the employee events out
+// to the webhook subscriptions, whose deliveries it sends too, signed with HMAC-SHA256. Several
+// instances can run at once: each batch is claimed with a lease. Failed messages and deliveries are
+// retried with exponential backoff; messages end up as DEAD after OUTBOX_MAX_ATTEMPTS, which
+// -requeue-dead gives a new round once the destination is fixed, and deliveries as FAILED after
+// WEBHOOK_MAX_ATTEMPTS, listed at GET /webhook-subscriptions/{id}/deliveries.
+//
+// Usage:
+//
+//	go run ./cmd/outbox-relay [-once] [-requeue-dead]
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/kevinsoras/employee-management/app"
+	"github.com/kevinsoras/employee-management/shared/application"
+	sharedPostgres "github.com/kevinsoras/employee-management/shared/infrastructure/datasource/postgres"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/logger"
+	sharedRepository "github.com/kevinsoras/employee-management/shared/infrastructure/repositories"
+)
+
+func main() {
+	once := flag.Bool("once", false, "publish the messages and send the webhook deliveries due now, then exit instead of polling")
+	requeueDead := flag.Bool("requeue-dead", false, "move the DEAD messages back to PENDING before relaying")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		slog.Info("No .env file found, using system env vars")
+	}
+	appLogger := logger.New()
+
+	dsn := os.Getenv("DB_URL")
+	if dsn == "" {
+		appLogger.Error("DB_URL not set in environment")
+		os.Exit(1)
+	}
+	dbConn := db.NewPostgresConnection(dsn)
+	defer dbConn.Close()
+
+	cfg := app.LoadConfig()
+	relay, err := app.NewOutboxRelay(dbConn, appLogger, cfg)
+	if err != nil {
+		appLogger.Error("Error assembling outbox relay", "error", err)
+		os.Exit(1)
+	}
+	dispatcher, err := app.NewWebhookDispatcher(dbConn, appLogger, cfg)
+	if err != nil {
+		appLogger.Error("Error assembling webhook dispatcher", "error", err)
+		os.Exit(1)
+	}
+	// The relay goes first: it queues the deliveries of the events it publishes
+	pollers := []*application.Poller{relay, dispatcher}
+
+	// SIGINT/SIGTERM let the batch in progress finish before exiting
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *requeueDead {
+		repoOutbox := sharedRepository.NewOutboxRepositoryImpl(sharedPostgres.NewOutboxDataSourcePostgres(dbConn))
+		requeued, err := repoOutbox.RequeueDead(ctx, time.Now())
+		if err != nil {
+			appLogger.Error("Error requeuing dead outbox messages", "error", err)
+			os.Exit(1)
+		}
+		appLogger.Info("Dead outbox messages requeued", "requeued", requeued)
+	}
+
+	if *once {
+		for _, poller := range pollers {
+			handled, err := poller.Drain(ctx)
+			if err != nil {
+				appLogger.Error("Outbox relay failed", "job", poller.Name(), "handled", handled, "error", err)
+				os.Exit(1)
+			}
+			appLogger.Info("Outbox relay finished", "job", poller.Name(), "handled", handled)
+		}
+		return
+	}
+
+	appLogger.Info("Outbox relay started", "publisher", cfg.OutboxPublisher, "pollInterval", cfg.OutboxPolling.PollInterval,
+		"webhookPollInterval", cfg.WebhookPolling.PollInterval)
+	var wg sync.WaitGroup
+	for _, poller := range pollers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			poller.Run(ctx)
+		}()
+	}
+	wg.Wait()
+	appLogger.Info("Outbox relay stopped")
+}
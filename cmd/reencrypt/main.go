@@ -0,0 +1,177 @@
+// Command reencrypt rewrites the encrypted columns with the active key version.
+// It also encrypts the rows still in plaintext and fills their blind indexes, so it is the
+// step that completes the migration to encryption at rest and every key rotation.
+//
+// Usage:
+//
+//	go run ./cmd/reencrypt [-batch-size 500] [-dry-run]
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"github.com/kevinsoras/employee-management/app"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/logger"
+)
+
+// encryptedColumn describes a column sealed by crypto.FieldEncrypter.
+type encryptedColumn struct {
+	table      string
+	idColumn   string
+	column     string
+	hashColumn string // Blind index column; empty when the column has none
+	purpose    string
+}
+
+var encryptedColumns = []encryptedColumn{
+	{table: "natural_persons", idColumn: "person_id", column: "document_number", hashColumn: "document_number_hash", purpose: crypto.PurposeDocumentNumber},
+	{table: "employees", idColumn: "employee_id", column: "bank_account", purpose: crypto.PurposeBankAccount},
+	{table: "payroll_items", idColumn: "item_id", column: "document_number", purpose: crypto.PurposePayrollDocumentNumber},
+	{table: "payroll_items", idColumn: "item_id", column: "bank_account", purpose: crypto.PurposePayrollBankAccount},
+	{table: "payslips", idColumn: "payslip_id", column: "content", purpose: crypto.PurposePayslipContent},
+	{table: "contract_documents", idColumn: "document_id", column: "content", purpose: crypto.PurposeContractContent},
+	{table: "webhook_subscriptions", idColumn: "subscription_id", column: "secret", purpose: crypto.PurposeWebhookSecret},
+	{table: "person_merges", idColumn: "merge_id", column: "merged_snapshot", purpose: crypto.PurposePersonMergeSnapshot},
+}
+
+func main() {
+	batchSize := flag.Int("batch-size", 500, "rows rewritten per transaction")
+	dryRun := flag.Bool("dry-run", false, "count the rows to rewrite without changing them")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		slog.Info("No .env file found, using system env vars")
+	}
+	appLogger := logger.New()
+
+	dsn := os.Getenv("DB_URL")
+	if dsn == "" {
+		appLogger.Error("DB_URL not set in environment")
+		os.Exit(1)
+	}
+	encrypter, err := crypto.NewFieldEncrypter(app.LoadConfig().Encryption)
+	if err != nil {
+		appLogger.Error("Error configuring field encryption", "error", err)
+		os.Exit(1)
+	}
+	dbConn := db.NewPostgresConnection(dsn)
+	defer dbConn.Close()
+
+	ctx := context.Background()
+	for _, col := range encryptedColumns {
+		rewritten, err := reencryptColumn(ctx, dbConn, encrypter, col, *batchSize, *dryRun)
+		if err != nil {
+			appLogger.Error("Re-encryption failed", "table", col.table, "column", col.column, "rewritten", rewritten, "error", err)
+			os.Exit(1)
+		}
+		appLogger.Info("Re-encryption finished", "table", col.table, "column", col.column,
+			"rewritten", rewritten, "activeKeyVersion", encrypter.ActiveVersion(), "dryRun", *dryRun)
+	}
+}
+
+// reencryptColumn walks the table by primary key in batches; each batch is one transaction,
+// so an interrupted run can simply be started again.
+func reencryptColumn(ctx context.Context, dbConn *sql.DB, encrypter *crypto.FieldEncrypter, col encryptedColumn, batchSize int, dryRun bool) (int, error) {
+	hashSelect := "''"
+	if col.hashColumn != "" {
+		hashSelect = fmt.Sprintf("COALESCE(%s, '')", col.hashColumn)
+	}
+	selectQuery := fmt.Sprintf(`SELECT %[1]s::text, %[2]s, %[3]s FROM %[4]s
+WHERE %[1]s::text > $1 AND %[2]s IS NOT NULL AND %[2]s <> ''
+ORDER BY %[1]s::text LIMIT $2 FOR UPDATE`, col.idColumn, col.column, hashSelect, col.table)
+	updateQuery := fmt.Sprintf(`UPDATE %s SET %s = $2 WHERE %s = $1`, col.table, col.column, col.idColumn)
+	if col.hashColumn != "" {
+		updateQuery = fmt.Sprintf(`UPDATE %s SET %s = $2, %s = $3 WHERE %s = $1`, col.table, col.column, col.hashColumn, col.idColumn)
+	}
+
+	rewritten, lastID := 0, ""
+	for {
+		tx, err := dbConn.BeginTx(ctx, nil)
+		if err != nil {
+			return rewritten, err
+		}
+		batchRewritten, batchLastID, batchLen, err := reencryptBatch(ctx, tx, encrypter, col, selectQuery, updateQuery, lastID, batchSize, dryRun)
+		if err != nil {
+			_ = tx.Rollback()
+			return rewritten, err
+		}
+		if dryRun {
+			err = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+		if err != nil {
+			return rewritten, err
+		}
+		rewritten += batchRewritten
+		if batchLen < batchSize {
+			return rewritten, nil
+		}
+		lastID = batchLastID
+	}
+}
+
+type storedValue struct {
+	id    string
+	value string
+	hash  string
+}
+
+func reencryptBatch(ctx context.Context, tx *sql.Tx, encrypter *crypto.FieldEncrypter, col encryptedColumn, selectQuery, updateQuery, afterID string, batchSize int, dryRun bool) (rewritten int, lastID string, batchLen int, err error) {
+	rows, err := tx.QueryContext(ctx, selectQuery, afterID, batchSize)
+	if err != nil {
+		return 0, "", 0, err
+	}
+	var batch []storedValue
+	for rows.Next() {
+		var v storedValue
+		if err := rows.Scan(&v.id, &v.value, &v.hash); err != nil {
+			rows.Close()
+			return 0, "", 0, err
+		}
+		batch = append(batch, v)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, "", 0, err
+	}
+	if len(batch) == 0 {
+		return 0, afterID, 0, nil
+	}
+
+	for _, v := range batch {
+		missingHash := col.hashColumn != "" && v.hash == ""
+		if !encrypter.NeedsReencryption(v.value) && !missingHash {
+			continue
+		}
+		plaintext, err := encrypter.Decrypt(v.value, col.purpose)
+		if err != nil {
+			return rewritten, "", 0, fmt.Errorf("row %s: %w", v.id, err)
+		}
+		rewritten++
+		if dryRun {
+			continue
+		}
+		ciphertext, err := encrypter.Encrypt(plaintext, col.purpose)
+		if err != nil {
+			return rewritten, "", 0, fmt.Errorf("row %s: %w", v.id, err)
+		}
+		args := []any{v.id, ciphertext}
+		if col.hashColumn != "" {
+			args = append(args, encrypter.BlindIndex(plaintext))
+		}
+		if _, err := tx.ExecContext(ctx, updateQuery, args...); err != nil {
+			return rewritten, "", 0, fmt.Errorf("row %s: %w", v.id, err)
+		}
+	}
+	return rewritten, batch[len(batch)-1].id, len(batch), nil
+}
@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+)
+
+// ContractTemplateRequest - DTO para POST /contract-templates: crea la siguiente versión de la
+// plantilla de una modalidad
+type ContractTemplateRequest struct {
+	ContractType string `json:"contractType" validate:"required,oneof=INDEFINIDO FIJO PRACTICANTE"`
+	Title        string `json:"title" validate:"required,max=150"`
+	Body         string `json:"body" validate:"required,max=50000"`
+}
+
+// ContractGenerationRequest - DTO para POST /employees/{id}/contracts. La modalidad es la del
+// empleado; sin templateVersion se usa la última versión de su plantilla
+type ContractGenerationRequest struct {
+	Format          string     `json:"format" validate:"omitempty,oneof=PDF DOCX pdf docx"`
+	TemplateVersion int        `json:"templateVersion" validate:"omitempty,min=1"`
+	Cause           string     `json:"cause" validate:"max=500"`           // Solo contrato sujeto a modalidad
+	EndDate         *time.Time `json:"endDate"`                            // Sin ella se usa la fecha de cese del empleado
+	EducationCenter string     `json:"educationCenter" validate:"max=150"` // Solo convenio de prácticas
+}
+
+type ContractTemplateResponse struct {
+	ID           string    `json:"id"`
+	ContractType string    `json:"contractType"`
+	Version      int       `json:"version"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	Placeholders []string  `json:"placeholders"`
+	CreatedBy    string    `json:"createdBy,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type ContractDocumentResponse struct {
+	ID              string    `json:"id"`
+	EmployeeID      string    `json:"employeeId"`
+	TemplateID      string    `json:"templateId"`
+	TemplateVersion int       `json:"templateVersion"`
+	ContractType    string    `json:"contractType"`
+	Format          string    `json:"format"`
+	ContentHash     string    `json:"contentHash"`
+	Size            int       `json:"size"`
+	GeneratedBy     string    `json:"generatedBy,omitempty"`
+	GeneratedAt     time.Time `json:"generatedAt"`
+}
+
+// ContractFileResponse - documento del contrato listo para descargar
+type ContractFileResponse struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+	ContentHash string
+}
+
+func NewContractTemplateResponse(template *entities.ContractTemplate) ContractTemplateResponse {
+	placeholders := template.Placeholders()
+	if placeholders == nil {
+		placeholders = []string{}
+	}
+	return ContractTemplateResponse{
+		ID:           template.ID(),
+		ContractType: string(template.ContractType()),
+		Version:      template.Version(),
+		Title:        template.Title(),
+		Body:         template.Body(),
+		Placeholders: placeholders,
+		CreatedBy:    template.CreatedBy(),
+		CreatedAt:    template.CreatedAt(),
+	}
+}
+
+func NewContractDocumentResponse(document *entities.ContractDocument) ContractDocumentResponse {
+	return ContractDocumentResponse{
+		ID:              document.ID(),
+		EmployeeID:      document.EmployeeID(),
+		TemplateID:      document.TemplateID(),
+		TemplateVersion: document.TemplateVersion(),
+		ContractType:    string(document.ContractType()),
+		Format:          string(document.Format()),
+		ContentHash:     document.ContentHash(),
+		Size:            document.Size(),
+		GeneratedBy:     document.GeneratedBy(),
+		GeneratedAt:     document.GeneratedAt(),
+	}
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+)
+
+// EmployeeDocumentResponse - datos de un documento adjunto al legajo del empleado
+type EmployeeDocumentResponse struct {
+	ID          string     `json:"id"`
+	EmployeeID  string     `json:"employeeId"`
+	Type        string     `json:"type"`
+	FileName    string     `json:"fileName"`
+	ContentType string     `json:"contentType"`
+	Size        int        `json:"size"`
+	ContentHash string     `json:"contentHash"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Expired     bool       `json:"expired"` // vencido a la fecha de la consulta
+	UploadedBy  string     `json:"uploadedBy,omitempty"`
+	UploadedAt  time.Time  `json:"uploadedAt"`
+}
+
+// EmployeeDocumentFileResponse - archivo del documento listo para descargar
+type EmployeeDocumentFileResponse struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+	ContentHash string
+}
+
+func NewEmployeeDocumentResponse(document *entities.EmployeeDocument, at time.Time) EmployeeDocumentResponse {
+	return EmployeeDocumentResponse{
+		ID:          document.ID(),
+		EmployeeID:  document.EmployeeID(),
+		Type:        string(document.DocumentType()),
+		FileName:    document.FileName(),
+		ContentType: document.ContentType(),
+		Size:        document.Size(),
+		ContentHash: document.ContentHash(),
+		ExpiresAt:   document.ExpiresAt(),
+		Expired:     document.IsExpired(at),
+		UploadedBy:  document.UploadedBy(),
+		UploadedAt:  document.UploadedAt(),
+	}
+}
@@ -0,0 +1,211 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// EmployeeFilterRequest - filtros de búsqueda de empleados (parámetros de query).
+// Las fechas usan el formato 2006-01-02.
+type EmployeeFilterRequest struct {
+	Department    string `json:"department"`
+	ContractType  string `json:"contractType" validate:"omitempty,oneof=INDEFINIDO FIJO PRACTICANTE"`
+	Position      string `json:"position"`
+	WorkLocation  string `json:"workLocation"`
+	AFP           string `json:"afp"`
+	PersonType    string `json:"personType" validate:"omitempty,oneof=NATURAL JURIDICAL"`
+	StartDateFrom string `json:"startDateFrom" validate:"omitempty,datetime=2006-01-02"`
+	StartDateTo   string `json:"startDateTo" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// ToFilter convierte los parámetros ya validados en el filtro del repositorio.
+func (f EmployeeFilterRequest) ToFilter() repositories.EmployeeFilter {
+	filter := repositories.EmployeeFilter{
+		Department:   strings.TrimSpace(f.Department),
+		ContractType: f.ContractType,
+		Position:     strings.TrimSpace(f.Position),
+		WorkLocation: strings.TrimSpace(f.WorkLocation),
+		AFP:          strings.TrimSpace(f.AFP),
+		PersonType:   value_objects.PersonType(f.PersonType),
+	}
+	if from, err := time.Parse(time.DateOnly, f.StartDateFrom); err == nil {
+		filter.StartDateFrom = &from
+	}
+	if to, err := time.Parse(time.DateOnly, f.StartDateTo); err == nil {
+		filter.StartDateTo = &to
+	}
+	return filter
+}
+
+// EmployeeExportColumn - columna exportable: extrae el valor ya enmascarado para el viewer.
+// Los datos de la persona se aplanan según su tipo: las columnas de persona natural quedan
+// vacías para una jurídica y viceversa.
+type EmployeeExportColumn struct {
+	Name  string
+	Value func(record repositories.EmployeeRecord, viewer masking.Viewer) any
+}
+
+// DefaultEmployeeExportColumns son las columnas exportadas cuando no se eligen otras.
+var DefaultEmployeeExportColumns = []string{
+	"employeeId", "personType", "documentType", "documentNumber", "fullName", "email", "phone",
+	"contractType", "startDate", "position", "department", "salary", "afp", "eps",
+}
+
+// employeeExportColumns es el catálogo completo, en el orden en que se documenta.
+var employeeExportColumns = []EmployeeExportColumn{
+	{"employeeId", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.ID() }},
+	{"personId", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Person.Person.ID }},
+	{"personType", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return string(r.Person.Person.Type) }},
+	{"documentType", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return exportDocumentType(r.Person) }},
+	{"documentNumber", func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		return v.String(masking.FieldDocumentNumber, exportDocumentNumber(r.Person))
+	}},
+	{"fullName", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return exportFullName(r.Person) }},
+	// NATURAL
+	{"firstName", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.FirstName })},
+	{"lastNamePaternal", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.LastNamePaternal })},
+	{"lastNameMaternal", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.LastNameMaternal })},
+	{"birthDate", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.BirthDate })},
+	{"gender", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.Gender })},
+	{"nationality", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.Nationality })},
+	{"workPermitExpiry", naturalColumn(func(a *aggregates.PersonAggregate) any { return a.NaturalPerson.WorkPermitExpiry })},
+	// JURIDICAL
+	{"businessName", juridicalColumn(func(a *aggregates.PersonAggregate) any { return a.JuridicalPerson.BusinessName })},
+	{"tradeName", juridicalColumn(func(a *aggregates.PersonAggregate) any { return a.JuridicalPerson.TradeName })},
+	{"constitutionDate", juridicalColumn(func(a *aggregates.PersonAggregate) any { return a.JuridicalPerson.ConstitutionDate })},
+	{"representativeName", juridicalColumn(func(a *aggregates.PersonAggregate) any { return a.JuridicalPerson.RepresentativeName })},
+	{"representativeDocument", func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		if r.Person.JuridicalPerson == nil {
+			return nil
+		}
+		return v.String(masking.FieldDocumentNumber, r.Person.JuridicalPerson.RepresentativeDocument)
+	}},
+	// Contacto
+	{"email", func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		return v.String(masking.FieldEmail, string(r.Person.Person.Email))
+	}},
+	{"phone", func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		return v.String(masking.FieldPhone, string(r.Person.Person.Phone))
+	}},
+	{"address", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Person.Person.Address }},
+	{"country", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Person.Person.Country }},
+	// Empleo
+	{"contractType", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.ContractType() }},
+	{"startDate", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.StartDate() }},
+	{"position", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.Position() }},
+	{"department", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.Department() }},
+	{"workSchedule", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.WorkSchedule() }},
+	{"workLocation", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.WorkLocation() }},
+	{"salary", func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		if !v.CanSee(masking.FieldSalary) {
+			return nil
+		}
+		return r.Employee.Salary()
+	}},
+	{"bankAccount", func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		return v.String(masking.FieldBankAccount, r.Employee.BankAccount())
+	}},
+	{"afp", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.AFP() }},
+	{"eps", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.EPS() }},
+	{"cuspp", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.CUSPP() }},
+	{"endDate", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.EndDate() }},
+	{"hasCTS", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.HasCTS() }},
+	{"hasGratification", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.HasGratification() }},
+	{"hasVacation", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.HasVacation() }},
+	{"cts", benefitsColumn(func(r repositories.EmployeeRecord) any { return r.Employee.Benefits().CTS() })},
+	{"gratification", benefitsColumn(func(r repositories.EmployeeRecord) any { return r.Employee.Benefits().Gratification() })},
+	{"vacationDays", benefitsColumn(func(r repositories.EmployeeRecord) any { return r.Employee.Benefits().VacationDays() })},
+	{"version", func(r repositories.EmployeeRecord, _ masking.Viewer) any { return r.Employee.Version() }},
+}
+
+// EmployeeExportColumns devuelve las columnas pedidas en ese orden; unknown lista las que no existen.
+func EmployeeExportColumns(names []string) (columns []EmployeeExportColumn, unknown []string) {
+	for _, name := range names {
+		found := false
+		for _, column := range employeeExportColumns {
+			if strings.EqualFold(column.Name, name) {
+				columns = append(columns, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	return columns, unknown
+}
+
+// EmployeeExportColumnNames lista todas las columnas disponibles.
+func EmployeeExportColumnNames() []string {
+	names := make([]string, len(employeeExportColumns))
+	for i, column := range employeeExportColumns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+func naturalColumn(value func(*aggregates.PersonAggregate) any) func(repositories.EmployeeRecord, masking.Viewer) any {
+	return func(r repositories.EmployeeRecord, _ masking.Viewer) any {
+		if r.Person.NaturalPerson == nil {
+			return nil
+		}
+		return value(r.Person)
+	}
+}
+
+func juridicalColumn(value func(*aggregates.PersonAggregate) any) func(repositories.EmployeeRecord, masking.Viewer) any {
+	return func(r repositories.EmployeeRecord, _ masking.Viewer) any {
+		if r.Person.JuridicalPerson == nil {
+			return nil
+		}
+		return value(r.Person)
+	}
+}
+
+func benefitsColumn(value func(repositories.EmployeeRecord) any) func(repositories.EmployeeRecord, masking.Viewer) any {
+	return func(r repositories.EmployeeRecord, v masking.Viewer) any {
+		if !v.CanSee(masking.FieldBenefits) {
+			return nil
+		}
+		return value(r)
+	}
+}
+
+// exportDocumentType - las personas jurídicas se identifican siempre con RUC.
+func exportDocumentType(agg *aggregates.PersonAggregate) string {
+	switch {
+	case agg.NaturalPerson != nil:
+		return string(agg.NaturalPerson.DocumentType)
+	case agg.JuridicalPerson != nil:
+		return "RUC"
+	}
+	return ""
+}
+
+func exportDocumentNumber(agg *aggregates.PersonAggregate) string {
+	switch {
+	case agg.NaturalPerson != nil:
+		return agg.NaturalPerson.DocumentNumber
+	case agg.JuridicalPerson != nil:
+		return agg.JuridicalPerson.DocumentNumber
+	}
+	return ""
+}
+
+// exportFullName - nombres y apellidos de la persona natural o razón social de la jurídica.
+func exportFullName(agg *aggregates.PersonAggregate) string {
+	switch {
+	case agg.NaturalPerson != nil:
+		np := agg.NaturalPerson
+		return strings.Join(strings.Fields(np.FirstName+" "+np.LastNamePaternal+" "+np.LastNameMaternal), " ")
+	case agg.JuridicalPerson != nil:
+		return agg.JuridicalPerson.BusinessName
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package dto
+
+// Modos de importación masiva de empleados.
+const (
+	// ImportModeDryRun valida y registra cada fila dentro de una transacción que siempre se revierte.
+	ImportModeDryRun = "DRY_RUN"
+	// ImportModeAllOrNothing confirma el archivo completo solo si ninguna fila falla.
+	ImportModeAllOrNothing = "ALL_OR_NOTHING"
+	// ImportModePerRow confirma cada fila válida en su propia transacción.
+	ImportModePerRow = "PER_ROW"
+)
+
+// Estados de una fila en el reporte de importación.
+const (
+	ImportRowCreated    = "CREATED"
+	ImportRowValid      = "VALID"       // dry-run: se habría registrado
+	ImportRowFailed     = "FAILED"      // la fila tiene errores y no se registró
+	ImportRowRolledBack = "ROLLED_BACK" // all-or-nothing: era válida, pero otra fila falló
+)
+
+// EmployeeImportRow - fila del archivo ya convertida al DTO de registro.
+// Err guarda el error de lectura o validación de la fila; en ese caso no se intenta registrar.
+type EmployeeImportRow struct {
+	Line int
+	Data EmployeeRegistrationRequest
+	Err  error
+}
+
+// DocumentNumber devuelve el documento de la persona de la fila, para identificarla en el reporte.
+func (r EmployeeImportRow) DocumentNumber() string {
+	switch {
+	case r.Data.PersonData != nil:
+		return r.Data.PersonData.DocumentNumber
+	case r.Data.PersonRef != nil:
+		return r.Data.PersonRef.DocumentNumber
+	}
+	return ""
+}
+
+// EmployeeImportReport - resultado de una importación masiva, fila por fila.
+type EmployeeImportReport struct {
+	Mode      string                    `json:"mode"`
+	Total     int                       `json:"total"`
+	Succeeded int                       `json:"succeeded"`
+	Failed    int                       `json:"failed"`
+	Committed bool                      `json:"committed"` // si algún registro quedó confirmado en la base de datos
+	Rows      []EmployeeImportRowResult `json:"rows"`
+}
+
+// EmployeeImportRowResult - estado de una fila. Code, Message y Errors se completan en la capa
+// HTTP a partir de Err, con el mismo formato y en el mismo idioma que los errores de la API.
+type EmployeeImportRowResult struct {
+	Line           int              `json:"line"`
+	DocumentNumber string           `json:"documentNumber,omitempty"`
+	Status         string           `json:"status"`
+	EmployeeID     string           `json:"employeeId,omitempty"`
+	Code           string           `json:"code,omitempty"`
+	Message        string           `json:"message,omitempty"`
+	Errors         []ImportRowError `json:"errors,omitempty"`
+	Err            error            `json:"-"`
+}
+
+// ImportRowError - campo rechazado de una fila.
+type ImportRowError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
@@ -6,26 +6,56 @@ import (
 	"github.com/kevinsoras/employee-management/shared/application/dto"
 )
 
-// EmployeeRegistrationRequest - DTO principal para registro de empleado
+// EmployeeRegistrationRequest - DTO principal para registro de empleado.
+// Se envía `person` para registrar una persona nueva, o `existingPerson` para reutilizar
+// una persona ya registrada (recontratación o segundo empleo).
 type EmployeeRegistrationRequest struct {
-	PersonData     dto.PersonRequest `json:"person" validate:"required"`
-	EmploymentData EmploymentData    `json:"employment" validate:"required"`
+	PersonData     *dto.PersonRequest `json:"person,omitempty" validate:"required_without=PersonRef,excluded_with=PersonRef"`
+	PersonRef      *PersonReference   `json:"existingPerson,omitempty" validate:"omitempty"`
+	EmploymentData EmploymentData     `json:"employment" validate:"required"`
+}
+
+// PersonReference - referencia a una persona existente por ID o por documento.
+// PersonUpdate permite sincronizar sus datos de contacto en la misma operación.
+type PersonReference struct {
+	PersonID       string                   `json:"personId" validate:"required_without=DocumentNumber,omitempty,uuid"`
+	DocumentType   string                   `json:"documentType" validate:"omitempty,oneof=DNI CE PASAPORTE PTP CPP RUC"`
+	DocumentNumber string                   `json:"documentNumber" validate:"required_without=PersonID"`
+	PersonUpdate   *dto.PersonUpdateRequest `json:"personUpdate,omitempty" validate:"omitempty"`
 }
 
 // EmploymentData - Datos laborales del empleado
 type EmploymentData struct {
-	Salary       float64   `json:"salary" validate:"required,min=0"`
-	ContractType string    `json:"contractType" validate:"required,oneof=INDEFINIDO FIJO PRACTICANTE"`
-	StartDate    time.Time `json:"startDate" validate:"required"`
-	Position     string    `json:"position" validate:"required"`
-	WorkSchedule string    `json:"workSchedule" validate:"required"`
-	Department   string    `json:"department" validate:"required"`
-	WorkLocation string    `json:"workLocation"`
-	BankAccount  string    `json:"bankAccount"`
-	AFP          string    `json:"afp" validate:"required"`
-	EPS          string    `json:"eps" validate:"required"`
+	Salary       float64    `json:"salary" validate:"required,min=0"`
+	ContractType string     `json:"contractType" validate:"required,oneof=INDEFINIDO FIJO PRACTICANTE"`
+	StartDate    time.Time  `json:"startDate" validate:"required"`
+	Position     string     `json:"position" validate:"required"`
+	WorkSchedule string     `json:"workSchedule" validate:"required"`
+	Department   string     `json:"department" validate:"required"`
+	WorkLocation string     `json:"workLocation"`
+	BankAccount  string     `json:"bankAccount"`
+	AFP          string     `json:"afp" validate:"required"`
+	EPS          string     `json:"eps" validate:"required"`
+	CUSPP        string     `json:"cuspp" validate:"omitempty,len=12,alphanum"`
+	EndDate      *time.Time `json:"endDate"` // Solo para registrar un empleo que ya terminó
 	// Campos específicos de nómina peruana
 	HasCTS           bool `json:"hasCTS"`
 	HasGratification bool `json:"hasGratification"`
 	HasVacation      bool `json:"hasVacation"`
 }
+
+// EmployeeUpdateRequest - DTO para PATCH /employees/{id}: solo se modifican los campos enviados.
+// El tipo de contrato y la fecha de inicio no cambian; un nuevo contrato es un nuevo empleo.
+// El cese se registra enviando endDate.
+type EmployeeUpdateRequest struct {
+	Salary       *float64   `json:"salary" validate:"omitempty,gt=0"`
+	Position     *string    `json:"position" validate:"omitempty,min=1"`
+	WorkSchedule *string    `json:"workSchedule" validate:"omitempty,min=1"`
+	Department   *string    `json:"department" validate:"omitempty,min=1"`
+	WorkLocation *string    `json:"workLocation"`
+	BankAccount  *string    `json:"bankAccount"`
+	AFP          *string    `json:"afp" validate:"omitempty,min=1"`
+	EPS          *string    `json:"eps" validate:"omitempty,min=1"`
+	CUSPP        *string    `json:"cuspp" validate:"omitempty,len=12,alphanum"`
+	EndDate      *time.Time `json:"endDate"` // Registra el cese
+}
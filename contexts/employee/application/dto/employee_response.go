@@ -1,30 +1,35 @@
 package dto
 
 import (
+	"log/slog"
 	"time"
 
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
 	sharedDto "github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
 )
 
 type EmployeeOutput struct {
-	ID               string           `json:"id"`
-	PersonID         string           `json:"personId"`
-	Salary           float64          `json:"salary"`
-	ContractType     string           `json:"contractType"`
-	StartDate        time.Time        `json:"startDate"`
-	Position         string           `json:"position"`
-	WorkSchedule     string           `json:"workSchedule"`
-	Department       string           `json:"department"`
-	WorkLocation     string           `json:"workLocation"`
-	BankAccount      string           `json:"bankAccount"`
-	AFP              string           `json:"afp"`
-	EPS              string           `json:"eps"`
-	HasCTS           bool             `json:"hasCTS"`
-	HasGratification bool             `json:"hasGratification"`
-	HasVacation      bool             `json:"hasVacation"`
-	Benefits         BenefitsResponse `json:"benefits"`
+	ID               string            `json:"id"`
+	PersonID         string            `json:"personId"`
+	Salary           float64           `json:"salary,omitempty"`
+	ContractType     string            `json:"contractType"`
+	StartDate        time.Time         `json:"startDate"`
+	Position         string            `json:"position"`
+	WorkSchedule     string            `json:"workSchedule"`
+	Department       string            `json:"department"`
+	WorkLocation     string            `json:"workLocation"`
+	BankAccount      string            `json:"bankAccount"`
+	AFP              string            `json:"afp"`
+	EPS              string            `json:"eps"`
+	CUSPP            string            `json:"cuspp,omitempty"`
+	EndDate          *time.Time        `json:"endDate,omitempty"`
+	HasCTS           bool              `json:"hasCTS"`
+	HasGratification bool              `json:"hasGratification"`
+	HasVacation      bool              `json:"hasVacation"`
+	Benefits         *BenefitsResponse `json:"benefits,omitempty"`
+	Version          int               `json:"version"` // También se expone como ETag
 }
 
 type EmployeeResponse struct {
@@ -32,36 +37,67 @@ type EmployeeResponse struct {
 	Person     sharedDto.PersonResponse `json:"person"`
 }
 
+// LogValue implementa slog.LogValuer: loguear la respuesta solo deja los identificadores y el
+// documento enmascarado como para un viewer anónimo, aunque se haya armado para un HR_ADMIN
+func (r EmployeeResponse) LogValue() slog.Value {
+	viewer := masking.DefaultPolicy().NewViewer()
+	return slog.GroupValue(
+		slog.Group("employment",
+			slog.String("id", r.Employment.ID),
+			slog.String("contractType", r.Employment.ContractType),
+			slog.String("position", r.Employment.Position),
+			slog.String("department", r.Employment.Department),
+			slog.Int("version", r.Employment.Version),
+		),
+		slog.Group("person",
+			slog.String("id", r.Person.ID),
+			slog.String("type", r.Person.Type),
+			slog.String("documentNumber", viewer.String(masking.FieldDocumentNumber, r.Person.DocumentNumber)),
+		),
+	)
+}
+
 type BenefitsResponse struct {
 	CTS           float64 `json:"cts"`
 	Gratification float64 `json:"gratification"`
 	VacationDays  int     `json:"vacationDays"`
 }
 
-func NewEmployeeResponse(e *entities.Employee, personAgg *aggregates.PersonAggregate) EmployeeResponse {
-	return EmployeeResponse{
+// NewEmployeeResponse arma la respuesta aplicando la política de enmascaramiento del viewer:
+// la cuenta bancaria se muestra como ****1234, el salario y los beneficios se omiten y el documento
+// se enmascara parcialmente para quien no tiene el rol necesario.
+func NewEmployeeResponse(e *entities.Employee, personAgg *aggregates.PersonAggregate, viewer masking.Viewer) EmployeeResponse {
+	resp := EmployeeResponse{
 		Employment: EmployeeOutput{
 			ID:               e.ID(),
 			PersonID:         e.PersonID(),
-			Salary:           e.Salary(),
 			ContractType:     e.ContractType(),
 			StartDate:        e.StartDate(),
 			Position:         e.Position(),
 			WorkSchedule:     e.WorkSchedule(),
 			Department:       e.Department(),
 			WorkLocation:     e.WorkLocation(),
-			BankAccount:      e.BankAccount(),
+			BankAccount:      viewer.String(masking.FieldBankAccount, e.BankAccount()),
 			AFP:              e.AFP(),
 			EPS:              e.EPS(),
+			CUSPP:            e.CUSPP(),
+			EndDate:          e.EndDate(),
 			HasCTS:           e.HasCTS(),
 			HasGratification: e.HasGratification(),
 			HasVacation:      e.HasVacation(),
-			Benefits: BenefitsResponse{
-				CTS:           e.Benefits().CTS(),
-				Gratification: e.Benefits().Gratification(),
-				VacationDays:  e.Benefits().VacationDays(),
-			},
+			Version:          e.Version(),
 		},
-		Person: sharedDto.NewPersonResponse(personAgg),
+		Person: sharedDto.NewPersonResponse(personAgg).Masked(viewer),
+	}
+	if viewer.CanSee(masking.FieldSalary) {
+		resp.Employment.Salary = e.Salary()
+	}
+	if viewer.CanSee(masking.FieldBenefits) {
+		resp.Employment.Benefits = &BenefitsResponse{
+			CTS:           e.Benefits().CTS(),
+			Gratification: e.Benefits().Gratification(),
+			VacationDays:  e.Benefits().VacationDays(),
+		}
 	}
+	return resp
 }
@@ -0,0 +1,48 @@
+package dto_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	sharedDto "github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/logger"
+)
+
+func TestEmployeeResponse_LogValueMasksAnUnmaskedResponse(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(logger.NewRedactingHandler(slog.NewTextHandler(&buf, nil), masking.DefaultPolicy()))
+	// As built for an HR_ADMIN, who sees everything
+	resp := dto.EmployeeResponse{
+		Employment: dto.EmployeeOutput{
+			ID:          "emp-1",
+			Salary:      5000,
+			Position:    "Analyst",
+			BankAccount: "19112345678901",
+			Benefits:    &dto.BenefitsResponse{CTS: 2500},
+		},
+		Person: sharedDto.PersonResponse{
+			ID:             "per-1",
+			Type:           "NATURAL",
+			Email:          "juan.perez@empresa.pe",
+			Phone:          "987654321",
+			DocumentNumber: "45678912",
+			FirstName:      "Juan",
+		},
+	}
+
+	log.Info("registered", "employee", resp)
+
+	out := buf.String()
+	assert.Contains(t, out, "employee.employment.id=emp-1")
+	assert.Contains(t, out, "employee.employment.position=Analyst")
+	assert.Contains(t, out, "employee.person.id=per-1")
+	assert.Contains(t, out, "employee.person.documentNumber=45****12")
+	for _, leaked := range []string{"5000", "2500", "19112345678901", "juan.perez", "987654321", "45678912", "Juan"} {
+		assert.NotContains(t, out, leaked)
+	}
+}
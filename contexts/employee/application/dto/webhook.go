@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+)
+
+// WebhookSubscriptionRequest - DTO para POST /webhook-subscriptions. Sin secreto se genera uno,
+// que se devuelve solo en esta respuesta
+type WebhookSubscriptionRequest struct {
+	URL         string   `json:"url" validate:"required,max=2048"`
+	Secret      string   `json:"secret" validate:"omitempty,min=16,max=255"`
+	Events      []string `json:"events" validate:"required,min=1"`
+	Description string   `json:"description" validate:"max=255"`
+}
+
+// WebhookSubscriptionUpdateRequest - DTO para PUT /webhook-subscriptions/{id}. Reemplaza el destino
+// y los eventos; sin active se conserva el estado actual. rotateSecret cambia el secreto por secret
+// o, si no se envía, por uno generado
+type WebhookSubscriptionUpdateRequest struct {
+	URL          string   `json:"url" validate:"required,max=2048"`
+	Events       []string `json:"events" validate:"required,min=1"`
+	Description  string   `json:"description" validate:"max=255"`
+	Active       *bool    `json:"active"`
+	RotateSecret bool     `json:"rotateSecret"`
+	Secret       string   `json:"secret" validate:"omitempty,min=16,max=255"`
+}
+
+// WebhookDeliveriesRequest - filtros de GET /webhook-subscriptions/{id}/deliveries, leídos de la
+// query string; sin limit se devuelven las 50 entregas más recientes
+type WebhookDeliveriesRequest struct {
+	Status string `json:"status" validate:"omitempty,oneof=PENDING DELIVERED FAILED"`
+	Limit  string `json:"limit" validate:"omitempty,numeric"`
+}
+
+type WebhookSubscriptionResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"secret,omitempty"` // Solo al crear la suscripción o rotar el secreto
+	Events      []string  `json:"events"`
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedBy   string    `json:"createdBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// WebhookDeliveryResponse - registro de una entrega y de su último intento
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscriptionId"`
+	EventID        string          `json:"eventId"`
+	EventName      string          `json:"eventName"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"` // Solo mientras está pendiente
+	LastStatusCode int             `json:"lastStatusCode,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	LastAttemptAt  *time.Time      `json:"lastAttemptAt,omitempty"`
+	DeliveredAt    *time.Time      `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+// WebhookPayload - cuerpo JSON de cada entrega. ID identifica el evento y es el mismo en todas las
+// suscripciones y reintentos, así el destino descarta los duplicados
+type WebhookPayload struct {
+	ID         string          `json:"id"`
+	Event      string          `json:"event"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewWebhookSubscriptionResponse arma la respuesta sin el secreto.
+func NewWebhookSubscriptionResponse(subscription *entities.WebhookSubscription) WebhookSubscriptionResponse {
+	return WebhookSubscriptionResponse{
+		ID:          subscription.ID(),
+		URL:         subscription.URL(),
+		Events:      subscription.Events(),
+		Description: subscription.Description(),
+		Active:      subscription.Active(),
+		CreatedBy:   subscription.CreatedBy(),
+		CreatedAt:   subscription.CreatedAt(),
+		UpdatedAt:   subscription.UpdatedAt(),
+	}
+}
+
+func NewWebhookDeliveryResponse(delivery *entities.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             delivery.ID(),
+		SubscriptionID: delivery.SubscriptionID(),
+		EventID:        delivery.EventID(),
+		EventName:      delivery.EventName(),
+		Payload:        delivery.Payload(),
+		Status:         string(delivery.Status()),
+		Attempts:       delivery.Attempts(),
+		LastStatusCode: delivery.LastStatusCode(),
+		LastError:      delivery.LastError(),
+		LastAttemptAt:  delivery.LastAttemptAt(),
+		DeliveredAt:    delivery.DeliveredAt(),
+		CreatedAt:      delivery.CreatedAt(),
+	}
+	if delivery.Status() == entities.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt()
+		resp.NextAttemptAt = &nextAttemptAt
+	}
+	return resp
+}
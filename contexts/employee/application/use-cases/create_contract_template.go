@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+)
+
+// CreateContractTemplateCommand carries the new template text and who writes it.
+type CreateContractTemplateCommand struct {
+	ExecutingUserID string
+	Data            employeedto.ContractTemplateRequest
+}
+
+// CreateContractTemplateUseCase publishes a new version of the template of a contract type.
+// Templates are never edited in place, so every generated contract can be traced to the exact
+// text it was filled from.
+type CreateContractTemplateUseCase struct {
+	templateRepo repositories.ContractTemplateRepository
+}
+
+// NewCreateContractTemplateUseCase creates a new CreateContractTemplateUseCase.
+func NewCreateContractTemplateUseCase(templateRepo repositories.ContractTemplateRepository) *CreateContractTemplateUseCase {
+	return &CreateContractTemplateUseCase{templateRepo: templateRepo}
+}
+
+// Execute numbers the template after the latest version; two concurrent publications of the same
+// contract type collide on the version and the second one fails with a conflict.
+func (uc *CreateContractTemplateUseCase) Execute(ctx context.Context, cmd CreateContractTemplateCommand) (employeedto.ContractTemplateResponse, error) {
+	contractType, err := value_objects.NewContractType(cmd.Data.ContractType)
+	if err != nil {
+		return employeedto.ContractTemplateResponse{}, err
+	}
+	latest, err := uc.templateRepo.GetLatestTemplate(ctx, contractType)
+	if err != nil {
+		return employeedto.ContractTemplateResponse{}, fmt.Errorf("error loading contract template: %w", err)
+	}
+	version := 1
+	if latest != nil {
+		version = latest.Version() + 1
+	}
+
+	template, err := entities.NewContractTemplate(contractType, version, cmd.Data.Title, cmd.Data.Body, cmd.ExecutingUserID)
+	if err != nil {
+		return employeedto.ContractTemplateResponse{}, err
+	}
+	if err := uc.templateRepo.SaveTemplate(ctx, template); err != nil {
+		return employeedto.ContractTemplateResponse{}, fmt.Errorf("error saving contract template: %w", err)
+	}
+	return employeedto.NewContractTemplateResponse(template), nil
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+)
+
+// CreateWebhookSubscriptionCommand carries the endpoint to register and who registers it.
+type CreateWebhookSubscriptionCommand struct {
+	ExecutingUserID string
+	Data            employeedto.WebhookSubscriptionRequest
+}
+
+// CreateWebhookSubscriptionUseCase registers an external system to receive employee lifecycle
+// events. The signing secret is returned only in this response; afterwards it can be rotated but
+// not read back.
+type CreateWebhookSubscriptionUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	sender           services.WebhookSender // Decides which endpoints may be registered
+}
+
+// NewCreateWebhookSubscriptionUseCase creates a new CreateWebhookSubscriptionUseCase.
+func NewCreateWebhookSubscriptionUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository,
+	sender services.WebhookSender) *CreateWebhookSubscriptionUseCase {
+	return &CreateWebhookSubscriptionUseCase{subscriptionRepo: subscriptionRepo, sender: sender}
+}
+
+// Execute generates the secret when none is given. Only events raised after the subscription
+// exists are delivered.
+func (uc *CreateWebhookSubscriptionUseCase) Execute(ctx context.Context, cmd CreateWebhookSubscriptionCommand) (employeedto.WebhookSubscriptionResponse, error) {
+	subscription, err := entities.NewWebhookSubscription(cmd.Data.URL, cmd.Data.Secret, cmd.Data.Events, cmd.Data.Description, cmd.ExecutingUserID)
+	if err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+	if err := checkWebhookURL(uc.sender, subscription); err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+	if err := uc.subscriptionRepo.SaveSubscription(ctx, subscription); err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, fmt.Errorf("error saving webhook subscription: %w", err)
+	}
+	resp := employeedto.NewWebhookSubscriptionResponse(subscription)
+	resp.Secret = subscription.Secret()
+	return resp, nil
+}
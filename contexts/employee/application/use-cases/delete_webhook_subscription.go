@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+)
+
+// DeleteWebhookSubscriptionCommand identifies the subscription to remove.
+type DeleteWebhookSubscriptionCommand struct {
+	ExecutingUserID string
+	SubscriptionID  string
+}
+
+// DeleteWebhookSubscriptionUseCase removes a subscription together with its delivery log; the
+// pending deliveries are dropped. Pausing it (active=false) keeps both instead.
+type DeleteWebhookSubscriptionUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+}
+
+// NewDeleteWebhookSubscriptionUseCase creates a new DeleteWebhookSubscriptionUseCase.
+func NewDeleteWebhookSubscriptionUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository) *DeleteWebhookSubscriptionUseCase {
+	return &DeleteWebhookSubscriptionUseCase{subscriptionRepo: subscriptionRepo}
+}
+
+// Execute returns the deleted subscription; it fails with NOT_FOUND when it does not exist.
+func (uc *DeleteWebhookSubscriptionUseCase) Execute(ctx context.Context, cmd DeleteWebhookSubscriptionCommand) (employeedto.WebhookSubscriptionResponse, error) {
+	subscription, err := loadWebhookSubscription(ctx, uc.subscriptionRepo, cmd.SubscriptionID)
+	if err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+	if err := uc.subscriptionRepo.DeleteSubscription(ctx, subscription.ID()); err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, fmt.Errorf("error deleting webhook subscription: %w", err)
+	}
+	return employeedto.NewWebhookSubscriptionResponse(subscription), nil
+}
@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// DeliverWebhooksConfig tunes how the claimed deliveries are sent and retried.
+type DeliverWebhooksConfig struct {
+	// LeaseDuration hides the claimed deliveries from the other workers while they are sent; it
+	// must outlast sending a whole batch
+	LeaseDuration time.Duration
+	Retry         domain.RetryPolicy
+}
+
+// DeliverWebhooksUseCase sends the due webhook deliveries to their subscriptions; the outbox relay
+// process runs it next to the relay with an application.Poller. Failed attempts are retried with
+// exponential backoff until the retry policy gives up and the delivery is left FAILED in the log.
+// Delivery is at least once: subscribers deduplicate by the event ID of the payload.
+type DeliverWebhooksUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+	sender           services.WebhookSender
+	config           DeliverWebhooksConfig
+	logger           *slog.Logger
+	now              func() time.Time
+}
+
+// NewDeliverWebhooksUseCase creates a new DeliverWebhooksUseCase. Without a lease or retry policy
+// it waits five minutes for a batch and gives a delivery eight attempts, backing off from thirty
+// seconds up to six hours.
+func NewDeliverWebhooksUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository, deliveryRepo repositories.WebhookDeliveryRepository,
+	sender services.WebhookSender, config DeliverWebhooksConfig, logger *slog.Logger) *DeliverWebhooksUseCase {
+	if config.LeaseDuration <= 0 {
+		config.LeaseDuration = 5 * time.Minute
+	}
+	if config.Retry.MaxAttempts <= 0 {
+		config.Retry.MaxAttempts = 8
+	}
+	if config.Retry.BaseDelay <= 0 {
+		config.Retry.BaseDelay = 30 * time.Second
+	}
+	if config.Retry.MaxDelay <= 0 {
+		config.Retry.MaxDelay = 6 * time.Hour
+	}
+	return &DeliverWebhooksUseCase{subscriptionRepo: subscriptionRepo, deliveryRepo: deliveryRepo, sender: sender,
+		config: config, logger: logger, now: time.Now}
+}
+
+// Execute sends up to limit due deliveries and returns how many it claimed. A rejected delivery is
+// recorded in the log, not returned: only storage errors are. The batch is claimed with a lease
+// that commits right away and each attempt is saved on its own, so no transaction stays open
+// while the subscribers answer; a delivery whose attempt was not saved is sent again once its
+// lease expires.
+func (uc *DeliverWebhooksUseCase) Execute(ctx context.Context, limit int) (int, error) {
+	now := uc.now()
+	deliveries, err := uc.deliveryRepo.ClaimDueDeliveries(ctx, limit, now, now.Add(uc.config.LeaseDuration))
+	if err != nil {
+		return 0, fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+	var errs []error
+	// A batch usually holds several deliveries of the same few subscriptions
+	subscriptions := make(map[string]*entities.WebhookSubscription)
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionID()]
+		if !ok {
+			if subscription, err = uc.subscriptionRepo.GetSubscription(ctx, delivery.SubscriptionID()); err != nil {
+				errs = append(errs, fmt.Errorf("error loading webhook subscription: %w", err))
+				continue
+			}
+			subscriptions[delivery.SubscriptionID()] = subscription
+		}
+		if subscription == nil {
+			continue // Deleted meanwhile: its deliveries are gone with it
+		}
+		uc.send(ctx, subscription, delivery)
+		if err := uc.deliveryRepo.SaveAttempt(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("error saving webhook delivery: %w", err))
+		}
+	}
+	return len(deliveries), errors.Join(errs...)
+}
+
+func (uc *DeliverWebhooksUseCase) send(ctx context.Context, subscription *entities.WebhookSubscription, delivery *entities.WebhookDelivery) {
+	statusCode, err := uc.sender.Send(ctx, services.WebhookRequest{
+		URL:        subscription.URL(),
+		Secret:     subscription.Secret(),
+		DeliveryID: delivery.ID(),
+		EventID:    delivery.EventID(),
+		EventName:  delivery.EventName(),
+		Body:       delivery.Payload(),
+	})
+	if err == nil {
+		delivery.MarkDelivered(statusCode, uc.now())
+		return
+	}
+	if delivery.MarkFailed(statusCode, err, uc.config.Retry, uc.now()) {
+		uc.logger.ErrorContext(ctx, "Webhook delivery failed for good", "deliveryID", delivery.ID(), "subscriptionID", subscription.ID(),
+			"event", delivery.EventName(), "attempts", delivery.Attempts(), "error", err)
+		return
+	}
+	uc.logger.WarnContext(ctx, "Webhook delivery failed, will retry", "deliveryID", delivery.ID(), "subscriptionID", subscription.ID(),
+		"event", delivery.EventName(), "attempts", delivery.Attempts(), "nextAttemptAt", delivery.NextAttemptAt(), "error", err)
+}
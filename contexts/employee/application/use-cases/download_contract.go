@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// DownloadContractQuery identifies the contract to download.
+type DownloadContractQuery struct {
+	DocumentID string
+}
+
+// DownloadContractUseCase returns a stored contract document.
+type DownloadContractUseCase struct {
+	documentRepo repositories.ContractDocumentRepository
+}
+
+// NewDownloadContractUseCase creates a new DownloadContractUseCase.
+func NewDownloadContractUseCase(documentRepo repositories.ContractDocumentRepository) *DownloadContractUseCase {
+	return &DownloadContractUseCase{documentRepo: documentRepo}
+}
+
+// Execute checks the document against the hash recorded when it was generated, so a tampered
+// document is never handed out for signature.
+func (uc *DownloadContractUseCase) Execute(ctx context.Context, query DownloadContractQuery) (employeedto.ContractFileResponse, error) {
+	document, err := uc.documentRepo.GetDocumentByID(ctx, query.DocumentID)
+	if err != nil {
+		return employeedto.ContractFileResponse{}, fmt.Errorf("error loading contract: %w", err)
+	}
+	if document == nil {
+		return employeedto.ContractFileResponse{}, domain.NewNotFoundError("contract.not_found", nil)
+	}
+	content, err := uc.documentRepo.GetDocumentContent(ctx, document.ID())
+	if err != nil {
+		return employeedto.ContractFileResponse{}, fmt.Errorf("error loading contract content: %w", err)
+	}
+	if !document.Matches(content) {
+		return employeedto.ContractFileResponse{}, fmt.Errorf("contract %s does not match its content hash", document.ID())
+	}
+	return employeedto.ContractFileResponse{
+		FileName: fmt.Sprintf("contrato-%s-%s-v%d.%s", strings.ToLower(string(document.ContractType())),
+			document.EmployeeID(), document.TemplateVersion(), document.Format().Extension()),
+		ContentType: document.Format().ContentType(),
+		Content:     content,
+		ContentHash: document.ContentHash(),
+	}, nil
+}
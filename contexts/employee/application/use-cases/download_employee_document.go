@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// DownloadEmployeeDocumentQuery identifies the document to download.
+type DownloadEmployeeDocumentQuery struct {
+	DocumentID string
+}
+
+// DownloadEmployeeDocumentUseCase returns the file of an employee document from the blob store.
+type DownloadEmployeeDocumentUseCase struct {
+	documentRepo repositories.EmployeeDocumentRepository
+	blobStore    services.BlobStore
+}
+
+// NewDownloadEmployeeDocumentUseCase creates a new DownloadEmployeeDocumentUseCase.
+func NewDownloadEmployeeDocumentUseCase(documentRepo repositories.EmployeeDocumentRepository, blobStore services.BlobStore) *DownloadEmployeeDocumentUseCase {
+	return &DownloadEmployeeDocumentUseCase{documentRepo: documentRepo, blobStore: blobStore}
+}
+
+// Execute checks the file against the hash recorded on upload, so a file replaced in the storage
+// behind the application's back is never handed out.
+func (uc *DownloadEmployeeDocumentUseCase) Execute(ctx context.Context, query DownloadEmployeeDocumentQuery) (employeedto.EmployeeDocumentFileResponse, error) {
+	document, err := uc.documentRepo.GetDocumentByID(ctx, query.DocumentID)
+	if err != nil {
+		return employeedto.EmployeeDocumentFileResponse{}, fmt.Errorf("error loading document: %w", err)
+	}
+	if document == nil {
+		return employeedto.EmployeeDocumentFileResponse{}, domain.NewNotFoundError("document.not_found", nil)
+	}
+	content, err := uc.blobStore.Get(ctx, document.StorageKey())
+	if errors.Is(err, services.ErrBlobNotFound) {
+		return employeedto.EmployeeDocumentFileResponse{}, fmt.Errorf("file %s of document %s is missing from the storage", document.StorageKey(), document.ID())
+	}
+	if err != nil {
+		return employeedto.EmployeeDocumentFileResponse{}, fmt.Errorf("error loading document file: %w", err)
+	}
+	if !document.Matches(content) {
+		return employeedto.EmployeeDocumentFileResponse{}, fmt.Errorf("document %s does not match its content hash", document.ID())
+	}
+	return employeedto.EmployeeDocumentFileResponse{
+		FileName:    document.FileName(),
+		ContentType: document.ContentType(),
+		Content:     content,
+		ContentHash: document.ContentHash(),
+	}, nil
+}
@@ -0,0 +1,180 @@
+package usecases_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+var pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+// memoryBlobStore keeps the blobs in memory
+type memoryBlobStore struct {
+	blobs map[string][]byte
+}
+
+func (s *memoryBlobStore) Put(_ context.Context, key string, content []byte, _ string) error {
+	s.blobs[key] = content
+	return nil
+}
+
+func (s *memoryBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	content, ok := s.blobs[key]
+	if !ok {
+		return nil, services.ErrBlobNotFound
+	}
+	return content, nil
+}
+
+func (s *memoryBlobStore) Delete(_ context.Context, key string) error {
+	delete(s.blobs, key)
+	return nil
+}
+
+// documentStore keeps the document metadata in memory; saveErr makes SaveDocument fail
+type documentStore struct {
+	documents []*entities.EmployeeDocument
+	saveErr   error
+}
+
+func (s *documentStore) SaveDocument(_ context.Context, document *entities.EmployeeDocument) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.documents = append(s.documents, document)
+	return nil
+}
+
+func (s *documentStore) ListDocumentsByEmployee(_ context.Context, employeeID string) ([]*entities.EmployeeDocument, error) {
+	var documents []*entities.EmployeeDocument
+	for _, document := range s.documents {
+		if document.EmployeeID() == employeeID {
+			documents = append(documents, document)
+		}
+	}
+	return documents, nil
+}
+
+func (s *documentStore) GetDocumentByID(_ context.Context, id string) (*entities.EmployeeDocument, error) {
+	for _, document := range s.documents {
+		if document.ID() == id {
+			return document, nil
+		}
+	}
+	return nil, nil
+}
+
+func documentUseCases(t *testing.T) (*entities.Employee, *documentStore, *memoryBlobStore, *usecases.UploadEmployeeDocumentUseCase) {
+	t.Helper()
+	employee := existingEmployee(t, "per-1")
+	employeeRepo := new(MockEmployeeRepository)
+	employeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+	employeeRepo.On("GetEmployeeByID", mock.Anything, mock.Anything).Return(nil, nil)
+	store := &documentStore{}
+	blobs := &memoryBlobStore{blobs: map[string][]byte{}}
+	return employee, store, blobs, usecases.NewUploadEmployeeDocumentUseCase(employeeRepo, store, blobs)
+}
+
+func TestUploadEmployeeDocumentUseCase_Execute_StoresFileAndMetadata(t *testing.T) {
+	employee, store, blobs, uc := documentUseCases(t)
+
+	resp, err := uc.Execute(context.Background(), usecases.UploadEmployeeDocumentCommand{
+		EmployeeID: employee.ID(), Type: "documento_identidad", ExpiresAt: "2020-01-31",
+		FileName: `C:\scans\dni "frente".png`, Content: pngContent, ExecutingUserID: "u-1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "DOCUMENTO_IDENTIDAD", resp.Type)
+	assert.Equal(t, "image/png", resp.ContentType, "the type is detected from the content")
+	assert.Equal(t, "dni frente.png", resp.FileName)
+	assert.Equal(t, "u-1", resp.UploadedBy)
+	assert.True(t, resp.Expired)
+	require.Len(t, store.documents, 1)
+	assert.Equal(t, pngContent, blobs.blobs["employees/"+employee.ID()+"/"+resp.ID])
+}
+
+func TestUploadEmployeeDocumentUseCase_Execute_RejectsInvalidFiles(t *testing.T) {
+	employee, store, blobs, uc := documentUseCases(t)
+	upload := func(content []byte) *sharedDomain.DomainError {
+		_, err := uc.Execute(context.Background(), usecases.UploadEmployeeDocumentCommand{
+			EmployeeID: employee.ID(), Type: "OTRO", FileName: "file.pdf", Content: content,
+		})
+		var domainErr *sharedDomain.DomainError
+		require.ErrorAs(t, err, &domainErr)
+		return domainErr
+	}
+
+	domainErr := upload([]byte("MZ\x90\x00 not really a pdf"))
+	assert.Equal(t, http.StatusUnsupportedMediaType, domainErr.HTTPStatusCode)
+	assert.Equal(t, "document.mime_not_allowed", domainErr.MessageKey)
+
+	domainErr = upload(append([]byte("%PDF-1.7\n"), make([]byte, entities.MaxEmployeeDocumentSize)...))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, domainErr.HTTPStatusCode)
+
+	_, err := uc.Execute(context.Background(), usecases.UploadEmployeeDocumentCommand{
+		EmployeeID: employee.ID(), Type: "PASAPORTE", ExpiresAt: "31/01/2026", Content: pngContent,
+	})
+	var inputErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &inputErr)
+	assert.Equal(t, http.StatusBadRequest, inputErr.HTTPStatusCode)
+	require.Len(t, inputErr.Fields, 2)
+	assert.Equal(t, "type", inputErr.Fields[0].Field)
+	assert.Equal(t, "expiresAt", inputErr.Fields[1].Field)
+
+	_, err = uc.Execute(context.Background(), usecases.UploadEmployeeDocumentCommand{EmployeeID: "missing", Type: "OTRO", Content: pngContent})
+	require.ErrorAs(t, err, &inputErr)
+	assert.Equal(t, http.StatusNotFound, inputErr.HTTPStatusCode)
+
+	assert.Empty(t, store.documents)
+	assert.Empty(t, blobs.blobs)
+}
+
+func TestUploadEmployeeDocumentUseCase_Execute_RemovesTheFileWhenMetadataFails(t *testing.T) {
+	employee, store, blobs, uc := documentUseCases(t)
+	store.saveErr = errors.New("connection reset")
+
+	_, err := uc.Execute(context.Background(), usecases.UploadEmployeeDocumentCommand{
+		EmployeeID: employee.ID(), Type: "TITULO", FileName: "titulo.png", Content: pngContent,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, blobs.blobs)
+}
+
+func TestDownloadEmployeeDocumentUseCase_Execute_VerifiesTheHash(t *testing.T) {
+	employee, store, blobs, uc := documentUseCases(t)
+	uploaded, err := uc.Execute(context.Background(), usecases.UploadEmployeeDocumentCommand{
+		EmployeeID: employee.ID(), Type: "CERTIFICADO_MEDICO", ExpiresAt: time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		FileName: "certificado.png", Content: pngContent,
+	})
+	require.NoError(t, err)
+	assert.False(t, uploaded.Expired)
+	download := usecases.NewDownloadEmployeeDocumentUseCase(store, blobs)
+
+	file, err := download.Execute(context.Background(), usecases.DownloadEmployeeDocumentQuery{DocumentID: uploaded.ID})
+	require.NoError(t, err)
+	assert.Equal(t, pngContent, file.Content)
+	assert.Equal(t, "certificado.png", file.FileName)
+	assert.Equal(t, uploaded.ContentHash, file.ContentHash)
+
+	blobs.blobs["employees/"+employee.ID()+"/"+uploaded.ID] = []byte("\x89PNG tampered")
+	_, err = download.Execute(context.Background(), usecases.DownloadEmployeeDocumentQuery{DocumentID: uploaded.ID})
+	assert.ErrorContains(t, err, "does not match")
+
+	_, err = download.Execute(context.Background(), usecases.DownloadEmployeeDocumentQuery{DocumentID: "missing"})
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "document.not_found", domainErr.MessageKey)
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	sharedEntities "github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+// EnqueueWebhookDeliveriesUseCase fans an outbox message out to the webhook subscriptions: it
+// queues one delivery per active subscription that listens to the event. The outbox relay runs it
+// for every message it publishes, so a delivery exists only for committed changes, and running it
+// again for the same message queues nothing new.
+type EnqueueWebhookDeliveriesUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+}
+
+// NewEnqueueWebhookDeliveriesUseCase creates a new EnqueueWebhookDeliveriesUseCase.
+func NewEnqueueWebhookDeliveriesUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository,
+	deliveryRepo repositories.WebhookDeliveryRepository) *EnqueueWebhookDeliveriesUseCase {
+	return &EnqueueWebhookDeliveriesUseCase{subscriptionRepo: subscriptionRepo, deliveryRepo: deliveryRepo}
+}
+
+// Execute returns how many subscriptions receive the event. The event ID of the payload is the message ID, so
+// every subscriber receives the same body and the same ID the other transports publish.
+func (uc *EnqueueWebhookDeliveriesUseCase) Execute(ctx context.Context, message *sharedEntities.OutboxMessage) (int, error) {
+	if !slices.Contains(entities.WebhookEvents, message.EventName) {
+		return 0, nil
+	}
+	subscriptions, err := uc.subscriptionRepo.ListSubscribers(ctx, message.EventName)
+	if err != nil {
+		return 0, fmt.Errorf("error loading webhook subscribers: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return 0, nil
+	}
+
+	payload, err := json.Marshal(employeedto.WebhookPayload{ID: message.ID, Event: message.EventName,
+		OccurredAt: message.OccurredAt, Data: message.Payload})
+	if err != nil {
+		return 0, fmt.Errorf("error serializing webhook payload: %w", err)
+	}
+
+	deliveries := make([]*entities.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, entities.NewWebhookDelivery(subscription.ID(), message.ID, message.EventName, payload))
+	}
+	if err := uc.deliveryRepo.SaveDeliveries(ctx, deliveries...); err != nil {
+		return 0, fmt.Errorf("error saving webhook deliveries: %w", err)
+	}
+	return len(deliveries), nil
+}
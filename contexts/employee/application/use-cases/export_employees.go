@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// EmployeeExportSink receives the export: the header once, then one row per employee.
+type EmployeeExportSink interface {
+	WriteHeader(columns []string) error
+	WriteRow(values []any) error
+}
+
+// ExportEmployeesQuery selects the employees and the columns to export, and where to write them.
+type ExportEmployeesQuery struct {
+	Filter  employeedto.EmployeeFilterRequest
+	Columns []string // empty exports employeedto.DefaultEmployeeExportColumns
+	Sink    EmployeeExportSink
+}
+
+// ExportEmployeesResult summarizes a finished export.
+type ExportEmployeesResult struct {
+	Rows int
+}
+
+// ExportEmployeesUseCase streams the filtered employees to a sink, masking what the caller's
+// roles cannot see. Rows go from the database cursor to the sink one by one.
+type ExportEmployeesUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+}
+
+// NewExportEmployeesUseCase creates a new ExportEmployeesUseCase.
+func NewExportEmployeesUseCase(employeeRepo repositories.EmployeeRepository) *ExportEmployeesUseCase {
+	return &ExportEmployeesUseCase{employeeRepo: employeeRepo}
+}
+
+// Execute writes the header lazily, with the first row: a failure before any employee is read
+// leaves the sink untouched, so the caller can still answer with an error.
+func (uc *ExportEmployeesUseCase) Execute(ctx context.Context, query ExportEmployeesQuery) (ExportEmployeesResult, error) {
+	names := query.Columns
+	if len(names) == 0 {
+		names = employeedto.DefaultEmployeeExportColumns
+	}
+	columns, unknown := employeedto.EmployeeExportColumns(names)
+	if len(unknown) > 0 {
+		fieldErrs := make([]domain.FieldError, len(unknown))
+		for i, name := range unknown {
+			fieldErrs[i] = *domain.NewFieldError("columns", "oneof", "export.unknown_column", domain.Params{"column": name})
+		}
+		return ExportEmployeesResult{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(fieldErrs...)
+	}
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+
+	viewer := masking.ViewerFromContext(ctx)
+	result := ExportEmployeesResult{}
+	values := make([]any, len(columns))
+	err := uc.employeeRepo.StreamEmployees(ctx, query.Filter.ToFilter(), func(record repositories.EmployeeRecord) error {
+		if result.Rows == 0 {
+			if err := query.Sink.WriteHeader(header); err != nil {
+				return err
+			}
+		}
+		for i, column := range columns {
+			values[i] = column.Value(record, viewer)
+		}
+		result.Rows++
+		return query.Sink.WriteRow(values)
+	})
+	if err != nil {
+		return result, fmt.Errorf("error exporting employees: %w", err)
+	}
+	if result.Rows == 0 {
+		if err := query.Sink.WriteHeader(header); err != nil {
+			return result, fmt.Errorf("error exporting employees: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	employee_value_objects "github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	entities_shared "github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	shared_vo "github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// recordingSink keeps what the export writes
+type recordingSink struct {
+	header []string
+	rows   [][]any
+}
+
+func (s *recordingSink) WriteHeader(columns []string) error {
+	s.header = columns
+	return nil
+}
+
+func (s *recordingSink) WriteRow(values []any) error {
+	s.rows = append(s.rows, append([]any(nil), values...))
+	return nil
+}
+
+func exportRecord(person *aggregates.PersonAggregate) repositories.EmployeeRecord {
+	benefits, _ := employee_value_objects.NewBenefits(1750, 3000, 30)
+	employee := entities.NewEmployeeBuilder(person.Person.ID, 3000, "INDEFINIDO", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)).
+		WithJobDetails("Analyst", "Finance", "L-V", "Lima").
+		WithPayroll("19112345678901", "Integra", "Rimac").
+		Restore("emp-1", benefits, 1, time.Now(), time.Now())
+	return repositories.EmployeeRecord{Employee: employee, Person: person}
+}
+
+func juridicalPersonAggregate() *aggregates.PersonAggregate {
+	person := entities_shared.NewPerson(shared_vo.Juridical, "contacto@acme.pe", "014567890", "Av. Javier Prado 100", "Peru")
+	name, trade, representative, representativeDoc := "ACME S.A.C.", "Acme", "Luis Rojas", "40123456"
+	constitution := time.Date(2010, 5, 1, 0, 0, 0, 0, time.UTC)
+	juridical, _ := entities_shared.NewJuridicalPerson(person.ID, "20123456789", &name, &trade, &representative, &representativeDoc, &constitution)
+	return aggregates.NewPersonAggregate(person, nil, juridical)
+}
+
+func contextWithRoles(roles ...security.Role) context.Context {
+	return security.WithPrincipal(context.Background(), &security.Principal{UserID: "user-1", Roles: roles})
+}
+
+func TestExportEmployeesUseCase_Execute_FlattensPersonByType(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	useCase := usecases.NewExportEmployeesUseCase(mockEmployeeRepo)
+	filter := employeedto.EmployeeFilterRequest{Department: "Finance", StartDateFrom: "2024-01-01"}
+	mockEmployeeRepo.On("StreamEmployees", mock.Anything, mock.MatchedBy(func(f repositories.EmployeeFilter) bool {
+		return f.Department == "Finance" && f.StartDateFrom != nil && f.StartDateFrom.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	})).Return([]repositories.EmployeeRecord{exportRecord(existingPersonAggregate()), exportRecord(juridicalPersonAggregate())}, nil)
+	sink := &recordingSink{}
+
+	// When
+	result, err := useCase.Execute(contextWithRoles(security.RoleHRAdmin), usecases.ExportEmployeesQuery{
+		Filter:  filter,
+		Columns: []string{"documentType", "documentNumber", "fullName", "firstName", "businessName", "salary"},
+		Sink:    sink,
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Rows)
+	assert.Equal(t, []string{"documentType", "documentNumber", "fullName", "firstName", "businessName", "salary"}, sink.header)
+	assert.Equal(t, []any{"DNI", "12345678", "John Doe", "John", nil, 3000.0}, sink.rows[0])
+	assert.Equal(t, []any{"RUC", "20123456789", "ACME S.A.C.", nil, "ACME S.A.C.", 3000.0}, sink.rows[1])
+}
+
+func TestExportEmployeesUseCase_Execute_MasksForRolesWithoutAccess(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	useCase := usecases.NewExportEmployeesUseCase(mockEmployeeRepo)
+	mockEmployeeRepo.On("StreamEmployees", mock.Anything, mock.Anything).
+		Return([]repositories.EmployeeRecord{exportRecord(existingPersonAggregate())}, nil)
+	sink := &recordingSink{}
+
+	// When
+	_, err := useCase.Execute(contextWithRoles(security.RoleManager), usecases.ExportEmployeesQuery{
+		Columns: []string{"documentNumber", "salary", "bankAccount", "cts"},
+		Sink:    sink,
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []any{"12****78", nil, "****8901", nil}, sink.rows[0])
+}
+
+func TestExportEmployeesUseCase_Execute_UnknownColumn(t *testing.T) {
+	// Given
+	useCase := usecases.NewExportEmployeesUseCase(new(MockEmployeeRepository))
+	sink := &recordingSink{}
+
+	// When
+	_, err := useCase.Execute(contextWithRoles(security.RoleHRAdmin), usecases.ExportEmployeesQuery{Columns: []string{"salary", "password"}, Sink: sink})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	require.Len(t, domainErr.Fields, 1)
+	assert.Equal(t, "password", domainErr.Fields[0].Params["column"])
+	assert.Nil(t, sink.header)
+}
+
+func TestExportEmployeesUseCase_Execute_FailureBeforeFirstRowWritesNothing(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	useCase := usecases.NewExportEmployeesUseCase(mockEmployeeRepo)
+	mockEmployeeRepo.On("StreamEmployees", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+	sink := &recordingSink{}
+
+	// When
+	_, err := useCase.Execute(contextWithRoles(security.RoleHRAdmin), usecases.ExportEmployeesQuery{Sink: sink})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, sink.header, "the header is only written with the first row, so the caller can still answer with a problem")
+}
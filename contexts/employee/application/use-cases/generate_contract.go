@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	sharedRepository "github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// rucDocumentType is the document type under which juridical persons are registered.
+const rucDocumentType = "RUC"
+
+// GenerateContractCommand identifies the employee and the terms of the contract to generate.
+type GenerateContractCommand struct {
+	EmployeeID      string
+	ExecutingUserID string
+	Data            employeedto.ContractGenerationRequest
+}
+
+// GenerateContractUseCase fills the template of the employee's contract type with the employer,
+// person and employment data, renders it and stores the document linked to the employee. The
+// employer comes from the juridical person registered with the employer RUC.
+type GenerateContractUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	personRepo   sharedRepository.PersonRepository
+	templateRepo repositories.ContractTemplateRepository
+	documentRepo repositories.ContractDocumentRepository
+	drafter      *services.ContractDrafter
+	renderers    map[value_objects.DocumentFormat]services.ContractRenderer
+	employerRUC  string
+}
+
+// NewGenerateContractUseCase creates a new GenerateContractUseCase with one renderer per format.
+func NewGenerateContractUseCase(employeeRepo repositories.EmployeeRepository, personRepo sharedRepository.PersonRepository,
+	templateRepo repositories.ContractTemplateRepository, documentRepo repositories.ContractDocumentRepository,
+	employerRUC string, renderers ...services.ContractRenderer) *GenerateContractUseCase {
+	byFormat := make(map[value_objects.DocumentFormat]services.ContractRenderer, len(renderers))
+	for _, renderer := range renderers {
+		byFormat[renderer.Format()] = renderer
+	}
+	return &GenerateContractUseCase{
+		employeeRepo: employeeRepo,
+		personRepo:   personRepo,
+		templateRepo: templateRepo,
+		documentRepo: documentRepo,
+		drafter:      services.NewContractDrafter(),
+		renderers:    byFormat,
+		employerRUC:  employerRUC,
+	}
+}
+
+// Execute uses the requested template version, or the latest one of the contract type. Each call
+// stores a new document: regenerating a contract keeps the previous ones.
+func (uc *GenerateContractUseCase) Execute(ctx context.Context, cmd GenerateContractCommand) (employeedto.ContractDocumentResponse, error) {
+	format, err := value_objects.NewDocumentFormat(cmd.Data.Format)
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, err
+	}
+	renderer, ok := uc.renderers[format]
+	if !ok {
+		return employeedto.ContractDocumentResponse{}, fmt.Errorf("no contract renderer for format %s", format)
+	}
+
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, cmd.EmployeeID)
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, fmt.Errorf("error loading employee: %w", err)
+	}
+	if employee == nil {
+		return employeedto.ContractDocumentResponse{}, domain.NewNotFoundError("employee.not_found", nil)
+	}
+	worker, err := uc.personRepo.GetPersonByID(ctx, employee.PersonID())
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, fmt.Errorf("error loading person: %w", err)
+	}
+	if worker == nil {
+		return employeedto.ContractDocumentResponse{}, fmt.Errorf("person %s of employee %s not found", employee.PersonID(), employee.ID())
+	}
+
+	template, err := uc.template(ctx, employee, cmd.Data.TemplateVersion)
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, err
+	}
+	employer, err := uc.employer(ctx)
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, err
+	}
+
+	text, err := uc.drafter.Draft(template, employer, employee, worker, services.ContractTerms{
+		Cause:           cmd.Data.Cause,
+		EndDate:         cmd.Data.EndDate,
+		EducationCenter: cmd.Data.EducationCenter,
+		SignatureDate:   time.Now(),
+	})
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, err
+	}
+	content, err := renderer.Render(text)
+	if err != nil {
+		return employeedto.ContractDocumentResponse{}, fmt.Errorf("error rendering contract: %w", err)
+	}
+
+	document := entities.NewContractDocument(employee.ID(), template, format, content, cmd.ExecutingUserID)
+	if err := uc.documentRepo.SaveDocument(ctx, document, content); err != nil {
+		return employeedto.ContractDocumentResponse{}, fmt.Errorf("error saving contract: %w", err)
+	}
+	return employeedto.NewContractDocumentResponse(document), nil
+}
+
+// template loads the template of the employee's contract type.
+func (uc *GenerateContractUseCase) template(ctx context.Context, employee *entities.Employee, version int) (*entities.ContractTemplate, error) {
+	contractType, err := value_objects.NewContractType(employee.ContractType())
+	if err != nil {
+		return nil, err
+	}
+	var template *entities.ContractTemplate
+	if version > 0 {
+		template, err = uc.templateRepo.GetTemplate(ctx, contractType, version)
+	} else {
+		template, err = uc.templateRepo.GetLatestTemplate(ctx, contractType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading contract template: %w", err)
+	}
+	if template == nil {
+		return nil, domain.NewBusinessRuleError("contract.template_not_found", nil).
+			WithParams(domain.Params{"contractType": contractType})
+	}
+	return template, nil
+}
+
+// employer reads the party that signs as employer from the juridical person of the employer RUC.
+func (uc *GenerateContractUseCase) employer(ctx context.Context) (services.ContractEmployer, error) {
+	person, err := uc.personRepo.GetPersonByDocument(ctx, rucDocumentType, uc.employerRUC)
+	if err != nil {
+		return services.ContractEmployer{}, fmt.Errorf("error loading employer: %w", err)
+	}
+	if person == nil || person.JuridicalPerson == nil {
+		return services.ContractEmployer{}, domain.NewBusinessRuleError("contract.employer_not_registered", nil).
+			WithParams(domain.Params{"ruc": uc.employerRUC})
+	}
+	employer := services.ContractEmployer{
+		RUC:                    person.JuridicalPerson.DocumentNumber,
+		BusinessName:           person.JuridicalPerson.BusinessName,
+		Address:                person.Person.Address,
+		RepresentativeName:     person.JuridicalPerson.RepresentativeName,
+		RepresentativeDocument: person.JuridicalPerson.RepresentativeDocument,
+	}
+	if address := person.Address; address != nil && address.Street != "" {
+		employer.Address = address.Street
+	}
+	return employer, nil
+}
@@ -0,0 +1,203 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	employee_value_objects "github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/contractdocs"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// contractStore keeps the contract templates and the generated contracts in memory
+type contractStore struct {
+	templates []*entities.ContractTemplate
+	documents map[string]*entities.ContractDocument
+	contents  map[string][]byte
+}
+
+func newContractStore(templates ...*entities.ContractTemplate) *contractStore {
+	return &contractStore{templates: templates, documents: map[string]*entities.ContractDocument{}, contents: map[string][]byte{}}
+}
+
+func (s *contractStore) SaveTemplate(_ context.Context, template *entities.ContractTemplate) error {
+	s.templates = append(s.templates, template)
+	return nil
+}
+
+func (s *contractStore) GetLatestTemplate(_ context.Context, contractType employee_value_objects.ContractType) (*entities.ContractTemplate, error) {
+	var latest *entities.ContractTemplate
+	for _, template := range s.templates {
+		if template.ContractType() == contractType && (latest == nil || template.Version() > latest.Version()) {
+			latest = template
+		}
+	}
+	return latest, nil
+}
+
+func (s *contractStore) GetTemplate(_ context.Context, contractType employee_value_objects.ContractType, version int) (*entities.ContractTemplate, error) {
+	for _, template := range s.templates {
+		if template.ContractType() == contractType && template.Version() == version {
+			return template, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *contractStore) ListTemplates(_ context.Context, contractType employee_value_objects.ContractType) ([]*entities.ContractTemplate, error) {
+	var templates []*entities.ContractTemplate
+	for _, template := range s.templates {
+		if contractType == "" || template.ContractType() == contractType {
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+func (s *contractStore) SaveDocument(_ context.Context, document *entities.ContractDocument, content []byte) error {
+	s.documents[document.ID()] = document
+	s.contents[document.ID()] = content
+	return nil
+}
+
+func (s *contractStore) ListDocumentsByEmployee(_ context.Context, employeeID string) ([]*entities.ContractDocument, error) {
+	var documents []*entities.ContractDocument
+	for _, document := range s.documents {
+		if document.EmployeeID() == employeeID {
+			documents = append(documents, document)
+		}
+	}
+	return documents, nil
+}
+
+func (s *contractStore) GetDocumentByID(_ context.Context, id string) (*entities.ContractDocument, error) {
+	return s.documents[id], nil
+}
+
+func (s *contractStore) GetDocumentContent(_ context.Context, id string) ([]byte, error) {
+	return s.contents[id], nil
+}
+
+func contractTemplate(t *testing.T, contractType employee_value_objects.ContractType, version int, body string) *entities.ContractTemplate {
+	t.Helper()
+	template, err := entities.NewContractTemplate(contractType, version, "CONTRATO DE {{worker.fullName}}", body, "u-admin")
+	require.NoError(t, err)
+	return template
+}
+
+func contractEmployee(t *testing.T, personID, contractType string) *entities.Employee {
+	t.Helper()
+	employee, err := entities.NewEmployeeBuilder(personID, 3500.0, contractType, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)).
+		WithJobDetails("Analista", "Finanzas", "Tiempo completo", "Lima").
+		WithPayroll("1234567890", "Integra", "Rimac").
+		Build()
+	require.NoError(t, err)
+	return employee
+}
+
+func contractUseCase(employee *entities.Employee, store *contractStore) *usecases.GenerateContractUseCase {
+	worker := existingPersonAggregate()
+	employeeRepo := new(MockEmployeeRepository)
+	employeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+	personRepo := new(MockPersonRepository)
+	personRepo.On("GetPersonByID", mock.Anything, employee.PersonID()).Return(worker, nil)
+	personRepo.On("GetPersonByDocument", mock.Anything, "RUC", "20123456789").Return(juridicalPersonAggregate(), nil)
+	return usecases.NewGenerateContractUseCase(employeeRepo, personRepo, store, store, "20123456789",
+		contractdocs.NewPDFRenderer(), contractdocs.NewDOCXRenderer())
+}
+
+func TestGenerateContractUseCase_Execute_FillsTheLatestTemplateOfTheContractType(t *testing.T) {
+	employee := contractEmployee(t, "per-1", "INDEFINIDO")
+	store := newContractStore(
+		contractTemplate(t, employee_value_objects.ContractIndefinite, 1, "Texto anterior."),
+		contractTemplate(t, employee_value_objects.ContractIndefinite, 2, "# PRIMERA\n{{employer.businessName}} contrata a {{worker.fullName}}."),
+		contractTemplate(t, employee_value_objects.ContractInternship, 3, "Convenio con {{educationCenter}}."),
+	)
+	uc := contractUseCase(employee, store)
+
+	resp, err := uc.Execute(context.Background(), usecases.GenerateContractCommand{EmployeeID: employee.ID(), ExecutingUserID: "u-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.TemplateVersion)
+	assert.Equal(t, "INDEFINIDO", resp.ContractType)
+	assert.Equal(t, "PDF", resp.Format, "PDF is the default format")
+	assert.Equal(t, "u-1", resp.GeneratedBy)
+	content := string(store.contents[resp.ID])
+	assert.Contains(t, content, "(CONTRATO DE John Doe)")
+	assert.Contains(t, content, "(ACME S.A.C. contrata a John Doe.)")
+	assert.True(t, store.documents[resp.ID].Matches(store.contents[resp.ID]))
+}
+
+func TestGenerateContractUseCase_Execute_FixedTermRequiresCauseAndEndDate(t *testing.T) {
+	employee := contractEmployee(t, "per-1", "FIJO")
+	store := newContractStore(contractTemplate(t, employee_value_objects.ContractFixedTerm, 1, "Por {{cause}} hasta el {{endDate}}."))
+	uc := contractUseCase(employee, store)
+
+	_, err := uc.Execute(context.Background(), usecases.GenerateContractCommand{EmployeeID: employee.ID()})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusBadRequest, domainErr.HTTPStatusCode)
+	require.Len(t, domainErr.Fields, 2)
+	assert.Equal(t, "cause", domainErr.Fields[0].Field)
+	assert.Equal(t, "endDate", domainErr.Fields[1].Field)
+	assert.Empty(t, store.documents)
+
+	endDate := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	resp, err := uc.Execute(context.Background(), usecases.GenerateContractCommand{
+		EmployeeID: employee.ID(),
+		Data:       employeedto.ContractGenerationRequest{Format: "docx", Cause: "Incremento de actividad", EndDate: &endDate},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "DOCX", resp.Format)
+}
+
+func TestGenerateContractUseCase_Execute_WithoutTemplate(t *testing.T) {
+	employee := contractEmployee(t, "per-1", "PRACTICANTE")
+	uc := contractUseCase(employee, newContractStore())
+
+	_, err := uc.Execute(context.Background(), usecases.GenerateContractCommand{EmployeeID: employee.ID()})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, domainErr.HTTPStatusCode)
+	assert.Equal(t, "contract.template_not_found", domainErr.MessageKey)
+}
+
+func TestCreateContractTemplateUseCase_Execute_PublishesTheNextVersion(t *testing.T) {
+	store := newContractStore(contractTemplate(t, employee_value_objects.ContractFixedTerm, 1, "Por {{cause}} hasta el {{endDate}}."))
+	uc := usecases.NewCreateContractTemplateUseCase(store)
+
+	resp, err := uc.Execute(context.Background(), usecases.CreateContractTemplateCommand{
+		ExecutingUserID: "u-admin",
+		Data: employeedto.ContractTemplateRequest{
+			ContractType: "FIJO", Title: "CONTRATO A PLAZO FIJO", Body: "Por {{ cause }} desde el {{startDate}} hasta el {{endDate}}.",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Version)
+	assert.Equal(t, []string{"cause", "startDate", "endDate"}, resp.Placeholders)
+
+	_, err = uc.Execute(context.Background(), usecases.CreateContractTemplateCommand{
+		Data: employeedto.ContractTemplateRequest{ContractType: "FIJO", Title: "CONTRATO", Body: "Desde el {{startDate}}."},
+	})
+	var fieldErr *sharedDomain.FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "contract.template_placeholder_required", fieldErr.MessageKey)
+
+	_, err = uc.Execute(context.Background(), usecases.CreateContractTemplateCommand{
+		Data: employeedto.ContractTemplateRequest{ContractType: "INDEFINIDO", Title: "CONTRATO", Body: "Sueldo: {{worker.salary}}."},
+	})
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "contract.template_placeholder_unknown", fieldErr.MessageKey)
+	assert.Len(t, store.templates, 2)
+}
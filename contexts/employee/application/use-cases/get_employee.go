@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	sharedRepository "github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// GetEmployeeQuery identifies the employee to read.
+type GetEmployeeQuery struct {
+	EmployeeID string
+}
+
+// GetEmployeeUseCase reads an employee, its person and its current version.
+type GetEmployeeUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	personRepo   sharedRepository.PersonRepository
+}
+
+// NewGetEmployeeUseCase creates a new GetEmployeeUseCase.
+func NewGetEmployeeUseCase(employeeRepo repositories.EmployeeRepository, personRepo sharedRepository.PersonRepository) *GetEmployeeUseCase {
+	return &GetEmployeeUseCase{
+		employeeRepo: employeeRepo,
+		personRepo:   personRepo,
+	}
+}
+
+// Execute loads the employee and masks what the caller's roles cannot see.
+func (uc *GetEmployeeUseCase) Execute(ctx context.Context, query GetEmployeeQuery) (employeedto.EmployeeResponse, error) {
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, query.EmployeeID)
+	if err != nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("error loading employee: %w", err)
+	}
+	if employee == nil {
+		return employeedto.EmployeeResponse{}, domain.NewNotFoundError("employee.not_found", nil)
+	}
+
+	personAgg, err := uc.personRepo.GetPersonByID(ctx, employee.PersonID())
+	if err != nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("error loading person: %w", err)
+	}
+	if personAgg == nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("person %s of employee %s not found", employee.PersonID(), employee.ID())
+	}
+
+	return employeedto.NewEmployeeResponse(employee, personAgg, masking.ViewerFromContext(ctx)), nil
+}
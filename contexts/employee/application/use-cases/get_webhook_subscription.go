@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// GetWebhookSubscriptionQuery identifies the subscription to read.
+type GetWebhookSubscriptionQuery struct {
+	SubscriptionID string
+}
+
+// GetWebhookSubscriptionUseCase reads a webhook subscription without its secret.
+type GetWebhookSubscriptionUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+}
+
+// NewGetWebhookSubscriptionUseCase creates a new GetWebhookSubscriptionUseCase.
+func NewGetWebhookSubscriptionUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository) *GetWebhookSubscriptionUseCase {
+	return &GetWebhookSubscriptionUseCase{subscriptionRepo: subscriptionRepo}
+}
+
+// Execute fails with NOT_FOUND when the subscription does not exist.
+func (uc *GetWebhookSubscriptionUseCase) Execute(ctx context.Context, query GetWebhookSubscriptionQuery) (employeedto.WebhookSubscriptionResponse, error) {
+	subscription, err := loadWebhookSubscription(ctx, uc.subscriptionRepo, query.SubscriptionID)
+	if err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+	return employeedto.NewWebhookSubscriptionResponse(subscription), nil
+}
+
+// loadWebhookSubscription returns the subscription or a NOT_FOUND error.
+func loadWebhookSubscription(ctx context.Context, subscriptionRepo repositories.WebhookSubscriptionRepository, id string) (*entities.WebhookSubscription, error) {
+	subscription, err := subscriptionRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error loading webhook subscription: %w", err)
+	}
+	if subscription == nil {
+		return nil, domain.NewNotFoundError("webhook.subscription_not_found", nil)
+	}
+	return subscription, nil
+}
+
+// checkWebhookURL rejects an endpoint the sender would refuse to call, e.g. plain http or an
+// internal address, before it is stored.
+func checkWebhookURL(sender services.WebhookSender, subscription *entities.WebhookSubscription) error {
+	if err := sender.CheckURL(subscription.URL()); err != nil {
+		return domain.NewFieldError("url", "url", "webhook.url_not_allowed", nil)
+	}
+	return nil
+}
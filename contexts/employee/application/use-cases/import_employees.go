@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// errImportRollback forces the rollback of the outer transaction of a dry run, or of an
+// all-or-nothing import with failed rows; it never reaches the caller.
+var errImportRollback = errors.New("import rolled back")
+
+// ImportEmployeesCommand carries the rows of an uploaded file, already mapped to registration requests.
+type ImportEmployeesCommand struct {
+	Mode string
+	Rows []employeedto.EmployeeImportRow
+	// ExecutingUserID and UserRoles come from the authenticated principal.
+	ExecutingUserID string
+	UserRoles       []security.Role
+}
+
+// ImportEmployeesUseCase registers every row of a file through the registration use case, so
+// each row gets exactly the same validation and labor rules as POST /employee.
+// registerUseCase must be transactional: inside the outer transaction of the dry-run and
+// all-or-nothing modes the UnitOfWork turns each row into a savepoint.
+type ImportEmployeesUseCase struct {
+	registerUseCase application.UseCase[RegisterEmployeeCommand, employeedto.EmployeeResponse]
+	uow             domain.UnitOfWork
+	dispatcher      *application.EventDispatcher
+}
+
+// NewImportEmployeesUseCase creates a new ImportEmployeesUseCase. The dispatcher receives the
+// events of the rows once the outer transaction commits.
+func NewImportEmployeesUseCase(registerUseCase application.UseCase[RegisterEmployeeCommand, employeedto.EmployeeResponse], uow domain.UnitOfWork,
+	dispatcher *application.EventDispatcher) *ImportEmployeesUseCase {
+	return &ImportEmployeesUseCase{
+		registerUseCase: registerUseCase,
+		uow:             uow,
+		dispatcher:      dispatcher,
+	}
+}
+
+// Execute processes every row, even after a failure, so the report lists all the errors of the file.
+func (uc *ImportEmployeesUseCase) Execute(ctx context.Context, cmd ImportEmployeesCommand) (employeedto.EmployeeImportReport, error) {
+	report := employeedto.EmployeeImportReport{Mode: cmd.Mode, Total: len(cmd.Rows)}
+	if len(cmd.Rows) == 0 {
+		return report, domain.NewInvalidInputError("import.empty", nil)
+	}
+
+	switch cmd.Mode {
+	case employeedto.ImportModePerRow:
+		// Without an outer transaction each registration commits on its own
+		report.Rows = uc.registerRows(ctx, cmd, employeedto.ImportRowCreated)
+
+	case employeedto.ImportModeDryRun:
+		err := application.ExecuteInTransaction(ctx, uc.uow, uc.dispatcher, func(txCtx context.Context) error {
+			report.Rows = uc.registerRows(txCtx, cmd, employeedto.ImportRowValid)
+			return errImportRollback
+		})
+		if !errors.Is(err, errImportRollback) {
+			return employeedto.EmployeeImportReport{}, fmt.Errorf("error running dry-run import: %w", err)
+		}
+
+	case employeedto.ImportModeAllOrNothing:
+		err := application.ExecuteInTransaction(ctx, uc.uow, uc.dispatcher, func(txCtx context.Context) error {
+			report.Rows = uc.registerRows(txCtx, cmd, employeedto.ImportRowCreated)
+			if countStatus(report.Rows, employeedto.ImportRowFailed) > 0 {
+				return errImportRollback
+			}
+			return nil
+		})
+		if errors.Is(err, errImportRollback) {
+			for i := range report.Rows {
+				if report.Rows[i].Status == employeedto.ImportRowCreated {
+					report.Rows[i].Status = employeedto.ImportRowRolledBack
+					report.Rows[i].EmployeeID = ""
+				}
+			}
+		} else if err != nil {
+			return employeedto.EmployeeImportReport{}, fmt.Errorf("error committing import: %w", err)
+		}
+
+	default:
+		return report, domain.NewInvalidInputError("import.mode_invalid", nil).WithParams(domain.Params{"mode": cmd.Mode})
+	}
+
+	report.Failed = countStatus(report.Rows, employeedto.ImportRowFailed)
+	report.Succeeded = countStatus(report.Rows, employeedto.ImportRowCreated) + countStatus(report.Rows, employeedto.ImportRowValid)
+	report.Committed = countStatus(report.Rows, employeedto.ImportRowCreated) > 0
+	return report, nil
+}
+
+func (uc *ImportEmployeesUseCase) registerRows(ctx context.Context, cmd ImportEmployeesCommand, successStatus string) []employeedto.EmployeeImportRowResult {
+	results := make([]employeedto.EmployeeImportRowResult, 0, len(cmd.Rows))
+	for _, row := range cmd.Rows {
+		result := employeedto.EmployeeImportRowResult{Line: row.Line, DocumentNumber: row.DocumentNumber()}
+		if row.Err != nil {
+			result.Status, result.Err = employeedto.ImportRowFailed, row.Err
+			results = append(results, result)
+			continue
+		}
+
+		resp, err := uc.registerUseCase.Execute(ctx, RegisterEmployeeCommand{
+			Data:            row.Data,
+			ExecutingUserID: cmd.ExecutingUserID,
+			UserRoles:       cmd.UserRoles,
+		})
+		if err != nil {
+			result.Status, result.Err = employeedto.ImportRowFailed, err
+		} else {
+			result.Status = successStatus
+			if successStatus == employeedto.ImportRowCreated {
+				// The ID of a dry run would never exist, it is not reported
+				result.EmployeeID = resp.Employment.ID
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func countStatus(rows []employeedto.EmployeeImportRowResult, status string) int {
+	count := 0
+	for _, row := range rows {
+		if row.Status == status {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,158 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	shared_dto "github.com/kevinsoras/employee-management/shared/application/dto"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// MockRegisterEmployeeUseCase is a mock for the registration use case run for each row
+type MockRegisterEmployeeUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRegisterEmployeeUseCase) Execute(ctx context.Context, cmd usecases.RegisterEmployeeCommand) (employeedto.EmployeeResponse, error) {
+	args := m.Called(ctx, cmd.Data.PersonData.DocumentNumber)
+	return args.Get(0).(employeedto.EmployeeResponse), args.Error(1)
+}
+
+// FakeUnitOfWork runs the callback directly and records whether it would have committed
+type FakeUnitOfWork struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (u *FakeUnitOfWork) Execute(ctx context.Context, fn sharedDomain.UowCallback) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack = true
+		return err
+	}
+	u.committed = true
+	return nil
+}
+
+func importRow(line int, documentNumber string) employeedto.EmployeeImportRow {
+	return employeedto.EmployeeImportRow{Line: line, Data: employeedto.EmployeeRegistrationRequest{
+		PersonData: &shared_dto.PersonRequest{DocumentNumber: documentNumber},
+	}}
+}
+
+func registeredEmployee(id string) employeedto.EmployeeResponse {
+	return employeedto.EmployeeResponse{Employment: employeedto.EmployeeOutput{ID: id}}
+}
+
+func importRowsWithOneFailure(registerUC *MockRegisterEmployeeUseCase) []employeedto.EmployeeImportRow {
+	registerUC.On("Execute", mock.Anything, "12345678").Return(registeredEmployee("emp-1"), nil)
+	registerUC.On("Execute", mock.Anything, "87654321").Return(employeedto.EmployeeResponse{}, sharedDomain.NewAlreadyExistsError("person.already_exists", nil))
+	invalid := importRow(4, "11111111")
+	invalid.Err = sharedDomain.NewInvalidInputError("validation.failed", nil)
+	return []employeedto.EmployeeImportRow{importRow(2, "12345678"), importRow(3, "87654321"), invalid}
+}
+
+func TestImportEmployeesUseCase_Execute_PerRowCommitsValidRows(t *testing.T) {
+	// Given
+	registerUC := new(MockRegisterEmployeeUseCase)
+	uow := &FakeUnitOfWork{}
+	useCase := usecases.NewImportEmployeesUseCase(registerUC, uow, nil)
+	rows := importRowsWithOneFailure(registerUC)
+
+	// When
+	report, err := useCase.Execute(context.Background(), usecases.ImportEmployeesCommand{Mode: employeedto.ImportModePerRow, Rows: rows})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 2, report.Failed)
+	assert.True(t, report.Committed)
+	assert.Equal(t, employeedto.ImportRowCreated, report.Rows[0].Status)
+	assert.Equal(t, "emp-1", report.Rows[0].EmployeeID)
+	assert.Equal(t, employeedto.ImportRowFailed, report.Rows[1].Status)
+	assert.Equal(t, employeedto.ImportRowFailed, report.Rows[2].Status)
+	assert.Equal(t, 4, report.Rows[2].Line)
+	assert.False(t, uow.committed, "per-row mode must not open an outer transaction")
+	// The row that failed to parse never reaches the registration
+	registerUC.AssertNumberOfCalls(t, "Execute", 2)
+}
+
+func TestImportEmployeesUseCase_Execute_AllOrNothingRollsBackOnFailure(t *testing.T) {
+	// Given
+	registerUC := new(MockRegisterEmployeeUseCase)
+	uow := &FakeUnitOfWork{}
+	useCase := usecases.NewImportEmployeesUseCase(registerUC, uow, nil)
+	rows := importRowsWithOneFailure(registerUC)
+
+	// When
+	report, err := useCase.Execute(context.Background(), usecases.ImportEmployeesCommand{Mode: employeedto.ImportModeAllOrNothing, Rows: rows})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, uow.rolledBack)
+	assert.False(t, report.Committed)
+	assert.Equal(t, 0, report.Succeeded)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, employeedto.ImportRowRolledBack, report.Rows[0].Status)
+	assert.Empty(t, report.Rows[0].EmployeeID)
+}
+
+func TestImportEmployeesUseCase_Execute_AllOrNothingCommitsWhenAllRowsSucceed(t *testing.T) {
+	// Given
+	registerUC := new(MockRegisterEmployeeUseCase)
+	uow := &FakeUnitOfWork{}
+	useCase := usecases.NewImportEmployeesUseCase(registerUC, uow, nil)
+	registerUC.On("Execute", mock.Anything, "12345678").Return(registeredEmployee("emp-1"), nil)
+
+	// When
+	report, err := useCase.Execute(context.Background(), usecases.ImportEmployeesCommand{
+		Mode: employeedto.ImportModeAllOrNothing,
+		Rows: []employeedto.EmployeeImportRow{importRow(2, "12345678")},
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, uow.committed)
+	assert.True(t, report.Committed)
+	assert.Equal(t, employeedto.ImportRowCreated, report.Rows[0].Status)
+}
+
+func TestImportEmployeesUseCase_Execute_DryRunAlwaysRollsBack(t *testing.T) {
+	// Given
+	registerUC := new(MockRegisterEmployeeUseCase)
+	uow := &FakeUnitOfWork{}
+	useCase := usecases.NewImportEmployeesUseCase(registerUC, uow, nil)
+	rows := importRowsWithOneFailure(registerUC)
+
+	// When
+	report, err := useCase.Execute(context.Background(), usecases.ImportEmployeesCommand{Mode: employeedto.ImportModeDryRun, Rows: rows})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, uow.rolledBack)
+	assert.False(t, report.Committed)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, employeedto.ImportRowValid, report.Rows[0].Status)
+	assert.Empty(t, report.Rows[0].EmployeeID)
+}
+
+func TestImportEmployeesUseCase_Execute_InvalidMode(t *testing.T) {
+	// Given
+	useCase := usecases.NewImportEmployeesUseCase(new(MockRegisterEmployeeUseCase), &FakeUnitOfWork{}, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.ImportEmployeesCommand{
+		Mode: "SOMETIMES",
+		Rows: []employeedto.EmployeeImportRow{importRow(2, "12345678")},
+	})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "import.mode_invalid", domainErr.MessageKey)
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+)
+
+// ListContractTemplatesQuery filters the templates by contract type; empty lists all of them.
+type ListContractTemplatesQuery struct {
+	ContractType string
+}
+
+// ListContractTemplatesUseCase lists the template versions, newest first.
+type ListContractTemplatesUseCase struct {
+	templateRepo repositories.ContractTemplateRepository
+}
+
+// NewListContractTemplatesUseCase creates a new ListContractTemplatesUseCase.
+func NewListContractTemplatesUseCase(templateRepo repositories.ContractTemplateRepository) *ListContractTemplatesUseCase {
+	return &ListContractTemplatesUseCase{templateRepo: templateRepo}
+}
+
+// Execute rejects an unknown contract type instead of returning an empty list.
+func (uc *ListContractTemplatesUseCase) Execute(ctx context.Context, query ListContractTemplatesQuery) ([]employeedto.ContractTemplateResponse, error) {
+	var contractType value_objects.ContractType
+	if query.ContractType != "" {
+		var err error
+		if contractType, err = value_objects.NewContractType(query.ContractType); err != nil {
+			return nil, err
+		}
+	}
+	templates, err := uc.templateRepo.ListTemplates(ctx, contractType)
+	if err != nil {
+		return nil, fmt.Errorf("error loading contract templates: %w", err)
+	}
+	resp := make([]employeedto.ContractTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		resp = append(resp, employeedto.NewContractTemplateResponse(template))
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// ListEmployeeContractsQuery identifies the employee whose contracts are listed.
+type ListEmployeeContractsQuery struct {
+	EmployeeID string
+}
+
+// ListEmployeeContractsUseCase lists the contracts generated for an employee, newest first.
+type ListEmployeeContractsUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	documentRepo repositories.ContractDocumentRepository
+}
+
+// NewListEmployeeContractsUseCase creates a new ListEmployeeContractsUseCase.
+func NewListEmployeeContractsUseCase(employeeRepo repositories.EmployeeRepository, documentRepo repositories.ContractDocumentRepository) *ListEmployeeContractsUseCase {
+	return &ListEmployeeContractsUseCase{employeeRepo: employeeRepo, documentRepo: documentRepo}
+}
+
+// Execute fails with NOT_FOUND when the employee does not exist, so an empty list always means
+// that no contract was generated yet.
+func (uc *ListEmployeeContractsUseCase) Execute(ctx context.Context, query ListEmployeeContractsQuery) ([]employeedto.ContractDocumentResponse, error) {
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, query.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading employee: %w", err)
+	}
+	if employee == nil {
+		return nil, domain.NewNotFoundError("employee.not_found", nil)
+	}
+	documents, err := uc.documentRepo.ListDocumentsByEmployee(ctx, employee.ID())
+	if err != nil {
+		return nil, fmt.Errorf("error loading contracts: %w", err)
+	}
+	resp := make([]employeedto.ContractDocumentResponse, 0, len(documents))
+	for _, document := range documents {
+		resp = append(resp, employeedto.NewContractDocumentResponse(document))
+	}
+	return resp, nil
+}
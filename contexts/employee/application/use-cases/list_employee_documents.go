@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// ListEmployeeDocumentsQuery identifies the employee whose documents are listed.
+type ListEmployeeDocumentsQuery struct {
+	EmployeeID string
+}
+
+// ListEmployeeDocumentsUseCase lists the documents attached to an employee, newest first, flagging
+// the expired ones.
+type ListEmployeeDocumentsUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	documentRepo repositories.EmployeeDocumentRepository
+}
+
+// NewListEmployeeDocumentsUseCase creates a new ListEmployeeDocumentsUseCase.
+func NewListEmployeeDocumentsUseCase(employeeRepo repositories.EmployeeRepository, documentRepo repositories.EmployeeDocumentRepository) *ListEmployeeDocumentsUseCase {
+	return &ListEmployeeDocumentsUseCase{employeeRepo: employeeRepo, documentRepo: documentRepo}
+}
+
+// Execute fails with NOT_FOUND when the employee does not exist.
+func (uc *ListEmployeeDocumentsUseCase) Execute(ctx context.Context, query ListEmployeeDocumentsQuery) ([]employeedto.EmployeeDocumentResponse, error) {
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, query.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading employee: %w", err)
+	}
+	if employee == nil {
+		return nil, domain.NewNotFoundError("employee.not_found", nil)
+	}
+	documents, err := uc.documentRepo.ListDocumentsByEmployee(ctx, employee.ID())
+	if err != nil {
+		return nil, fmt.Errorf("error loading documents: %w", err)
+	}
+	now := time.Now()
+	resp := make([]employeedto.EmployeeDocumentResponse, 0, len(documents))
+	for _, document := range documents {
+		resp = append(resp, employeedto.NewEmployeeDocumentResponse(document, now))
+	}
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+const (
+	defaultWebhookDeliveriesLimit = 50
+	maxWebhookDeliveriesLimit     = 500
+)
+
+// ListWebhookDeliveriesQuery filters the delivery log of a subscription. An empty status lists
+// every status and an empty limit falls back to the latest 50 deliveries.
+type ListWebhookDeliveriesQuery struct {
+	SubscriptionID string
+	Filter         employeedto.WebhookDeliveriesRequest
+}
+
+// ListWebhookDeliveriesUseCase returns the delivery log of a subscription, newest first, with the
+// outcome of the last attempt of each delivery.
+type ListWebhookDeliveriesUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+}
+
+// NewListWebhookDeliveriesUseCase creates a new ListWebhookDeliveriesUseCase.
+func NewListWebhookDeliveriesUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository,
+	deliveryRepo repositories.WebhookDeliveryRepository) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{subscriptionRepo: subscriptionRepo, deliveryRepo: deliveryRepo}
+}
+
+// Execute fails with NOT_FOUND when the subscription does not exist and with INVALID_INPUT for an
+// unknown status or a limit outside 1..500.
+func (uc *ListWebhookDeliveriesUseCase) Execute(ctx context.Context, query ListWebhookDeliveriesQuery) ([]employeedto.WebhookDeliveryResponse, error) {
+	var status entities.WebhookDeliveryStatus
+	if query.Filter.Status != "" {
+		parsed, err := entities.ParseWebhookDeliveryStatus(query.Filter.Status)
+		if err != nil {
+			return nil, err
+		}
+		status = parsed
+	}
+	limit := defaultWebhookDeliveriesLimit
+	if query.Filter.Limit != "" {
+		limit, _ = strconv.Atoi(query.Filter.Limit)
+	}
+	if limit < 1 {
+		return nil, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(
+			*domain.NewFieldError("limit", "min", "validation.min", domain.Params{"min": 1}))
+	}
+	if limit > maxWebhookDeliveriesLimit {
+		return nil, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(
+			*domain.NewFieldError("limit", "max", "validation.max", domain.Params{"max": maxWebhookDeliveriesLimit}))
+	}
+
+	subscription, err := loadWebhookSubscription(ctx, uc.subscriptionRepo, query.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := uc.deliveryRepo.ListDeliveries(ctx, subscription.ID(), status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error loading webhook deliveries: %w", err)
+	}
+	resp := make([]employeedto.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		resp = append(resp, employeedto.NewWebhookDeliveryResponse(delivery))
+	}
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+)
+
+// ListWebhookSubscriptionsQuery has no filters: the subscriptions are few.
+type ListWebhookSubscriptionsQuery struct{}
+
+// ListWebhookSubscriptionsUseCase lists every webhook subscription, newest first, without secrets.
+type ListWebhookSubscriptionsUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+}
+
+// NewListWebhookSubscriptionsUseCase creates a new ListWebhookSubscriptionsUseCase.
+func NewListWebhookSubscriptionsUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository) *ListWebhookSubscriptionsUseCase {
+	return &ListWebhookSubscriptionsUseCase{subscriptionRepo: subscriptionRepo}
+}
+
+func (uc *ListWebhookSubscriptionsUseCase) Execute(ctx context.Context, _ ListWebhookSubscriptionsQuery) ([]employeedto.WebhookSubscriptionResponse, error) {
+	subscriptions, err := uc.subscriptionRepo.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading webhook subscriptions: %w", err)
+	}
+	resp := make([]employeedto.WebhookSubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		resp = append(resp, employeedto.NewWebhookSubscriptionResponse(subscription))
+	}
+	return resp, nil
+}
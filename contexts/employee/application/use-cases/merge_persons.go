@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	sharedRepository "github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// MergePersonsCommand encapsulates the duplicate to remove and the person that survives.
+type MergePersonsCommand struct {
+	Data dto.PersonMergeRequest
+}
+
+// MergePersonsUseCase merges a duplicate person into the surviving one.
+// It lives in the employee context because it re-points employees; it must run inside
+// a transaction so the re-pointing, the audit record and the deletion are atomic.
+type MergePersonsUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	personRepo   sharedRepository.PersonRepository
+}
+
+// NewMergePersonsUseCase creates a new MergePersonsUseCase.
+func NewMergePersonsUseCase(employeeRepo repositories.EmployeeRepository, personRepo sharedRepository.PersonRepository) *MergePersonsUseCase {
+	return &MergePersonsUseCase{
+		employeeRepo: employeeRepo,
+		personRepo:   personRepo,
+	}
+}
+
+// Execute moves the contacts, address and employees of the duplicate to the survivor, records the merge
+// and deletes the duplicate.
+func (uc *MergePersonsUseCase) Execute(ctx context.Context, cmd MergePersonsCommand) (dto.PersonMergeResponse, error) {
+	req := cmd.Data
+	if req.SurvivorPersonID == req.DuplicatePersonID {
+		return dto.PersonMergeResponse{}, domain.NewInvalidInputError("person.merge_self", nil)
+	}
+
+	// 1. Load both persons
+	survivor, err := uc.loadPerson(ctx, req.SurvivorPersonID)
+	if err != nil {
+		return dto.PersonMergeResponse{}, err
+	}
+	duplicate, err := uc.loadPerson(ctx, req.DuplicatePersonID)
+	if err != nil {
+		return dto.PersonMergeResponse{}, err
+	}
+	if survivor.Person.Type != duplicate.Person.Type {
+		return dto.PersonMergeResponse{}, domain.NewInvalidInputError("person.merge_type_mismatch", nil)
+	}
+
+	// 2. Move the contacts and address of the duplicate to the survivor; differing addresses reject the merge
+	survivorChanged, err := survivor.AbsorbContactsAndAddress(duplicate)
+	if err != nil {
+		return dto.PersonMergeResponse{}, err
+	}
+
+	// 3. Re-point the employees of the duplicate to the survivor
+	employeesMoved, err := uc.employeeRepo.ReassignPerson(ctx, duplicate.Person.ID, survivor.Person.ID)
+	if err != nil {
+		return dto.PersonMergeResponse{}, fmt.Errorf("error reassigning employees: %w", err)
+	}
+
+	// 4. Keep an audit record with a snapshot of the duplicate before deleting it
+	snapshot, err := json.Marshal(dto.NewPersonResponse(duplicate))
+	if err != nil {
+		return dto.PersonMergeResponse{}, fmt.Errorf("error serializing merged person: %w", err)
+	}
+	merge, err := entities.NewPersonMerge(survivor.Person.ID, duplicate.Person.ID, req.Reason, employeesMoved, snapshot)
+	if err != nil {
+		return dto.PersonMergeResponse{}, domain.NewInvalidInputError("validation.failed", err)
+	}
+	if err := uc.personRepo.SavePersonMerge(ctx, merge); err != nil {
+		return dto.PersonMergeResponse{}, fmt.Errorf("error saving person merge: %w", err)
+	}
+
+	// 5. Delete the duplicate (its details, contacts and address cascade) and save what the survivor took over
+	if err := uc.personRepo.DeletePerson(ctx, duplicate.Person.ID); err != nil {
+		return dto.PersonMergeResponse{}, fmt.Errorf("error deleting merged person: %w", err)
+	}
+	if survivorChanged {
+		if err := uc.personRepo.UpdatePerson(ctx, survivor); err != nil {
+			return dto.PersonMergeResponse{}, fmt.Errorf("error updating surviving person: %w", err)
+		}
+	}
+
+	return dto.PersonMergeResponse{
+		MergeID:          merge.ID,
+		SurvivorPersonID: merge.SurvivorPersonID,
+		MergedPersonID:   merge.MergedPersonID,
+		EmployeesMoved:   merge.EmployeesMoved,
+		MergedAt:         merge.MergedAt,
+		Survivor:         dto.NewPersonResponse(survivor).Masked(masking.ViewerFromContext(ctx)),
+	}, nil
+}
+
+func (uc *MergePersonsUseCase) loadPerson(ctx context.Context, id string) (*aggregates.PersonAggregate, error) {
+	person, err := uc.personRepo.GetPersonByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error loading person: %w", err)
+	}
+	if person == nil {
+		return nil, domain.NewNotFoundError("person.not_found_id", nil).WithParams(domain.Params{"id": id})
+	}
+	return person, nil
+}
@@ -0,0 +1,153 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	shared_dto "github.com/kevinsoras/employee-management/shared/application/dto"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	entities_shared "github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+func TestMergePersonsUseCase_Execute_Success(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewMergePersonsUseCase(mockEmployeeRepo, mockPersonRepo)
+	survivor, duplicate := existingPersonAggregate(), existingPersonAggregate()
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, survivor.Person.ID).Return(survivor, nil)
+	mockPersonRepo.On("GetPersonByID", mock.Anything, duplicate.Person.ID).Return(duplicate, nil)
+	mockEmployeeRepo.On("ReassignPerson", mock.Anything, duplicate.Person.ID, survivor.Person.ID).Return(2, nil)
+	mockPersonRepo.On("SavePersonMerge", mock.Anything, mock.MatchedBy(func(m *entities_shared.PersonMerge) bool {
+		return m.SurvivorPersonID == survivor.Person.ID && m.MergedPersonID == duplicate.Person.ID && m.EmployeesMoved == 2 && len(m.MergedSnapshot) > 0
+	})).Return(nil)
+	mockPersonRepo.On("DeletePerson", mock.Anything, duplicate.Person.ID).Return(nil)
+
+	cmd := usecases.MergePersonsCommand{Data: shared_dto.PersonMergeRequest{
+		SurvivorPersonID:  survivor.Person.ID,
+		DuplicatePersonID: duplicate.Person.ID,
+		Reason:            "Mismo nombre con otro correo",
+	}}
+
+	// When
+	resp, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.EmployeesMoved)
+	assert.Equal(t, survivor.Person.ID, resp.Survivor.ID)
+	mockEmployeeRepo.AssertExpectations(t)
+	mockPersonRepo.AssertExpectations(t)
+}
+
+func TestMergePersonsUseCase_Execute_DuplicateNotFound(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewMergePersonsUseCase(mockEmployeeRepo, mockPersonRepo)
+	survivor := existingPersonAggregate()
+	missingID := "01990000-0000-7000-8000-000000000000"
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, survivor.Person.ID).Return(survivor, nil)
+	mockPersonRepo.On("GetPersonByID", mock.Anything, missingID).Return(nil, nil)
+
+	cmd := usecases.MergePersonsCommand{Data: shared_dto.PersonMergeRequest{
+		SurvivorPersonID:  survivor.Person.ID,
+		DuplicatePersonID: missingID,
+	}}
+
+	// When
+	_, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "NOT_FOUND", domainErr.Code)
+	mockEmployeeRepo.AssertNotCalled(t, "ReassignPerson", mock.Anything, mock.Anything, mock.Anything)
+	mockPersonRepo.AssertNotCalled(t, "DeletePerson", mock.Anything, mock.Anything)
+}
+
+func TestMergePersonsUseCase_Execute_MovesContactsAndAddressToTheSurvivor(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewMergePersonsUseCase(mockEmployeeRepo, mockPersonRepo)
+	survivor, duplicate := existingPersonAggregate(), existingPersonAggregate()
+	survivorMobile, _ := entities_shared.NewContact(survivor.Person.ID, "MOBILE", "987654321", "", "", true)
+	_ = survivor.ReplaceContacts([]*entities_shared.Contact{survivorMobile})
+	sameMobile, _ := entities_shared.NewContact(duplicate.Person.ID, "MOBILE", "987654321", "", "", false)
+	otherMobile, _ := entities_shared.NewContact(duplicate.Person.ID, "MOBILE", "912345678", "", "", true)
+	workEmail, _ := entities_shared.NewContact(duplicate.Person.ID, "WORK_EMAIL", "john.doe@empresa.pe", "", "", true)
+	_ = duplicate.ReplaceContacts([]*entities_shared.Contact{sameMobile, otherMobile, workEmail})
+	address, _ := entities_shared.NewAddress(duplicate.Person.ID, "Av. Arequipa 123", "", "150101")
+	_ = duplicate.SetAddress(address)
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, survivor.Person.ID).Return(survivor, nil)
+	mockPersonRepo.On("GetPersonByID", mock.Anything, duplicate.Person.ID).Return(duplicate, nil)
+	mockEmployeeRepo.On("ReassignPerson", mock.Anything, duplicate.Person.ID, survivor.Person.ID).Return(0, nil)
+	mockPersonRepo.On("SavePersonMerge", mock.Anything, mock.Anything).Return(nil)
+	mockPersonRepo.On("DeletePerson", mock.Anything, duplicate.Person.ID).Return(nil)
+	mockPersonRepo.On("UpdatePerson", mock.Anything, survivor).Return(nil)
+
+	cmd := usecases.MergePersonsCommand{Data: shared_dto.PersonMergeRequest{
+		SurvivorPersonID:  survivor.Person.ID,
+		DuplicatePersonID: duplicate.Person.ID,
+	}}
+
+	// When
+	resp, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, survivor.Contacts, 3, "the repeated mobile is not copied")
+	for _, c := range survivor.Contacts {
+		assert.Equal(t, survivor.Person.ID, c.PersonID)
+		if c.Value == "912345678" {
+			assert.False(t, c.IsPrimary, "the survivor keeps its primary mobile")
+		}
+		if c.Value == "john.doe@empresa.pe" {
+			assert.True(t, c.IsPrimary)
+		}
+	}
+	assert.Equal(t, survivor.Person.ID, survivor.Address.PersonID)
+	assert.Equal(t, "Av. Arequipa 123", survivor.Address.Street)
+	assert.Len(t, resp.Survivor.Contacts, 3)
+	mockPersonRepo.AssertExpectations(t)
+}
+
+func TestMergePersonsUseCase_Execute_RejectsDifferentAddresses(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewMergePersonsUseCase(mockEmployeeRepo, mockPersonRepo)
+	survivor, duplicate := existingPersonAggregate(), existingPersonAggregate()
+	survivorAddress, _ := entities_shared.NewAddress(survivor.Person.ID, "Av. Arequipa 123", "", "150101")
+	_ = survivor.SetAddress(survivorAddress)
+	duplicateAddress, _ := entities_shared.NewAddress(duplicate.Person.ID, "Jr. Junín 456", "", "150101")
+	_ = duplicate.SetAddress(duplicateAddress)
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, survivor.Person.ID).Return(survivor, nil)
+	mockPersonRepo.On("GetPersonByID", mock.Anything, duplicate.Person.ID).Return(duplicate, nil)
+
+	cmd := usecases.MergePersonsCommand{Data: shared_dto.PersonMergeRequest{
+		SurvivorPersonID:  survivor.Person.ID,
+		DuplicatePersonID: duplicate.Person.ID,
+	}}
+
+	// When
+	_, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "person.merge_address_conflict", domainErr.MessageKey)
+	assert.Equal(t, "Av. Arequipa 123", survivor.Address.Street)
+	mockEmployeeRepo.AssertNotCalled(t, "ReassignPerson", mock.Anything, mock.Anything, mock.Anything)
+	mockPersonRepo.AssertNotCalled(t, "DeletePerson", mock.Anything, mock.Anything)
+}
@@ -3,21 +3,59 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/shared/application"
 	"github.com/kevinsoras/employee-management/shared/application/mappers"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
 	"github.com/kevinsoras/employee-management/shared/domain/factories"
 	sharedRepository "github.com/kevinsoras/employee-management/shared/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
 )
 
 // RegisterEmployeeCommand encapsulates all the information needed to register an employee.
 // This follows the Command pattern.
 type RegisterEmployeeCommand struct {
 	Data employeedto.EmployeeRegistrationRequest
-	// Future fields like ExecutingUserID, UserRole, etc. can be added here.
+	// ExecutingUserID and UserRoles come from the authenticated principal.
+	ExecutingUserID string
+	UserRoles       []security.Role
+}
+
+// LogValue implements slog.LogValuer so that logging the command never dumps personal data:
+// only identifying fields are logged and the sensitive ones are masked as for an anonymous viewer.
+func (c RegisterEmployeeCommand) LogValue() slog.Value {
+	viewer := masking.DefaultPolicy().NewViewer()
+	attrs := []slog.Attr{slog.String("executingUserID", c.ExecutingUserID)}
+	if p := c.Data.PersonData; p != nil {
+		attrs = append(attrs, slog.Group("person",
+			slog.String("type", p.Type),
+			slog.String("documentType", p.DocumentType),
+			slog.String("documentNumber", viewer.String(masking.FieldDocumentNumber, p.DocumentNumber)),
+		))
+	}
+	if ref := c.Data.PersonRef; ref != nil {
+		attrs = append(attrs, slog.Group("existingPerson",
+			slog.String("personId", ref.PersonID),
+			slog.String("documentNumber", viewer.String(masking.FieldDocumentNumber, ref.DocumentNumber)),
+		))
+	}
+	e := c.Data.EmploymentData
+	attrs = append(attrs, slog.Group("employment",
+		slog.String("contractType", e.ContractType),
+		slog.String("position", e.Position),
+		slog.String("department", e.Department),
+		slog.Time("startDate", e.StartDate),
+	))
+	return slog.GroupValue(attrs...)
 }
 
 // RegisterEmployeeUseCase orchestrates the registration of an employee.
@@ -26,6 +64,7 @@ type RegisterEmployeeUseCase struct {
 	employeeRepo repositories.EmployeeRepository
 	personRepo   sharedRepository.PersonRepository
 	laborService services.LaborService
+	now          func() time.Time
 }
 
 // NewRegisterEmployeeUseCase creates a new RegisterEmployeeUseCase.
@@ -34,29 +73,41 @@ func NewRegisterEmployeeUseCase(employeeRepo repositories.EmployeeRepository, pe
 		employeeRepo: employeeRepo,
 		personRepo:   personRepo,
 		laborService: laborService,
+		now:          time.Now,
 	}
 }
 
 // Execute contains the core business logic for registering an employee.
 func (uc *RegisterEmployeeUseCase) Execute(ctx context.Context, cmd RegisterEmployeeCommand) (employeedto.EmployeeResponse, error) {
-	// 1. Create person aggregate using the factory
-	personReq := cmd.Data.PersonData
-	personParams := mappers.ToPersonFactoryParams(personReq)
-	personAgg, err := factories.CreatePerson(personParams)
+	// 1. Resolve the person: reuse an existing one or create a new aggregate using the factory
+	personAgg, isNewPerson, err := uc.resolvePerson(ctx, cmd.Data)
 	if err != nil {
-		return employeedto.EmployeeResponse{}, fmt.Errorf("error creating person: %w", err)
+		return employeedto.EmployeeResponse{}, err
 	}
 	personID := personAgg.Person.ID
+	e := cmd.Data.EmploymentData
+
+	// A foreign worker needs a work permit valid when hired: today, or the start date if it is later
+	hiringDate := uc.now()
+	if e.StartDate.After(hiringDate) {
+		hiringDate = e.StartDate
+	}
+	if np := personAgg.NaturalPerson; np != nil {
+		if err := np.EnsureWorkPermitValidOn(hiringDate); err != nil {
+			return employeedto.EmployeeResponse{}, domain.NewInvalidInputError("validation.failed", err)
+		}
+	}
 
 	// 2. Create Employee entity using the person ID
-	e := cmd.Data.EmploymentData
 	employee, err := entities.NewEmployeeBuilder(personID, e.Salary, e.ContractType, e.StartDate).
 		WithJobDetails(e.Position, e.Department, e.WorkSchedule, e.WorkLocation).
 		WithPayroll(e.BankAccount, e.AFP, e.EPS).
+		WithPension(e.CUSPP).
+		WithEndDate(e.EndDate).
 		WithBenefitFlags(e.HasCTS, e.HasGratification, e.HasVacation).
 		Build()
 	if err != nil {
-		return employeedto.EmployeeResponse{}, fmt.Errorf("error creating employee: %w", err)
+		return employeedto.EmployeeResponse{}, domain.NewInvalidInputError("validation.failed", err)
 	}
 
 	// 3. Perform domain validations using a domain service
@@ -65,7 +116,7 @@ func (uc *RegisterEmployeeUseCase) Execute(ctx context.Context, cmd RegisterEmpl
 		ContractType: e.ContractType,
 	}
 	if err := uc.laborService.ValidateEmployeeRegistration(employee, employmentData); err != nil {
-		return employeedto.EmployeeResponse{}, fmt.Errorf("legal validation error: %w", err)
+		return employeedto.EmployeeResponse{}, domain.NewBusinessRuleError("labor.rules_violated", err)
 	}
 
 	// 4. Calculate benefits
@@ -75,14 +126,73 @@ func (uc *RegisterEmployeeUseCase) Execute(ctx context.Context, cmd RegisterEmpl
 	}
 	employee.AssignBenefits(benefits)
 
-	// 5. Persist person and employee
-	if err := uc.personRepo.SavePerson(ctx, personAgg); err != nil {
-		return employeedto.EmployeeResponse{}, fmt.Errorf("error saving person: %w", err)
+	// 5. Persist person (new or synced) and employee
+	if isNewPerson {
+		if err := uc.personRepo.SavePerson(ctx, personAgg); err != nil {
+			return employeedto.EmployeeResponse{}, fmt.Errorf("error saving person: %w", err)
+		}
+	} else if cmd.Data.PersonRef.PersonUpdate != nil {
+		if err := uc.personRepo.UpdatePerson(ctx, personAgg); err != nil {
+			return employeedto.EmployeeResponse{}, fmt.Errorf("error updating person: %w", err)
+		}
 	}
 	if err := uc.employeeRepo.SaveEmployee(ctx, employee); err != nil {
 		return employeedto.EmployeeResponse{}, fmt.Errorf("error saving employee: %w", err)
 	}
+	application.RecordEvents(ctx, personAgg.PullEvents()...)
+	application.RecordEvents(ctx, employee.PullEvents()...)
+
+	// 6. Map to output DTO, masking what the executing user's roles cannot see
+	viewer := masking.DefaultPolicy().NewViewer(cmd.UserRoles...)
+	return employeedto.NewEmployeeResponse(employee, personAgg, viewer), nil
+}
+
+// resolvePerson returns the person the new employment belongs to and whether it must be created.
+// When the command references an existing person, only the employment is created, the optional
+// contact data is applied to the existing aggregate to keep it in sync and the person is re-validated.
+func (uc *RegisterEmployeeUseCase) resolvePerson(ctx context.Context, data employeedto.EmployeeRegistrationRequest) (*aggregates.PersonAggregate, bool, error) {
+	if data.PersonRef == nil {
+		if data.PersonData == nil {
+			return nil, false, domain.NewInvalidInputError("employee.person_required", nil)
+		}
+		personParams, err := mappers.ToPersonFactoryParams(*data.PersonData)
+		if err != nil {
+			return nil, false, domain.NewInvalidInputError("validation.failed", err)
+		}
+		personAgg, err := factories.CreatePerson(personParams)
+		if err != nil {
+			return nil, false, domain.NewInvalidInputError("validation.failed", err)
+		}
+		return personAgg, true, nil
+	}
+
+	ref := data.PersonRef
+	var personAgg *aggregates.PersonAggregate
+	var err error
+	if ref.PersonID != "" {
+		personAgg, err = uc.personRepo.GetPersonByID(ctx, ref.PersonID)
+	} else {
+		documentType := ref.DocumentType
+		if documentType == "" {
+			documentType = string(value_objects.DNI)
+		}
+		personAgg, err = uc.personRepo.GetPersonByDocument(ctx, documentType, ref.DocumentNumber)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error loading person: %w", err)
+	}
+	if personAgg == nil {
+		return nil, false, domain.NewNotFoundError("person.referenced_not_found", nil)
+	}
 
-	// 6. Map to output DTO
-	return employeedto.NewEmployeeResponse(employee, personAgg), nil
+	if ref.PersonUpdate != nil {
+		if err := mappers.ApplyPersonUpdate(personAgg, *ref.PersonUpdate); err != nil {
+			return nil, false, err
+		}
+	}
+	// The stored person may predate the current validation rules
+	if err := personAgg.Validate(); err != nil {
+		return nil, false, domain.NewInvalidInputError("validation.failed", err)
+	}
+	return personAgg, false, nil
 }
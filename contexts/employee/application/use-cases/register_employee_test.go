@@ -12,10 +12,14 @@ import (
 	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
 	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
 	employee_value_objects "github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
 	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	entities_shared "github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	shared_vo "github.com/kevinsoras/employee-management/shared/domain/value_objects"
 	shared_dto "github.com/kevinsoras/employee-management/shared/application/dto"
 	sharedInfra "github.com/kevinsoras/employee-management/shared/infrastructure"
 )
@@ -38,6 +42,28 @@ func (m *MockEmployeeRepository) GetEmployeeByID(ctx context.Context, id string)
 	return args.Get(0).(*entities.Employee), args.Error(1)
 }
 
+func (m *MockEmployeeRepository) UpdateEmployee(ctx context.Context, employee *entities.Employee) error {
+	args := m.Called(ctx, employee)
+	return args.Error(0)
+}
+
+func (m *MockEmployeeRepository) ReassignPerson(ctx context.Context, fromPersonID, toPersonID string) (int, error) {
+	args := m.Called(ctx, fromPersonID, toPersonID)
+	return args.Int(0), args.Error(1)
+}
+
+// StreamEmployees calls fn with the records given as the first return value
+func (m *MockEmployeeRepository) StreamEmployees(ctx context.Context, filter repositories.EmployeeFilter, fn func(repositories.EmployeeRecord) error) error {
+	args := m.Called(ctx, filter)
+	records, _ := args.Get(0).([]repositories.EmployeeRecord)
+	for _, record := range records {
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
 // MockPersonRepository is a mock implementation of PersonRepository
 type MockPersonRepository struct {
 	mock.Mock
@@ -48,6 +74,11 @@ func (m *MockPersonRepository) SavePerson(ctx context.Context, person *aggregate
 	return args.Error(0)
 }
 
+func (m *MockPersonRepository) UpdatePerson(ctx context.Context, person *aggregates.PersonAggregate) error {
+	args := m.Called(ctx, person)
+	return args.Error(0)
+}
+
 func (m *MockPersonRepository) GetPersonByID(ctx context.Context, id string) (*aggregates.PersonAggregate, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -56,6 +87,32 @@ func (m *MockPersonRepository) GetPersonByID(ctx context.Context, id string) (*a
 	return args.Get(0).(*aggregates.PersonAggregate), args.Error(1)
 }
 
+func (m *MockPersonRepository) GetPersonByDocument(ctx context.Context, documentType, documentNumber string) (*aggregates.PersonAggregate, error) {
+	args := m.Called(ctx, documentType, documentNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*aggregates.PersonAggregate), args.Error(1)
+}
+
+func (m *MockPersonRepository) FindDuplicateCandidates(ctx context.Context, person *aggregates.PersonAggregate) ([]*aggregates.PersonAggregate, error) {
+	args := m.Called(ctx, person)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*aggregates.PersonAggregate), args.Error(1)
+}
+
+func (m *MockPersonRepository) DeletePerson(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPersonRepository) SavePersonMerge(ctx context.Context, merge *entities_shared.PersonMerge) error {
+	args := m.Called(ctx, merge)
+	return args.Error(0)
+}
+
 // MockPeruvianLaborService is a mock implementation of LaborService
 type MockPeruvianLaborService struct {
 	mock.Mock
@@ -66,6 +123,11 @@ func (m *MockPeruvianLaborService) ValidateEmployeeRegistration(employee *entiti
 	return args.Error(0)
 }
 
+func (m *MockPeruvianLaborService) ValidateSalary(salary float64) error {
+	args := m.Called(salary)
+	return args.Error(0)
+}
+
 func (m *MockPeruvianLaborService) CalculateBenefits(employee *entities.Employee) (employee_value_objects.Benefits, error) {
 	args := m.Called(employee)
 	return args.Get(0).(employee_value_objects.Benefits), args.Error(1)
@@ -81,7 +143,7 @@ func TestRegisterEmployeeUseCase_Execute_Success(t *testing.T) {
 
 	cmd := usecases.RegisterEmployeeCommand{
 		Data: employeedto.EmployeeRegistrationRequest{
-			PersonData: shared_dto.PersonRequest{
+			PersonData: &shared_dto.PersonRequest{
 				Type:            "NATURAL",
 				FirstName:       "John",
 				LastNamePaternal: "Doe",
@@ -110,6 +172,8 @@ func TestRegisterEmployeeUseCase_Execute_Success(t *testing.T) {
 				HasVacation:    true,
 			},
 		},
+		ExecutingUserID: "hr-analyst-1",
+		UserRoles:       []security.Role{security.RoleHRAnalyst},
 	}
 
 	ctx := context.Background()
@@ -144,7 +208,7 @@ func TestRegisterEmployeeUseCase_Execute_PersonCreationError(t *testing.T) {
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "", // Invalid data to trigger person creation error
 			LastNamePaternal: "Doe",
@@ -182,8 +246,13 @@ func TestRegisterEmployeeUseCase_Execute_PersonCreationError(t *testing.T) {
 	employeeResp, err := useCase.Execute(ctx, cmd)
 
 	// Then
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "error creating person")
+	var domainErr *sharedDomain.DomainError
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "INVALID_INPUT", domainErr.Code)
+	var fieldErr *sharedDomain.FieldError
+	assert.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "firstName", fieldErr.Field)
+	assert.Equal(t, "required", fieldErr.Rule)
 	assert.Equal(t, employeedto.EmployeeResponse{}, employeeResp)
 
 	mockEmployeeRepo.AssertNotCalled(t, "SaveEmployee", mock.Anything, mock.Anything)
@@ -201,7 +270,7 @@ func TestRegisterEmployeeUseCase_Execute_EmployeeCreationError(t *testing.T) {
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "John",
 			LastNamePaternal: "Doe",
@@ -242,15 +311,19 @@ func TestRegisterEmployeeUseCase_Execute_EmployeeCreationError(t *testing.T) {
 	mockPersonRepo.On("SavePerson", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockEmployeeRepo.On("SaveEmployee", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-
 	cmd := usecases.RegisterEmployeeCommand{Data: req}
 
 	// When
 	employeeResp, err := useCase.Execute(ctx, cmd)
 
 	// Then
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "error creating employee")
+	var domainErr *sharedDomain.DomainError
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "INVALID_INPUT", domainErr.Code)
+	var fieldErr *sharedDomain.FieldError
+	assert.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "salary", fieldErr.Field)
+	assert.Equal(t, "gt", fieldErr.Rule)
 	assert.Equal(t, employeedto.EmployeeResponse{}, employeeResp)
 
 	mockEmployeeRepo.AssertNotCalled(t, "SaveEmployee", mock.Anything, mock.Anything)
@@ -268,7 +341,7 @@ func TestRegisterEmployeeUseCase_Execute_LaborServiceValidationError(t *testing.
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "John",
 			LastNamePaternal: "Doe",
@@ -299,7 +372,7 @@ func TestRegisterEmployeeUseCase_Execute_LaborServiceValidationError(t *testing.
 	}
 
 	ctx := context.Background()
-	validationErr := errors.New("labor service validation failed")
+	validationErr := sharedDomain.NewFieldError("salary", "min", "labor.minimum_wage", sharedDomain.Params{"amount": "S/1,130"})
 
 	// Mock expectations
 	mockLaborService.On("ValidateEmployeeRegistration", mock.Anything, mock.Anything).Return(validationErr)
@@ -314,8 +387,10 @@ func TestRegisterEmployeeUseCase_Execute_LaborServiceValidationError(t *testing.
 	employeeResp, err := useCase.Execute(ctx, cmd)
 
 	// Then
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "legal validation error")
+	var domainErr *sharedDomain.DomainError
+	assert.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "BUSINESS_RULE_VIOLATION", domainErr.Code)
+	assert.ErrorIs(t, err, validationErr)
 	assert.Equal(t, employeedto.EmployeeResponse{}, employeeResp)
 
 	mockLaborService.AssertCalled(t, "ValidateEmployeeRegistration", mock.Anything, mock.Anything)
@@ -333,7 +408,7 @@ func TestRegisterEmployeeUseCase_Execute_CalculateBenefitsError(t *testing.T) {
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "John",
 			LastNamePaternal: "Doe",
@@ -398,7 +473,7 @@ func TestRegisterEmployeeUseCase_Execute_SavePersonError(t *testing.T) {
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "John",
 			LastNamePaternal: "Doe",
@@ -463,7 +538,7 @@ func TestRegisterEmployeeUseCase_Execute_SavePersonUniqueConstraintError(t *test
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "John",
 			LastNamePaternal: "Doe",
@@ -528,7 +603,7 @@ func TestRegisterEmployeeUseCase_Execute_SaveEmployeeError(t *testing.T) {
 	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
 
 	req := employeedto.EmployeeRegistrationRequest{
-		PersonData: shared_dto.PersonRequest{
+		PersonData: &shared_dto.PersonRequest{
 			Type:            "NATURAL",
 			FirstName:       "John",
 			LastNamePaternal: "Doe",
@@ -582,4 +657,240 @@ func TestRegisterEmployeeUseCase_Execute_SaveEmployeeError(t *testing.T) {
 	mockLaborService.AssertCalled(t, "CalculateBenefits", mock.Anything)
 	mockPersonRepo.AssertCalled(t, "SavePerson", mock.Anything, mock.Anything)
 	mockEmployeeRepo.AssertCalled(t, "SaveEmployee", mock.Anything, mock.Anything)
-}
\ No newline at end of file
+}
+
+func existingPersonAggregate() *aggregates.PersonAggregate {
+	person := entities_shared.NewPerson(shared_vo.Natural, "john.doe@example.com", "123456789", "123 Main St", "Peru")
+	birthDate := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	firstName, lastPat, gender := "John", "Doe", "M"
+	natural, _ := entities_shared.NewNaturalPerson(person.ID, shared_vo.DNI, "12345678", &firstName, &lastPat, nil, &gender, &birthDate, nil, nil)
+	return aggregates.NewPersonAggregate(person, natural, nil)
+}
+
+func secondEmploymentData() employeedto.EmploymentData {
+	return employeedto.EmploymentData{
+		Salary:       3000.0,
+		ContractType: "indefinido",
+		StartDate:    time.Now(),
+		Position:     "Analyst",
+		Department:   "Finance",
+		WorkSchedule: "part-time",
+		WorkLocation: "office",
+		BankAccount:  "1234567890",
+		AFP:          "Integra",
+		EPS:          "Rimac",
+	}
+}
+
+func TestRegisterEmployeeUseCase_Execute_ExistingPersonByID(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+	existing := existingPersonAggregate()
+
+	cmd := usecases.RegisterEmployeeCommand{
+		Data: employeedto.EmployeeRegistrationRequest{
+			PersonRef:      &employeedto.PersonReference{PersonID: existing.Person.ID},
+			EmploymentData: secondEmploymentData(),
+		},
+	}
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+	mockLaborService.On("ValidateEmployeeRegistration", mock.Anything, mock.Anything).Return(nil)
+	benefits, _ := employee_value_objects.NewBenefits(0.0, 0.0, 0)
+	mockLaborService.On("CalculateBenefits", mock.Anything).Return(benefits, nil)
+	mockEmployeeRepo.On("SaveEmployee", mock.Anything, mock.MatchedBy(func(e *entities.Employee) bool {
+		return e.PersonID() == existing.Person.ID
+	})).Return(nil)
+
+	// When
+	employeeResp, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, existing.Person.ID, employeeResp.Person.ID)
+	mockPersonRepo.AssertNotCalled(t, "SavePerson", mock.Anything, mock.Anything)
+	mockPersonRepo.AssertNotCalled(t, "UpdatePerson", mock.Anything, mock.Anything)
+	mockEmployeeRepo.AssertExpectations(t)
+}
+
+func TestRegisterEmployeeUseCase_Execute_ExistingPersonWithExpiredWorkPermit(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+	person := entities_shared.NewPerson(shared_vo.Natural, "maria.gomez@example.com", "987654321", "Av. Lima 123", "Peru")
+	birthDate := time.Date(1992, 5, 10, 0, 0, 0, 0, time.UTC)
+	permitExpiry := time.Now().AddDate(1, 0, 0)
+	firstName, lastPat, gender, nationality := "Maria", "Gomez", "F", "VEN"
+	natural, err := entities_shared.NewNaturalPerson(person.ID, shared_vo.CE, "001234567", &firstName, &lastPat, nil, &gender, &birthDate, &nationality, &permitExpiry)
+	assert.NoError(t, err)
+	// The permit was valid when the person was registered and has expired since
+	natural.WorkPermitExpiry = time.Now().AddDate(0, 0, -1)
+	existing := aggregates.NewPersonAggregate(person, natural, nil)
+
+	cmd := usecases.RegisterEmployeeCommand{
+		Data: employeedto.EmployeeRegistrationRequest{
+			PersonRef:      &employeedto.PersonReference{PersonID: existing.Person.ID},
+			EmploymentData: secondEmploymentData(),
+		},
+	}
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+
+	// When
+	_, err = useCase.Execute(context.Background(), cmd)
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "INVALID_INPUT", domainErr.Code)
+	var fieldErr *sharedDomain.FieldError
+	assert.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "workPermitExpiry", fieldErr.Field)
+	assert.Equal(t, "person.work_permit_expired", fieldErr.MessageKey)
+	mockEmployeeRepo.AssertNotCalled(t, "SaveEmployee", mock.Anything, mock.Anything)
+	mockLaborService.AssertNotCalled(t, "ValidateEmployeeRegistration", mock.Anything, mock.Anything)
+}
+
+func TestRegisterEmployeeUseCase_Execute_WorkPermitExpiringBeforeStartDate(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+	permitExpiry := time.Now().AddDate(0, 1, 0)
+	employment := secondEmploymentData()
+	employment.StartDate = permitExpiry.AddDate(0, 0, 1)
+
+	cmd := usecases.RegisterEmployeeCommand{
+		Data: employeedto.EmployeeRegistrationRequest{
+			PersonData: &shared_dto.PersonRequest{
+				Type:             "NATURAL",
+				DocumentType:     "CE",
+				DocumentNumber:   "001234567",
+				Nationality:      "VEN",
+				WorkPermitExpiry: &permitExpiry,
+				FirstName:        "Maria",
+				LastNamePaternal: "Gomez",
+				Email:            "maria.gomez@example.com",
+				Phone:            "987654321",
+				BirthDate:        time.Date(1992, 5, 10, 0, 0, 0, 0, time.UTC),
+				Gender:           "F",
+			},
+			EmploymentData: employment,
+		},
+	}
+
+	// When
+	_, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	var fieldErr *sharedDomain.FieldError
+	assert.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "person.work_permit_expired", fieldErr.MessageKey)
+	mockPersonRepo.AssertNotCalled(t, "SavePerson", mock.Anything, mock.Anything)
+	mockEmployeeRepo.AssertNotCalled(t, "SaveEmployee", mock.Anything, mock.Anything)
+}
+
+func TestRegisterEmployeeUseCase_Execute_ExistingPersonByDocumentSyncsContactData(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+	existing := existingPersonAggregate()
+
+	cmd := usecases.RegisterEmployeeCommand{
+		Data: employeedto.EmployeeRegistrationRequest{
+			PersonRef: &employeedto.PersonReference{
+				DocumentNumber: "12345678",
+				PersonUpdate: &shared_dto.PersonUpdateRequest{
+					Email:   "john.new@example.com",
+					Phone:   "912345678",
+					Address: "Av. Nueva 123",
+					Country: "Peru",
+				},
+			},
+			EmploymentData: secondEmploymentData(),
+		},
+	}
+
+	mockPersonRepo.On("GetPersonByDocument", mock.Anything, "DNI", "12345678").Return(existing, nil)
+	mockPersonRepo.On("UpdatePerson", mock.Anything, existing).Return(nil)
+	mockLaborService.On("ValidateEmployeeRegistration", mock.Anything, mock.Anything).Return(nil)
+	benefits, _ := employee_value_objects.NewBenefits(0.0, 0.0, 0)
+	mockLaborService.On("CalculateBenefits", mock.Anything).Return(benefits, nil)
+	mockEmployeeRepo.On("SaveEmployee", mock.Anything, mock.Anything).Return(nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "john.new@example.com", string(existing.Person.Email))
+	mockPersonRepo.AssertExpectations(t)
+	mockPersonRepo.AssertNotCalled(t, "SavePerson", mock.Anything, mock.Anything)
+}
+
+func TestRegisterEmployeeUseCase_Execute_ExistingPersonNotFound(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+
+	cmd := usecases.RegisterEmployeeCommand{
+		Data: employeedto.EmployeeRegistrationRequest{
+			PersonRef:      &employeedto.PersonReference{DocumentType: "CE", DocumentNumber: "X1234567"},
+			EmploymentData: secondEmploymentData(),
+		},
+	}
+
+	mockPersonRepo.On("GetPersonByDocument", mock.Anything, "CE", "X1234567").Return(nil, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "NOT_FOUND", domainErr.Code)
+	mockEmployeeRepo.AssertNotCalled(t, "SaveEmployee", mock.Anything, mock.Anything)
+}
+
+func TestRegisterEmployeeUseCase_Execute_MasksSensitiveDataForNonHRRoles(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewRegisterEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+	existing := existingPersonAggregate()
+
+	cmd := usecases.RegisterEmployeeCommand{
+		Data: employeedto.EmployeeRegistrationRequest{
+			PersonRef:      &employeedto.PersonReference{PersonID: existing.Person.ID},
+			EmploymentData: secondEmploymentData(),
+		},
+		UserRoles: []security.Role{security.RoleManager},
+	}
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+	mockLaborService.On("ValidateEmployeeRegistration", mock.Anything, mock.Anything).Return(nil)
+	benefits, _ := employee_value_objects.NewBenefits(500.0, 500.0, 30)
+	mockLaborService.On("CalculateBenefits", mock.Anything).Return(benefits, nil)
+	mockEmployeeRepo.On("SaveEmployee", mock.Anything, mock.Anything).Return(nil)
+
+	// When
+	employeeResp, err := useCase.Execute(context.Background(), cmd)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "****7890", employeeResp.Employment.BankAccount)
+	assert.Zero(t, employeeResp.Employment.Salary)
+	assert.Nil(t, employeeResp.Employment.Benefits)
+	assert.Equal(t, "12****78", employeeResp.Person.DocumentNumber)
+}
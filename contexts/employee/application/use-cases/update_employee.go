@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	sharedRepository "github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// UpdateEmployeeCommand encapsulates the partial changes to an employee.
+type UpdateEmployeeCommand struct {
+	EmployeeID string
+	// ExpectedVersion is the version the client read (If-Match); the update fails if it changed since.
+	ExpectedVersion int
+	Data            employeedto.EmployeeUpdateRequest
+}
+
+// UpdateEmployeeUseCase changes the salary, job details and payroll data of an employee.
+type UpdateEmployeeUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	personRepo   sharedRepository.PersonRepository
+	laborService services.LaborService
+}
+
+// NewUpdateEmployeeUseCase creates a new UpdateEmployeeUseCase.
+func NewUpdateEmployeeUseCase(employeeRepo repositories.EmployeeRepository, personRepo sharedRepository.PersonRepository, laborService services.LaborService) *UpdateEmployeeUseCase {
+	return &UpdateEmployeeUseCase{
+		employeeRepo: employeeRepo,
+		personRepo:   personRepo,
+		laborService: laborService,
+	}
+}
+
+// Execute loads the employee, checks the expected version, applies the changes and persists them.
+func (uc *UpdateEmployeeUseCase) Execute(ctx context.Context, cmd UpdateEmployeeCommand) (employeedto.EmployeeResponse, error) {
+	// 1. Load the employee and reject stale edits early
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, cmd.EmployeeID)
+	if err != nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("error loading employee: %w", err)
+	}
+	if employee == nil {
+		return employeedto.EmployeeResponse{}, domain.NewNotFoundError("employee.not_found", nil)
+	}
+	if employee.Version() != cmd.ExpectedVersion {
+		return employeedto.EmployeeResponse{}, domain.NewVersionConflictError("employee.version_conflict", nil)
+	}
+
+	// 2. Apply the changes and validate the result
+	if err := uc.applyChanges(employee, cmd.Data); err != nil {
+		return employeedto.EmployeeResponse{}, err
+	}
+
+	// 3. Persist (the repository re-checks the version to catch concurrent updates)
+	if err := uc.employeeRepo.UpdateEmployee(ctx, employee); err != nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("error updating employee: %w", err)
+	}
+	application.RecordEvents(ctx, employee.PullEvents()...)
+
+	personAgg, err := uc.personRepo.GetPersonByID(ctx, employee.PersonID())
+	if err != nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("error loading person: %w", err)
+	}
+	if personAgg == nil {
+		return employeedto.EmployeeResponse{}, fmt.Errorf("person %s of employee %s not found", employee.PersonID(), employee.ID())
+	}
+	return employeedto.NewEmployeeResponse(employee, personAgg, masking.ViewerFromContext(ctx)), nil
+}
+
+// applyChanges merges the fields sent in the request with the current ones; a salary change
+// is checked against the minimum wage and recalculates the benefits.
+func (uc *UpdateEmployeeUseCase) applyChanges(employee *entities.Employee, data employeedto.EmployeeUpdateRequest) error {
+	if data.Salary != nil {
+		if err := uc.laborService.ValidateSalary(*data.Salary); err != nil {
+			return domain.NewBusinessRuleError("labor.rules_violated", err)
+		}
+		employee.ChangeSalary(*data.Salary)
+	}
+	if data.Position != nil || data.Department != nil || data.WorkSchedule != nil || data.WorkLocation != nil {
+		employee.ChangeJobDetails(
+			valueOr(data.Position, employee.Position()),
+			valueOr(data.Department, employee.Department()),
+			valueOr(data.WorkSchedule, employee.WorkSchedule()),
+			valueOr(data.WorkLocation, employee.WorkLocation()),
+		)
+	}
+	if data.BankAccount != nil || data.AFP != nil || data.EPS != nil {
+		employee.ChangePayroll(
+			valueOr(data.BankAccount, employee.BankAccount()),
+			valueOr(data.AFP, employee.AFP()),
+			valueOr(data.EPS, employee.EPS()),
+		)
+	}
+	if data.CUSPP != nil {
+		employee.ChangeCUSPP(*data.CUSPP)
+	}
+	if data.EndDate != nil {
+		employee.Terminate(*data.EndDate)
+	}
+	if err := employee.Validate(); err != nil {
+		return domain.NewInvalidInputError("validation.failed", err)
+	}
+
+	if data.Salary != nil {
+		benefits, err := uc.laborService.CalculateBenefits(employee)
+		if err != nil {
+			return fmt.Errorf("error calculating benefits: %w", err)
+		}
+		employee.AssignBenefits(benefits)
+	}
+	return nil
+}
+
+func valueOr(value *string, current string) string {
+	if value == nil {
+		return current
+	}
+	return *value
+}
@@ -0,0 +1,211 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	employee_events "github.com/kevinsoras/employee-management/contexts/employee/domain/events"
+	employee_value_objects "github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/application"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+func existingEmployee(t *testing.T, personID string) *entities.Employee {
+	t.Helper()
+	employee, err := entities.NewEmployeeBuilder(personID, 3000.0, "INDEFINIDO", time.Now().AddDate(-1, 0, 0)).
+		WithJobDetails("Developer", "IT", "Full-time", "Lima").
+		WithPayroll("1234567890", "AFP Integra", "EPS Rimac").
+		WithBenefitFlags(true, true, true).
+		Build()
+	require.NoError(t, err)
+	return employee
+}
+
+func TestUpdateEmployeeUseCase_Execute_SalaryChange(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewUpdateEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+
+	personAgg := existingPersonAggregate()
+	employee := existingEmployee(t, personAgg.Person.ID)
+	newSalary, newPosition := 4500.0, "Senior Developer"
+	benefits, _ := employee_value_objects.NewBenefits(437.5, 4500.0, 30)
+
+	mockEmployeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+	mockLaborService.On("ValidateSalary", newSalary).Return(nil)
+	mockLaborService.On("CalculateBenefits", employee).Return(benefits, nil)
+	mockEmployeeRepo.On("UpdateEmployee", mock.Anything, employee).Run(func(args mock.Arguments) {
+		args.Get(1).(*entities.Employee).IncrementVersion()
+	}).Return(nil)
+	mockPersonRepo.On("GetPersonByID", mock.Anything, personAgg.Person.ID).Return(personAgg, nil)
+
+	ctx := security.WithPrincipal(context.Background(), &security.Principal{UserID: "u1", Roles: []security.Role{security.RoleHRAnalyst}})
+
+	// When
+	resp, err := useCase.Execute(ctx, usecases.UpdateEmployeeCommand{
+		EmployeeID:      employee.ID(),
+		ExpectedVersion: 1,
+		Data:            employeedto.EmployeeUpdateRequest{Salary: &newSalary, Position: &newPosition},
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.Employment.Version)
+	assert.Equal(t, 4500.0, resp.Employment.Salary)
+	assert.Equal(t, "Senior Developer", resp.Employment.Position)
+	assert.Equal(t, "IT", resp.Employment.Department, "fields not sent keep their value")
+	if assert.NotNil(t, resp.Employment.Benefits) {
+		assert.Equal(t, 4500.0, resp.Employment.Benefits.Gratification)
+	}
+	mockEmployeeRepo.AssertExpectations(t)
+	mockLaborService.AssertExpectations(t)
+}
+
+func TestUpdateEmployeeUseCase_Execute_DispatchesEventsAfterCommit(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	var received []sharedDomain.DomainEvent
+	dispatcher := application.NewEventDispatcher(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	application.Subscribe(dispatcher, func(_ context.Context, event employee_events.SalaryChanged) error {
+		received = append(received, event)
+		return nil
+	})
+	application.Subscribe(dispatcher, func(_ context.Context, event employee_events.EmployeeTerminated) error {
+		received = append(received, event)
+		return nil
+	})
+	application.Subscribe(dispatcher, func(_ context.Context, event employee_events.EmployeeUpdated) error {
+		received = append(received, event)
+		return nil
+	})
+	uow := &FakeUnitOfWork{}
+	useCase := application.NewTransactionalDecorator[usecases.UpdateEmployeeCommand, employeedto.EmployeeResponse](
+		usecases.NewUpdateEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService), uow, dispatcher)
+
+	personAgg := existingPersonAggregate()
+	employee := existingEmployee(t, personAgg.Person.ID)
+	employee.PullEvents() // A loaded employee has no pending events
+	newSalary, endDate := 4500.0, time.Now().AddDate(0, 1, 0).Truncate(24*time.Hour)
+	benefits, _ := employee_value_objects.NewBenefits(437.5, 4500.0, 30)
+	mockEmployeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+	mockLaborService.On("ValidateSalary", newSalary).Return(nil)
+	mockLaborService.On("CalculateBenefits", employee).Return(benefits, nil)
+	mockEmployeeRepo.On("UpdateEmployee", mock.Anything, employee).Run(func(args mock.Arguments) {
+		assert.Empty(t, received, "events wait for the commit")
+		args.Get(1).(*entities.Employee).IncrementVersion()
+	}).Return(nil)
+	mockPersonRepo.On("GetPersonByID", mock.Anything, personAgg.Person.ID).Return(personAgg, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdateEmployeeCommand{
+		EmployeeID:      employee.ID(),
+		ExpectedVersion: 1,
+		Data:            employeedto.EmployeeUpdateRequest{Salary: &newSalary, EndDate: &endDate},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, uow.committed)
+	assert.Equal(t, []sharedDomain.DomainEvent{
+		employee_events.SalaryChanged{EmployeeID: employee.ID(), PreviousSalary: 3000, NewSalary: 4500, At: received[0].OccurredAt()},
+		employee_events.EmployeeTerminated{EmployeeID: employee.ID(), EndDate: endDate, At: received[1].OccurredAt()},
+		employee_events.EmployeeUpdated{EmployeeID: employee.ID(), Version: 2, ChangedFields: []string{"salary", "endDate"}, At: received[2].OccurredAt()},
+	}, received)
+}
+
+func TestUpdateEmployeeUseCase_Execute_VersionConflict(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewUpdateEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+
+	employee := existingEmployee(t, "person-1")
+	employee.IncrementVersion() // Otro analista guardó cambios después de la lectura
+	department := "Finanzas"
+
+	mockEmployeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdateEmployeeCommand{
+		EmployeeID:      employee.ID(),
+		ExpectedVersion: 1,
+		Data:            employeedto.EmployeeUpdateRequest{Department: &department},
+	})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "VERSION_CONFLICT", domainErr.Code)
+	mockEmployeeRepo.AssertNotCalled(t, "UpdateEmployee", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEmployeeUseCase_Execute_SalaryBelowMinimum(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	mockPersonRepo := new(MockPersonRepository)
+	mockLaborService := new(MockPeruvianLaborService)
+	useCase := usecases.NewUpdateEmployeeUseCase(mockEmployeeRepo, mockPersonRepo, mockLaborService)
+
+	employee := existingEmployee(t, "person-1")
+	salary := 900.0
+
+	mockEmployeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+	mockLaborService.On("ValidateSalary", salary).Return(sharedDomain.NewFieldError("salary", "min", "labor.minimum_wage", nil))
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdateEmployeeCommand{
+		EmployeeID:      employee.ID(),
+		ExpectedVersion: 1,
+		Data:            employeedto.EmployeeUpdateRequest{Salary: &salary},
+	})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "BUSINESS_RULE_VIOLATION", domainErr.Code)
+	assert.Equal(t, 3000.0, employee.Salary())
+	mockEmployeeRepo.AssertNotCalled(t, "UpdateEmployee", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEmployeeUseCase_Execute_RejectsEndDateBeforeStart(t *testing.T) {
+	// Given
+	mockEmployeeRepo := new(MockEmployeeRepository)
+	useCase := usecases.NewUpdateEmployeeUseCase(mockEmployeeRepo, new(MockPersonRepository), new(MockPeruvianLaborService))
+
+	employee := existingEmployee(t, "person-1")
+	endDate := employee.StartDate().AddDate(0, 0, -1)
+	cuspp := "580201aqmma5"
+
+	mockEmployeeRepo.On("GetEmployeeByID", mock.Anything, employee.ID()).Return(employee, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdateEmployeeCommand{
+		EmployeeID:      employee.ID(),
+		ExpectedVersion: 1,
+		Data:            employeedto.EmployeeUpdateRequest{CUSPP: &cuspp, EndDate: &endDate},
+	})
+
+	// Then
+	var fieldErr *sharedDomain.FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "endDate", fieldErr.Field)
+	assert.Equal(t, "580201AQMMA5", employee.CUSPP())
+	mockEmployeeRepo.AssertNotCalled(t, "UpdateEmployee", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+)
+
+// UpdateWebhookSubscriptionCommand carries the new settings of a subscription.
+type UpdateWebhookSubscriptionCommand struct {
+	ExecutingUserID string
+	SubscriptionID  string
+	Data            employeedto.WebhookSubscriptionUpdateRequest
+}
+
+// UpdateWebhookSubscriptionUseCase replaces the endpoint, events and description of a
+// subscription, pauses or resumes it and optionally rotates its secret. Pending deliveries keep
+// their payload but are sent to the new endpoint, signed with the current secret.
+type UpdateWebhookSubscriptionUseCase struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	sender           services.WebhookSender // Decides which endpoints may be registered
+}
+
+// NewUpdateWebhookSubscriptionUseCase creates a new UpdateWebhookSubscriptionUseCase.
+func NewUpdateWebhookSubscriptionUseCase(subscriptionRepo repositories.WebhookSubscriptionRepository,
+	sender services.WebhookSender) *UpdateWebhookSubscriptionUseCase {
+	return &UpdateWebhookSubscriptionUseCase{subscriptionRepo: subscriptionRepo, sender: sender}
+}
+
+// Execute returns the new secret only when it was rotated.
+func (uc *UpdateWebhookSubscriptionUseCase) Execute(ctx context.Context, cmd UpdateWebhookSubscriptionCommand) (employeedto.WebhookSubscriptionResponse, error) {
+	subscription, err := loadWebhookSubscription(ctx, uc.subscriptionRepo, cmd.SubscriptionID)
+	if err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+
+	active := subscription.Active()
+	if cmd.Data.Active != nil {
+		active = *cmd.Data.Active
+	}
+	if err := subscription.Change(cmd.Data.URL, cmd.Data.Events, cmd.Data.Description, active); err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+	if err := checkWebhookURL(uc.sender, subscription); err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, err
+	}
+	if cmd.Data.RotateSecret {
+		if err := subscription.RotateSecret(cmd.Data.Secret); err != nil {
+			return employeedto.WebhookSubscriptionResponse{}, err
+		}
+	}
+	if err := uc.subscriptionRepo.UpdateSubscription(ctx, subscription); err != nil {
+		return employeedto.WebhookSubscriptionResponse{}, fmt.Errorf("error saving webhook subscription: %w", err)
+	}
+
+	resp := employeedto.NewWebhookSubscriptionResponse(subscription)
+	if cmd.Data.RotateSecret {
+		resp.Secret = subscription.Secret()
+	}
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// UploadEmployeeDocumentCommand carries an uploaded file and its metadata. Type and ExpiresAt
+// come as sent in the form; ExpiresAt is YYYY-MM-DD or empty.
+type UploadEmployeeDocumentCommand struct {
+	EmployeeID      string
+	Type            string
+	ExpiresAt       string
+	FileName        string
+	Content         []byte
+	ExecutingUserID string
+}
+
+// UploadEmployeeDocumentUseCase attaches a document to an employee: the file goes to the blob
+// store and its metadata to the repository.
+type UploadEmployeeDocumentUseCase struct {
+	employeeRepo repositories.EmployeeRepository
+	documentRepo repositories.EmployeeDocumentRepository
+	blobStore    services.BlobStore
+}
+
+// NewUploadEmployeeDocumentUseCase creates a new UploadEmployeeDocumentUseCase.
+func NewUploadEmployeeDocumentUseCase(employeeRepo repositories.EmployeeRepository, documentRepo repositories.EmployeeDocumentRepository,
+	blobStore services.BlobStore) *UploadEmployeeDocumentUseCase {
+	return &UploadEmployeeDocumentUseCase{employeeRepo: employeeRepo, documentRepo: documentRepo, blobStore: blobStore}
+}
+
+// Execute stores the file before its metadata, so a listed document always has its file. If the
+// metadata cannot be saved the file is removed; a failed removal only leaves an unreferenced blob.
+func (uc *UploadEmployeeDocumentUseCase) Execute(ctx context.Context, cmd UploadEmployeeDocumentCommand) (employeedto.EmployeeDocumentResponse, error) {
+	var fieldErrs []domain.FieldError
+	documentType, err := value_objects.NewEmployeeDocumentType(cmd.Type)
+	var fieldErr *domain.FieldError
+	if errors.As(err, &fieldErr) {
+		fieldErrs = append(fieldErrs, *fieldErr)
+	}
+	var expiresAt *time.Time
+	if raw := strings.TrimSpace(cmd.ExpiresAt); raw != "" {
+		expiry, err := time.Parse(time.DateOnly, raw)
+		if err != nil {
+			fieldErrs = append(fieldErrs, *domain.NewFieldError("expiresAt", "datetime", "validation.datetime", domain.Params{"format": "YYYY-MM-DD"}))
+		}
+		expiresAt = &expiry
+	}
+	if len(fieldErrs) > 0 {
+		return employeedto.EmployeeDocumentResponse{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(fieldErrs...)
+	}
+
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, cmd.EmployeeID)
+	if err != nil {
+		return employeedto.EmployeeDocumentResponse{}, fmt.Errorf("error loading employee: %w", err)
+	}
+	if employee == nil {
+		return employeedto.EmployeeDocumentResponse{}, domain.NewNotFoundError("employee.not_found", nil)
+	}
+
+	document, err := entities.NewEmployeeDocument(employee.ID(), documentType, cmd.FileName, cmd.Content, expiresAt, cmd.ExecutingUserID)
+	if err != nil {
+		return employeedto.EmployeeDocumentResponse{}, err
+	}
+	if err := uc.blobStore.Put(ctx, document.StorageKey(), cmd.Content, document.ContentType()); err != nil {
+		return employeedto.EmployeeDocumentResponse{}, fmt.Errorf("error storing document file: %w", err)
+	}
+	if err := uc.documentRepo.SaveDocument(ctx, document); err != nil {
+		if deleteErr := uc.blobStore.Delete(ctx, document.StorageKey()); deleteErr != nil {
+			err = fmt.Errorf("%w (the file %s could not be removed: %v)", err, document.StorageKey(), deleteErr)
+		}
+		return employeedto.EmployeeDocumentResponse{}, fmt.Errorf("error saving document: %w", err)
+	}
+	return employeedto.NewEmployeeDocumentResponse(document, time.Now()), nil
+}
@@ -0,0 +1,334 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	employeedto "github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	employee_events "github.com/kevinsoras/employee-management/contexts/employee/domain/events"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	sharedApplication "github.com/kevinsoras/employee-management/shared/application"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	sharedEntities "github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+// webhookStore keeps the subscriptions and deliveries in memory; every pending delivery is due
+type webhookStore struct {
+	subscriptions []*entities.WebhookSubscription
+	deliveries    []*entities.WebhookDelivery
+	lookups       int
+	leasedUntil   time.Time // leaseUntil of the last claim
+	saveErr       error
+}
+
+func (s *webhookStore) SaveSubscription(_ context.Context, subscription *entities.WebhookSubscription) error {
+	s.subscriptions = append(s.subscriptions, subscription)
+	return nil
+}
+
+func (s *webhookStore) UpdateSubscription(context.Context, *entities.WebhookSubscription) error {
+	return nil
+}
+
+func (s *webhookStore) DeleteSubscription(_ context.Context, id string) error {
+	for i, subscription := range s.subscriptions {
+		if subscription.ID() == id {
+			s.subscriptions = append(s.subscriptions[:i], s.subscriptions[i+1:]...)
+			return nil
+		}
+	}
+	return sharedDomain.NewNotFoundError("webhook.subscription_not_found", nil)
+}
+
+func (s *webhookStore) GetSubscription(_ context.Context, id string) (*entities.WebhookSubscription, error) {
+	s.lookups++
+	for _, subscription := range s.subscriptions {
+		if subscription.ID() == id {
+			return subscription, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *webhookStore) ListSubscriptions(context.Context) ([]*entities.WebhookSubscription, error) {
+	return s.subscriptions, nil
+}
+
+func (s *webhookStore) ListSubscribers(_ context.Context, eventName string) ([]*entities.WebhookSubscription, error) {
+	var subscribers []*entities.WebhookSubscription
+	for _, subscription := range s.subscriptions {
+		if subscription.Subscribes(eventName) {
+			subscribers = append(subscribers, subscription)
+		}
+	}
+	return subscribers, nil
+}
+
+// SaveDeliveries skips the deliveries of an event a subscription already has, like the UNIQUE
+// constraint of the table
+func (s *webhookStore) SaveDeliveries(_ context.Context, deliveries ...*entities.WebhookDelivery) error {
+	for _, delivery := range deliveries {
+		if !slices.ContainsFunc(s.deliveries, func(saved *entities.WebhookDelivery) bool {
+			return saved.SubscriptionID() == delivery.SubscriptionID() && saved.EventID() == delivery.EventID()
+		}) {
+			s.deliveries = append(s.deliveries, delivery)
+		}
+	}
+	return nil
+}
+
+func (s *webhookStore) ClaimDueDeliveries(_ context.Context, limit int, _, leaseUntil time.Time) ([]*entities.WebhookDelivery, error) {
+	s.leasedUntil = leaseUntil
+	var due []*entities.WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if delivery.Status() == entities.WebhookDeliveryPending && len(due) < limit {
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (s *webhookStore) SaveAttempt(context.Context, *entities.WebhookDelivery) error {
+	return s.saveErr
+}
+
+func (s *webhookStore) ListDeliveries(_ context.Context, subscriptionID string, status entities.WebhookDeliveryStatus, limit int) ([]*entities.WebhookDelivery, error) {
+	var deliveries []*entities.WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if delivery.SubscriptionID() == subscriptionID && (status == "" || delivery.Status() == status) && len(deliveries) < limit {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+// fakeWebhookSender answers every request with the status of its destination URL and only accepts
+// https endpoints
+type fakeWebhookSender struct {
+	statuses map[string]int
+	sent     []services.WebhookRequest
+}
+
+func (s *fakeWebhookSender) CheckURL(url string) error {
+	if !strings.HasPrefix(url, "https://") {
+		return errors.New("not allowed")
+	}
+	return nil
+}
+
+func (s *fakeWebhookSender) Send(_ context.Context, request services.WebhookRequest) (int, error) {
+	s.sent = append(s.sent, request)
+	status := s.statuses[request.URL]
+	if status < 200 || status > 299 {
+		return status, errors.New("rejected")
+	}
+	return status, nil
+}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func newOutboxMessage(t *testing.T, event sharedDomain.DomainEvent) *sharedEntities.OutboxMessage {
+	message, err := sharedEntities.NewOutboxMessage(event)
+	require.NoError(t, err)
+	return message
+}
+
+// enqueueTermination fans the termination of the employee out as the outbox relay does
+func enqueueTermination(t *testing.T, store *webhookStore, employeeID string) {
+	message := newOutboxMessage(t, employee_events.EmployeeTerminated{EmployeeID: employeeID, At: time.Now()})
+	_, err := usecases.NewEnqueueWebhookDeliveriesUseCase(store, store).Execute(context.Background(), message)
+	require.NoError(t, err)
+}
+
+func newSubscription(t *testing.T, url string, events ...string) *entities.WebhookSubscription {
+	subscription, err := entities.NewWebhookSubscription(url, "", events, "", "u1")
+	require.NoError(t, err)
+	return subscription
+}
+
+func TestCreateWebhookSubscriptionUseCase_ReturnsTheGeneratedSecretOnlyOnCreate(t *testing.T) {
+	store := &webhookStore{}
+
+	resp, err := usecases.NewCreateWebhookSubscriptionUseCase(store, &fakeWebhookSender{}).Execute(context.Background(), usecases.CreateWebhookSubscriptionCommand{
+		ExecutingUserID: "u1",
+		Data:            employeedto.WebhookSubscriptionRequest{URL: "https://access.example.com/hooks", Events: []string{" Employee.Terminated "}},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(resp.Secret, "whsec_"))
+	assert.Equal(t, []string{"employee.terminated"}, resp.Events)
+	assert.True(t, resp.Active)
+	listed, err := usecases.NewListWebhookSubscriptionsUseCase(store).Execute(context.Background(), usecases.ListWebhookSubscriptionsQuery{})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Empty(t, listed[0].Secret, "the secret is never read back")
+}
+
+func TestCreateWebhookSubscriptionUseCase_RejectsUnknownEventsAndURLs(t *testing.T) {
+	useCase := usecases.NewCreateWebhookSubscriptionUseCase(&webhookStore{}, &fakeWebhookSender{})
+
+	_, err := useCase.Execute(context.Background(), usecases.CreateWebhookSubscriptionCommand{
+		Data: employeedto.WebhookSubscriptionRequest{URL: "https://access.example.com/hooks", Events: []string{"employee.promoted"}},
+	})
+	var fieldErr *sharedDomain.FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "webhook.event_unknown", fieldErr.MessageKey)
+
+	_, err = useCase.Execute(context.Background(), usecases.CreateWebhookSubscriptionCommand{
+		Data: employeedto.WebhookSubscriptionRequest{URL: "ftp://access.example.com", Events: []string{"employee.terminated"}},
+	})
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "webhook.url_invalid", fieldErr.MessageKey)
+
+	_, err = useCase.Execute(context.Background(), usecases.CreateWebhookSubscriptionCommand{
+		Data: employeedto.WebhookSubscriptionRequest{URL: "http://10.0.0.5/hooks", Events: []string{"employee.terminated"}},
+	})
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "webhook.url_not_allowed", fieldErr.MessageKey)
+}
+
+func TestEnqueueWebhookDeliveriesUseCase_QueuesTheSamePayloadForEverySubscriber(t *testing.T) {
+	store := &webhookStore{}
+	accessControl := newSubscription(t, "https://access.example.com/hooks", "employee.terminated")
+	mailing := newSubscription(t, "https://mail.example.com/hooks", "employee.terminated", "employee.registered")
+	payroll := newSubscription(t, "https://payroll.example.com/hooks", "employee.salary_changed")
+	paused := newSubscription(t, "https://old.example.com/hooks", "employee.terminated")
+	require.NoError(t, paused.Change(paused.URL(), paused.Events(), "", false))
+	store.subscriptions = []*entities.WebhookSubscription{accessControl, mailing, payroll, paused}
+	endDate := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	message := newOutboxMessage(t, employee_events.EmployeeTerminated{EmployeeID: "emp-1", EndDate: endDate, At: endDate})
+	useCase := usecases.NewEnqueueWebhookDeliveriesUseCase(store, store)
+
+	queued, err := useCase.Execute(context.Background(), message)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, queued)
+	require.Len(t, store.deliveries, 2)
+	first, second := store.deliveries[0], store.deliveries[1]
+	assert.Equal(t, accessControl.ID(), first.SubscriptionID())
+	assert.Equal(t, mailing.ID(), second.SubscriptionID())
+	assert.Equal(t, message.ID, first.EventID(), "the event ID is the outbox message ID")
+	assert.Equal(t, first.EventID(), second.EventID())
+	assert.Equal(t, first.Payload(), second.Payload())
+	assert.JSONEq(t, `{"id":"`+message.ID+`","event":"employee.terminated","occurredAt":"2025-10-31T00:00:00Z",
+		"data":{"employeeId":"emp-1","endDate":"2025-10-31T00:00:00Z","occurredAt":"2025-10-31T00:00:00Z"}}`, string(first.Payload()))
+
+	_, err = useCase.Execute(context.Background(), message)
+	require.NoError(t, err)
+	assert.Len(t, store.deliveries, 2, "a retried message queues no new deliveries")
+}
+
+func TestEnqueueWebhookDeliveriesUseCase_IgnoresEventsWebhooksCannotSubscribeTo(t *testing.T) {
+	store := &webhookStore{}
+	store.subscriptions = []*entities.WebhookSubscription{newSubscription(t, "https://access.example.com/hooks", "employee.terminated")}
+
+	queued, err := usecases.NewEnqueueWebhookDeliveriesUseCase(store, store).Execute(context.Background(),
+		&sharedEntities.OutboxMessage{ID: "m1", EventName: "person.registered", Payload: []byte(`{}`)})
+
+	require.NoError(t, err)
+	assert.Zero(t, queued)
+	assert.Empty(t, store.deliveries)
+}
+
+func TestDeliverWebhooksUseCase_RetriesWithBackoffUntilFailed(t *testing.T) {
+	store := &webhookStore{}
+	healthy := newSubscription(t, "https://access.example.com/hooks", "employee.terminated")
+	broken := newSubscription(t, "https://mail.example.com/hooks", "employee.terminated")
+	store.subscriptions = []*entities.WebhookSubscription{healthy, broken}
+	enqueueTermination(t, store, "emp-1")
+	enqueueTermination(t, store, "emp-2")
+	sender := &fakeWebhookSender{statuses: map[string]int{healthy.URL(): 204, broken.URL(): 500}}
+	retry := sharedDomain.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	useCase := usecases.NewDeliverWebhooksUseCase(store, store, sender,
+		usecases.DeliverWebhooksConfig{LeaseDuration: 2 * time.Minute, Retry: retry}, discardLogger)
+
+	handled, err := useCase.Execute(context.Background(), 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, handled)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), store.leasedUntil, time.Second, "the batch is leased, not locked")
+	assert.Equal(t, 2, store.lookups, "each subscription is loaded once per batch")
+	delivered, failing := store.deliveries[0], store.deliveries[1]
+	assert.Equal(t, entities.WebhookDeliveryDelivered, delivered.Status())
+	assert.Equal(t, 204, delivered.LastStatusCode())
+	assert.Equal(t, entities.WebhookDeliveryPending, failing.Status())
+	assert.Equal(t, 500, failing.LastStatusCode())
+	assert.Equal(t, "rejected", failing.LastError())
+	assert.WithinDuration(t, failing.LastAttemptAt().Add(time.Minute), failing.NextAttemptAt(), time.Second)
+	assert.Equal(t, healthy.Secret(), sender.sent[0].Secret)
+	assert.Equal(t, delivered.ID(), sender.sent[0].DeliveryID)
+
+	_, _ = useCase.Execute(context.Background(), 10)
+	assert.WithinDuration(t, failing.LastAttemptAt().Add(2*time.Minute), failing.NextAttemptAt(), time.Second, "the wait doubles")
+	handled, err = useCase.Execute(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, handled)
+	assert.Equal(t, entities.WebhookDeliveryFailed, failing.Status())
+	assert.Equal(t, 3, failing.Attempts())
+
+	handled, err = sharedApplication.NewPoller("webhook-deliveries", useCase.Execute, sharedApplication.PollerConfig{BatchSize: 10},
+		discardLogger).Drain(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, handled, "failed deliveries are not retried anymore")
+}
+
+func TestDeliverWebhooksUseCase_SaveFailureKeepsSendingTheBatch(t *testing.T) {
+	store := &webhookStore{saveErr: errors.New("connection reset")}
+	subscription := newSubscription(t, "https://access.example.com/hooks", "employee.terminated")
+	store.subscriptions = []*entities.WebhookSubscription{subscription}
+	enqueueTermination(t, store, "emp-1")
+	enqueueTermination(t, store, "emp-2")
+	sender := &fakeWebhookSender{statuses: map[string]int{subscription.URL(): 204}}
+	useCase := usecases.NewDeliverWebhooksUseCase(store, store, sender, usecases.DeliverWebhooksConfig{}, discardLogger)
+
+	handled, err := useCase.Execute(context.Background(), 10)
+
+	require.Error(t, err)
+	assert.Equal(t, 2, handled)
+	assert.Len(t, sender.sent, 2, "one failed write does not stop the others")
+}
+
+func TestListWebhookDeliveriesUseCase_FiltersByStatusAndChecksTheLimit(t *testing.T) {
+	store := &webhookStore{}
+	subscription := newSubscription(t, "https://access.example.com/hooks", "employee.terminated")
+	store.subscriptions = []*entities.WebhookSubscription{subscription}
+	enqueueTermination(t, store, "emp-1")
+	useCase := usecases.NewListWebhookDeliveriesUseCase(store, store)
+
+	pending, err := useCase.Execute(context.Background(), usecases.ListWebhookDeliveriesQuery{
+		SubscriptionID: subscription.ID(), Filter: employeedto.WebhookDeliveriesRequest{Status: "PENDING"}})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.NotNil(t, pending[0].NextAttemptAt)
+	var payload employeedto.WebhookPayload
+	require.NoError(t, json.Unmarshal(pending[0].Payload, &payload))
+	assert.Equal(t, "employee.terminated", payload.Event)
+
+	delivered, err := useCase.Execute(context.Background(), usecases.ListWebhookDeliveriesQuery{
+		SubscriptionID: subscription.ID(), Filter: employeedto.WebhookDeliveriesRequest{Status: "DELIVERED"}})
+	require.NoError(t, err)
+	assert.Empty(t, delivered)
+
+	_, err = useCase.Execute(context.Background(), usecases.ListWebhookDeliveriesQuery{
+		SubscriptionID: subscription.ID(), Filter: employeedto.WebhookDeliveriesRequest{Limit: "1000"}})
+	var domainErr *sharedDomain.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "INVALID_INPUT", domainErr.Code)
+
+	_, err = useCase.Execute(context.Background(), usecases.ListWebhookDeliveriesQuery{SubscriptionID: "missing"})
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "NOT_FOUND", domainErr.Code)
+}
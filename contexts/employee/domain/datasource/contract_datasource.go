@@ -0,0 +1,34 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+)
+
+// ContractTemplateDataSource define el contrato para fuentes de datos de plantillas de contrato
+// (solo interfaz, sin implementación)
+type ContractTemplateDataSource interface {
+	// SaveTemplate guarda una versión nueva; falla con ALREADY_EXISTS si la versión ya existe
+	SaveTemplate(ctx context.Context, template *entities.ContractTemplate) error
+	// GetLatestTemplate devuelve la última versión de la modalidad, o nil si no tiene plantillas
+	GetLatestTemplate(ctx context.Context, contractType value_objects.ContractType) (*entities.ContractTemplate, error)
+	// GetTemplate devuelve una versión de la modalidad, o nil si no existe
+	GetTemplate(ctx context.Context, contractType value_objects.ContractType, version int) (*entities.ContractTemplate, error)
+	// ListTemplates devuelve las versiones de una modalidad, o de todas si no se indica, de la más reciente a la más antigua
+	ListTemplates(ctx context.Context, contractType value_objects.ContractType) ([]*entities.ContractTemplate, error)
+}
+
+// ContractDocumentDataSource define el contrato para fuentes de datos de contratos generados
+// (solo interfaz, sin implementación)
+type ContractDocumentDataSource interface {
+	// SaveDocument guarda el contrato con su documento
+	SaveDocument(ctx context.Context, document *entities.ContractDocument, content []byte) error
+	// ListDocumentsByEmployee devuelve los contratos del empleado, sin su documento, del más reciente al más antiguo
+	ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.ContractDocument, error)
+	// GetDocumentByID devuelve el contrato sin su documento, o nil si no existe
+	GetDocumentByID(ctx context.Context, id string) (*entities.ContractDocument, error)
+	// GetDocumentContent devuelve el documento del contrato, o nil si no existe
+	GetDocumentContent(ctx context.Context, id string) ([]byte, error)
+}
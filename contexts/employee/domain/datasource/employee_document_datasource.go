@@ -0,0 +1,18 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+)
+
+// EmployeeDocumentDataSource define el contrato para fuentes de datos de documentos adjuntos
+// (solo interfaz, sin implementación)
+type EmployeeDocumentDataSource interface {
+	// SaveDocument guarda los datos del documento
+	SaveDocument(ctx context.Context, document *entities.EmployeeDocument) error
+	// ListDocumentsByEmployee devuelve los documentos del empleado, del más reciente al más antiguo
+	ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.EmployeeDocument, error)
+	// GetDocumentByID devuelve el documento, o nil si no existe
+	GetDocumentByID(ctx context.Context, id string) (*entities.EmployeeDocument, error)
+}
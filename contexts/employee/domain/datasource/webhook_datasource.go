@@ -0,0 +1,41 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+)
+
+// WebhookSubscriptionDataSource define el contrato para fuentes de datos de suscripciones de
+// webhooks (solo interfaz, sin implementación)
+type WebhookSubscriptionDataSource interface {
+	SaveSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error
+	// UpdateSubscription falla con NOT_FOUND si la suscripción no existe
+	UpdateSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error
+	// DeleteSubscription borra la suscripción con su historial de entregas; falla con NOT_FOUND si no existe
+	DeleteSubscription(ctx context.Context, id string) error
+	// GetSubscription devuelve la suscripción, o nil si no existe
+	GetSubscription(ctx context.Context, id string) (*entities.WebhookSubscription, error)
+	// ListSubscriptions devuelve todas las suscripciones, de la más reciente a la más antigua
+	ListSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error)
+	// ListSubscribers devuelve las suscripciones activas que reciben el evento
+	ListSubscribers(ctx context.Context, eventName string) ([]*entities.WebhookSubscription, error)
+}
+
+// WebhookDeliveryDataSource define el contrato para fuentes de datos de entregas de webhooks
+// (solo interfaz, sin implementación)
+type WebhookDeliveryDataSource interface {
+	// SaveDeliveries encola las entregas. Se omiten las que ya existen para la misma suscripción y
+	// evento, así el relay puede repetir el reparto de un evento sin duplicarlas.
+	SaveDeliveries(ctx context.Context, deliveries ...*entities.WebhookDelivery) error
+	// ClaimDueDeliveries reserva hasta limit entregas pendientes de suscripciones activas cuyo
+	// reintento ya venció, en orden de llegada, moviendo su próximo intento a leaseUntil. La reserva
+	// se confirma en el acto: los demás procesos no las ven hasta que venza.
+	ClaimDueDeliveries(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.WebhookDelivery, error)
+	// SaveAttempt guarda el resultado del envío (estado, intentos, respuesta) y libera la reserva
+	SaveAttempt(ctx context.Context, delivery *entities.WebhookDelivery) error
+	// ListDeliveries devuelve las entregas de la suscripción, de la más reciente a la más antigua,
+	// opcionalmente solo las de un estado
+	ListDeliveries(ctx context.Context, subscriptionID string, status entities.WebhookDeliveryStatus, limit int) ([]*entities.WebhookDelivery, error)
+}
@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+)
+
+// ContractDocument - contrato generado para un empleado a partir de una versión de plantilla.
+// El documento se guarda tal como se generó, con su hash SHA-256 para verificarlo al descargarlo.
+type ContractDocument struct {
+	id              string
+	employeeID      string
+	templateID      string
+	templateVersion int
+	contractType    value_objects.ContractType
+	format          value_objects.DocumentFormat
+	contentHash     string
+	size            int
+	generatedBy     string
+	generatedAt     time.Time
+}
+
+// NewContractDocument registra el documento generado con la plantilla indicada.
+func NewContractDocument(employeeID string, template *ContractTemplate, format value_objects.DocumentFormat, content []byte, generatedBy string) *ContractDocument {
+	return &ContractDocument{
+		id:              uuid.New().String(),
+		employeeID:      employeeID,
+		templateID:      template.ID(),
+		templateVersion: template.Version(),
+		contractType:    template.ContractType(),
+		format:          format,
+		contentHash:     documentHash(content),
+		size:            len(content),
+		generatedBy:     generatedBy,
+		generatedAt:     time.Now(),
+	}
+}
+
+// RestoreContractDocument reconstruye un documento guardado.
+func RestoreContractDocument(id, employeeID, templateID string, templateVersion int, contractType value_objects.ContractType,
+	format value_objects.DocumentFormat, contentHash string, size int, generatedBy string, generatedAt time.Time) *ContractDocument {
+	return &ContractDocument{
+		id: id, employeeID: employeeID, templateID: templateID, templateVersion: templateVersion, contractType: contractType,
+		format: format, contentHash: contentHash, size: size, generatedBy: generatedBy, generatedAt: generatedAt,
+	}
+}
+
+func (d *ContractDocument) ID() string {
+	return d.id
+}
+
+func (d *ContractDocument) EmployeeID() string {
+	return d.employeeID
+}
+
+func (d *ContractDocument) TemplateID() string {
+	return d.templateID
+}
+
+func (d *ContractDocument) TemplateVersion() int {
+	return d.templateVersion
+}
+
+func (d *ContractDocument) ContractType() value_objects.ContractType {
+	return d.contractType
+}
+
+func (d *ContractDocument) Format() value_objects.DocumentFormat {
+	return d.format
+}
+
+func (d *ContractDocument) ContentHash() string {
+	return d.contentHash
+}
+
+func (d *ContractDocument) Size() int {
+	return d.size
+}
+
+func (d *ContractDocument) GeneratedBy() string {
+	return d.generatedBy
+}
+
+func (d *ContractDocument) GeneratedAt() time.Time {
+	return d.generatedAt
+}
+
+// Matches indica si el contenido es el mismo documento que se generó.
+func (d *ContractDocument) Matches(content []byte) bool {
+	return documentHash(content) == d.contentHash
+}
+
+func documentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
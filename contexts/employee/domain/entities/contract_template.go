@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// placeholderPattern - los campos de la plantilla se escriben como {{worker.fullName}}
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z.]+)\s*\}\}`)
+
+// ContractPlaceholders son los campos que una plantilla puede usar
+var ContractPlaceholders = []string{
+	"employer.businessName", "employer.ruc", "employer.address",
+	"employer.representativeName", "employer.representativeDocument",
+	"worker.fullName", "worker.documentType", "worker.documentNumber", "worker.address", "worker.nationality",
+	"position", "department", "workLocation", "workSchedule", "salary",
+	"startDate", "endDate", "cause", "educationCenter", "signatureDate",
+}
+
+// requiredPlaceholders - lo que la ley exige que conste en el contrato de cada modalidad: el
+// contrato sujeto a modalidad debe expresar su causa objetiva y su duración (D.S. 003-97-TR,
+// art. 72) y el convenio de prácticas el centro de formación (Ley 28518)
+var requiredPlaceholders = map[value_objects.ContractType][]string{
+	value_objects.ContractFixedTerm:  {"cause", "endDate"},
+	value_objects.ContractInternship: {"educationCenter"},
+}
+
+// ContractTemplate - plantilla de contrato de una modalidad. Las plantillas no se modifican: cada
+// cambio crea una versión nueva y los contratos generados guardan la versión que usaron.
+//
+// El cuerpo es texto plano: los párrafos se separan con una línea en blanco y un párrafo que
+// empieza con "# " es el título de una cláusula.
+type ContractTemplate struct {
+	id           string
+	contractType value_objects.ContractType
+	version      int
+	title        string
+	body         string
+	createdBy    string
+	createdAt    time.Time
+}
+
+// NewContractTemplate crea la versión indicada de la plantilla de una modalidad.
+func NewContractTemplate(contractType value_objects.ContractType, version int, title, body, createdBy string) (*ContractTemplate, error) {
+	t := &ContractTemplate{
+		id:           uuid.New().String(),
+		contractType: contractType,
+		version:      version,
+		title:        strings.TrimSpace(title),
+		body:         strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n")),
+		createdBy:    createdBy,
+		createdAt:    time.Now(),
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// RestoreContractTemplate reconstruye una plantilla guardada.
+func RestoreContractTemplate(id string, contractType value_objects.ContractType, version int, title, body, createdBy string, createdAt time.Time) *ContractTemplate {
+	return &ContractTemplate{id: id, contractType: contractType, version: version, title: title, body: body, createdBy: createdBy, createdAt: createdAt}
+}
+
+func (t *ContractTemplate) ID() string {
+	return t.id
+}
+
+func (t *ContractTemplate) ContractType() value_objects.ContractType {
+	return t.contractType
+}
+
+func (t *ContractTemplate) Version() int {
+	return t.version
+}
+
+func (t *ContractTemplate) Title() string {
+	return t.title
+}
+
+func (t *ContractTemplate) Body() string {
+	return t.body
+}
+
+func (t *ContractTemplate) CreatedBy() string {
+	return t.createdBy
+}
+
+func (t *ContractTemplate) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// Placeholders devuelve los campos que usa la plantilla, sin repetir y en orden de aparición.
+func (t *ContractTemplate) Placeholders() []string {
+	var placeholders []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(t.title+"\n"+t.body, -1) {
+		if !slices.Contains(placeholders, match[1]) {
+			placeholders = append(placeholders, match[1])
+		}
+	}
+	return placeholders
+}
+
+// Fill reemplaza cada campo por su valor en el texto indicado.
+func (t *ContractTemplate) Fill(text string, values map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return values[placeholderPattern.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+// Validate valida los campos requeridos y que la plantilla solo use campos conocidos.
+func (t *ContractTemplate) Validate() error {
+	if t.version < 1 {
+		return domain.NewFieldError("version", "min", "validation.min", domain.Params{"min": 1})
+	}
+	if t.title == "" {
+		return domain.NewRequiredFieldError("title")
+	}
+	if len(t.title) > 150 {
+		return domain.NewMaxLengthFieldError("title", 150)
+	}
+	if t.body == "" {
+		return domain.NewRequiredFieldError("body")
+	}
+	if len(t.body) > 50000 {
+		return domain.NewMaxLengthFieldError("body", 50000)
+	}
+	placeholders := t.Placeholders()
+	for _, placeholder := range placeholders {
+		if !slices.Contains(ContractPlaceholders, placeholder) {
+			return domain.NewFieldError("body", "placeholder", "contract.template_placeholder_unknown", domain.Params{"placeholder": placeholder})
+		}
+	}
+	for _, placeholder := range requiredPlaceholders[t.contractType] {
+		if !slices.Contains(placeholders, placeholder) {
+			return domain.NewFieldError("body", "placeholder", "contract.template_placeholder_required",
+				domain.Params{"placeholder": placeholder, "contractType": t.contractType})
+		}
+	}
+	return nil
+}
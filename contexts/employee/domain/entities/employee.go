@@ -1,12 +1,19 @@
 package entities
 
 import (
-	"errors"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/events"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
 )
 
+// cusppPattern - el CUSPP tiene 12 caracteres alfanuméricos
+var cusppPattern = regexp.MustCompile(`^[0-9A-Z]{12}$`)
+
 // Employee representa el agregado raíz de empleado
 type Employee struct {
 	id               string
@@ -21,12 +28,17 @@ type Employee struct {
 	bankAccount      string
 	afp              string
 	eps              string
+	cuspp            string     // Código Único del Sistema Privado de Pensiones; vacío si aún no lo tiene o aporta a la ONP
+	endDate          *time.Time // Fecha de cese; nil mientras el vínculo laboral sigue vigente
 	hasCTS           bool
 	hasGratification bool
 	hasVacation      bool
 	benefits         value_objects.Benefits
+	version          int // Control de concurrencia optimista: aumenta en cada actualización
 	createdAt        time.Time
 	updatedAt        time.Time
+	events           domain.EventRecorder // eventos pendientes de publicar
+	changedFields    []string             // campos modificados desde la última versión guardada
 }
 
 // --- Getters ---
@@ -79,6 +91,14 @@ func (e *Employee) EPS() string {
 	return e.eps
 }
 
+func (e *Employee) CUSPP() string {
+	return e.cuspp
+}
+
+func (e *Employee) EndDate() *time.Time {
+	return e.endDate
+}
+
 func (e *Employee) HasCTS() bool {
 	return e.hasCTS
 }
@@ -95,6 +115,10 @@ func (e *Employee) Benefits() value_objects.Benefits {
 	return e.benefits
 }
 
+func (e *Employee) Version() int {
+	return e.version
+}
+
 func (e *Employee) CreatedAt() time.Time {
 	return e.createdAt
 }
@@ -110,70 +134,158 @@ func (e *Employee) AssignBenefits(benefits value_objects.Benefits) {
 	e.benefits = benefits
 }
 
+// ChangeSalary actualiza el salario; los beneficios deben recalcularse después
+func (e *Employee) ChangeSalary(salary float64) {
+	if salary != e.salary {
+		e.events.Record(events.SalaryChanged{EmployeeID: e.id, PreviousSalary: e.salary, NewSalary: salary, At: time.Now()})
+		e.trackChange("salary")
+	}
+	e.salary = salary
+	e.updatedAt = time.Now()
+}
+
+// ChangeJobDetails actualiza los detalles del puesto de trabajo
+func (e *Employee) ChangeJobDetails(position, department, workSchedule, workLocation string) {
+	e.trackChangeIf(position != e.position, "position")
+	e.trackChangeIf(department != e.department, "department")
+	e.trackChangeIf(workSchedule != e.workSchedule, "workSchedule")
+	e.trackChangeIf(workLocation != e.workLocation, "workLocation")
+	e.position = position
+	e.department = department
+	e.workSchedule = workSchedule
+	e.workLocation = workLocation
+	e.updatedAt = time.Now()
+}
+
+// ChangePayroll actualiza la información de nómina
+func (e *Employee) ChangePayroll(bankAccount, afp, eps string) {
+	e.trackChangeIf(bankAccount != e.bankAccount, "bankAccount")
+	e.trackChangeIf(afp != e.afp, "afp")
+	e.trackChangeIf(eps != e.eps, "eps")
+	e.bankAccount = bankAccount
+	e.afp = afp
+	e.eps = eps
+	e.updatedAt = time.Now()
+}
+
+// ChangeCUSPP registra el CUSPP asignado por la AFP
+func (e *Employee) ChangeCUSPP(cuspp string) {
+	e.trackChangeIf(normalizeCUSPP(cuspp) != e.cuspp, "cuspp")
+	e.cuspp = normalizeCUSPP(cuspp)
+	e.updatedAt = time.Now()
+}
+
+// Terminate registra el cese del empleado: su último día de trabajo
+func (e *Employee) Terminate(endDate time.Time) {
+	if e.endDate == nil || !e.endDate.Equal(endDate) {
+		e.events.Record(events.EmployeeTerminated{EmployeeID: e.id, EndDate: endDate, At: time.Now()})
+		e.trackChange("endDate")
+	}
+	e.endDate = &endDate
+	e.updatedAt = time.Now()
+}
+
+// PullEvents devuelve los eventos pendientes del empleado y los descarta.
+func (e *Employee) PullEvents() []domain.DomainEvent {
+	return e.events.PullEvents()
+}
+
+// IncrementVersion registra que la persistencia guardó una nueva versión del empleado. Si hubo
+// cambios, la versión guardada se publica como EmployeeUpdated.
+func (e *Employee) IncrementVersion() {
+	e.version++
+	if len(e.changedFields) > 0 {
+		e.events.Record(events.EmployeeUpdated{EmployeeID: e.id, Version: e.version, ChangedFields: e.changedFields, At: time.Now()})
+		e.changedFields = nil
+	}
+}
+
+func (e *Employee) trackChangeIf(changed bool, field string) {
+	if changed {
+		e.trackChange(field)
+	}
+}
+
+func (e *Employee) trackChange(field string) {
+	if !slices.Contains(e.changedFields, field) {
+		e.changedFields = append(e.changedFields, field)
+	}
+}
+
+func normalizeCUSPP(cuspp string) string {
+	return strings.ToUpper(strings.TrimSpace(cuspp))
+}
+
 // Validate valida los campos requeridos y reglas de negocio para Employee
 func (e *Employee) Validate() error {
 	if e.personID == "" {
-		return errors.New("personID es obligatorio")
+		return domain.NewRequiredFieldError("personId")
 	}
 	if e.salary <= 0 {
-		return errors.New("el salario debe ser mayor a 0")
+		return domain.NewFieldError("salary", "gt", "validation.gt", domain.Params{"min": 0})
 	}
 	if e.contractType == "" {
-		return errors.New("contractType es obligatorio")
+		return domain.NewRequiredFieldError("contractType")
 	}
 	if len(e.contractType) > 30 {
-		return errors.New("contractType demasiado largo")
+		return domain.NewMaxLengthFieldError("contractType", 30)
 	}
 	if e.position == "" {
-		return errors.New("position es obligatorio")
+		return domain.NewRequiredFieldError("position")
 	}
 	if len(e.position) > 50 {
-		return errors.New("position demasiado largo")
+		return domain.NewMaxLengthFieldError("position", 50)
 	}
 	if e.department == "" {
-		return errors.New("department es obligatorio")
+		return domain.NewRequiredFieldError("department")
 	}
 	if len(e.department) > 50 {
-		return errors.New("department demasiado largo")
+		return domain.NewMaxLengthFieldError("department", 50)
 	}
 	if e.workSchedule == "" {
-		return errors.New("workSchedule es obligatorio")
+		return domain.NewRequiredFieldError("workSchedule")
 	}
 	if len(e.workSchedule) > 30 {
-		return errors.New("workSchedule demasiado largo")
+		return domain.NewMaxLengthFieldError("workSchedule", 30)
 	}
 	if e.workLocation == "" {
-		return errors.New("workLocation es obligatorio")
+		return domain.NewRequiredFieldError("workLocation")
 	}
 	if len(e.workLocation) > 100 {
-		return errors.New("workLocation demasiado largo")
+		return domain.NewMaxLengthFieldError("workLocation", 100)
 	}
 	if e.bankAccount == "" {
-		return errors.New("bankAccount es obligatorio")
+		return domain.NewRequiredFieldError("bankAccount")
 	}
 	if len(e.bankAccount) > 30 {
-		return errors.New("bankAccount demasiado largo")
+		return domain.NewMaxLengthFieldError("bankAccount", 30)
 	}
 	if e.afp == "" {
-		return errors.New("AFP es obligatorio")
+		return domain.NewRequiredFieldError("afp")
 	}
 	if len(e.afp) > 30 {
-		return errors.New("AFP demasiado largo")
+		return domain.NewMaxLengthFieldError("afp", 30)
 	}
 	if e.eps == "" {
-		return errors.New("EPS es obligatorio")
+		return domain.NewRequiredFieldError("eps")
 	}
 	if len(e.eps) > 50 {
-		return errors.New("EPS demasiado largo")
+		return domain.NewMaxLengthFieldError("eps", 50)
+	}
+	if e.cuspp != "" && !cusppPattern.MatchString(e.cuspp) {
+		return domain.NewFieldError("cuspp", "cuspp", "employee.cuspp_invalid", nil)
 	}
 	if e.startDate.IsZero() {
-		return errors.New("startDate es obligatorio")
+		return domain.NewRequiredFieldError("startDate")
 	}
 	if e.startDate.After(time.Now().AddDate(0, 1, 0)) {
-		return errors.New("startDate no puede ser en el futuro lejano")
+		return domain.NewFieldError("startDate", "max", "employee.start_date_too_far", nil)
 	}
 	if e.startDate.Before(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)) {
-		return errors.New("startDate no puede ser antes del año 2000")
+		return domain.NewFieldError("startDate", "min", "validation.date_min", domain.Params{"min": 2000})
+	}
+	if e.endDate != nil && e.endDate.Before(e.startDate) {
+		return domain.NewFieldError("endDate", "gtefield", "employee.end_date_before_start", nil)
 	}
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/events"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
 )
 
@@ -41,6 +42,18 @@ func (b *EmployeeBuilder) WithPayroll(bankAccount, afp, eps string) *EmployeeBui
 	return b
 }
 
+// WithPension registra el CUSPP del afiliado a una AFP.
+func (b *EmployeeBuilder) WithPension(cuspp string) *EmployeeBuilder {
+	b.employee.cuspp = normalizeCUSPP(cuspp)
+	return b
+}
+
+// WithEndDate registra la fecha de cese; nil si el empleado sigue activo.
+func (b *EmployeeBuilder) WithEndDate(endDate *time.Time) *EmployeeBuilder {
+	b.employee.endDate = endDate
+	return b
+}
+
 // WithBenefitFlags agrupa la configuración de los indicadores de beneficios.
 func (b *EmployeeBuilder) WithBenefitFlags(hasCTS, hasGratification, hasVacation bool) *EmployeeBuilder {
 	b.employee.hasCTS = hasCTS
@@ -56,6 +69,7 @@ func (b *EmployeeBuilder) Build() (*Employee, error) {
 		return nil, err
 	}
 	b.employee.id = u7.String()
+	b.employee.version = 1
 	b.employee.createdAt = time.Now()
 	b.employee.updatedAt = time.Now()
 
@@ -67,5 +81,24 @@ func (b *EmployeeBuilder) Build() (*Employee, error) {
 		return nil, err
 	}
 
+	b.employee.events.Record(events.EmployeeRegistered{
+		EmployeeID:   b.employee.id,
+		PersonID:     b.employee.personID,
+		ContractType: b.employee.contractType,
+		Salary:       b.employee.salary,
+		StartDate:    b.employee.startDate,
+		At:           b.employee.createdAt,
+	})
 	return b.employee, nil
 }
+
+// Restore reconstruye un empleado ya persistido con su identidad, beneficios y versión.
+// Los datos ya fueron validados al guardarse, por eso no se vuelven a validar.
+func (b *EmployeeBuilder) Restore(id string, benefits value_objects.Benefits, version int, createdAt, updatedAt time.Time) *Employee {
+	b.employee.id = id
+	b.employee.benefits = benefits
+	b.employee.version = version
+	b.employee.createdAt = createdAt
+	b.employee.updatedAt = updatedAt
+	return b.employee
+}
@@ -0,0 +1,177 @@
+package entities
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// MaxEmployeeDocumentSize - tamaño máximo de un documento adjunto (10 MB)
+const MaxEmployeeDocumentSize = 10 << 20
+
+const maxDocumentFileNameLength = 255
+
+// allowedDocumentTypes - tipos de archivo aceptados; se reconocen por su contenido, no por el
+// tipo que declara el cliente
+var allowedDocumentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// EmployeeDocument - documento adjunto al legajo de un empleado (contrato firmado, DNI, certificado
+// médico...). Aquí se guardan sus datos; el archivo queda en el almacenamiento bajo StorageKey.
+type EmployeeDocument struct {
+	id           string
+	employeeID   string
+	documentType value_objects.EmployeeDocumentType
+	fileName     string
+	contentType  string
+	size         int
+	contentHash  string
+	storageKey   string
+	expiresAt    *time.Time
+	uploadedBy   string
+	uploadedAt   time.Time
+}
+
+// NewEmployeeDocument valida el archivo subido: no vacío, de hasta MaxEmployeeDocumentSize y de un
+// tipo permitido. Un archivo demasiado grande es PAYLOAD_TOO_LARGE y uno de otro tipo
+// UNSUPPORTED_MEDIA_TYPE.
+func NewEmployeeDocument(employeeID string, documentType value_objects.EmployeeDocumentType, fileName string,
+	content []byte, expiresAt *time.Time, uploadedBy string) (*EmployeeDocument, error) {
+	if len(content) == 0 {
+		return nil, domain.NewRequiredFieldError("file")
+	}
+	if len(content) > MaxEmployeeDocumentSize {
+		return nil, domain.NewPayloadTooLargeError("document.too_large", nil).
+			WithParams(domain.Params{"maxMB": MaxEmployeeDocumentSize >> 20})
+	}
+	contentType := DetectDocumentContentType(content)
+	if _, ok := allowedDocumentTypes[contentType]; !ok {
+		return nil, domain.NewUnsupportedMediaTypeError("document.mime_not_allowed", nil).
+			WithParams(domain.Params{"contentType": contentType, "allowed": "PDF, JPEG, PNG"})
+	}
+
+	id := uuid.New().String()
+	uploadedAt := time.Now()
+	if expiresAt != nil {
+		expiry := time.Date(expiresAt.Year(), expiresAt.Month(), expiresAt.Day(), 0, 0, 0, 0, time.UTC)
+		expiresAt = &expiry
+	}
+	return &EmployeeDocument{
+		id:           id,
+		employeeID:   employeeID,
+		documentType: documentType,
+		fileName:     cleanDocumentFileName(fileName, string(documentType), allowedDocumentTypes[contentType]),
+		contentType:  contentType,
+		size:         len(content),
+		contentHash:  documentHash(content),
+		storageKey:   "employees/" + employeeID + "/" + id,
+		expiresAt:    expiresAt,
+		uploadedBy:   uploadedBy,
+		uploadedAt:   uploadedAt,
+	}, nil
+}
+
+// RestoreEmployeeDocument reconstruye un documento guardado.
+func RestoreEmployeeDocument(id, employeeID string, documentType value_objects.EmployeeDocumentType, fileName, contentType string,
+	size int, contentHash, storageKey string, expiresAt *time.Time, uploadedBy string, uploadedAt time.Time) *EmployeeDocument {
+	return &EmployeeDocument{
+		id: id, employeeID: employeeID, documentType: documentType, fileName: fileName, contentType: contentType, size: size,
+		contentHash: contentHash, storageKey: storageKey, expiresAt: expiresAt, uploadedBy: uploadedBy, uploadedAt: uploadedAt,
+	}
+}
+
+// DetectDocumentContentType reconoce el tipo del archivo por sus primeros bytes, sin parámetros.
+func DetectDocumentContentType(content []byte) string {
+	contentType, _, _ := strings.Cut(http.DetectContentType(content), ";")
+	return contentType
+}
+
+// cleanDocumentFileName deja solo el nombre del archivo, sin rutas ni caracteres de control; sin
+// nombre se usa la clase de documento con la extensión de su tipo
+func cleanDocumentFileName(fileName, fallback, extension string) string {
+	fileName = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, filepath.Base(strings.ReplaceAll(fileName, "\\", "/")))
+	fileName = strings.TrimSpace(fileName)
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return strings.ToLower(fallback) + extension
+	}
+	for utf8.RuneCountInString(fileName) > maxDocumentFileNameLength {
+		_, size := utf8.DecodeRuneInString(fileName)
+		fileName = fileName[size:]
+	}
+	return fileName
+}
+
+func (d *EmployeeDocument) ID() string {
+	return d.id
+}
+
+func (d *EmployeeDocument) EmployeeID() string {
+	return d.employeeID
+}
+
+func (d *EmployeeDocument) DocumentType() value_objects.EmployeeDocumentType {
+	return d.documentType
+}
+
+func (d *EmployeeDocument) FileName() string {
+	return d.fileName
+}
+
+func (d *EmployeeDocument) ContentType() string {
+	return d.contentType
+}
+
+func (d *EmployeeDocument) Size() int {
+	return d.size
+}
+
+func (d *EmployeeDocument) ContentHash() string {
+	return d.contentHash
+}
+
+func (d *EmployeeDocument) StorageKey() string {
+	return d.storageKey
+}
+
+// ExpiresAt es la fecha de vencimiento del documento (un DNI, un certificado médico), o nil.
+func (d *EmployeeDocument) ExpiresAt() *time.Time {
+	return d.expiresAt
+}
+
+func (d *EmployeeDocument) UploadedBy() string {
+	return d.uploadedBy
+}
+
+func (d *EmployeeDocument) UploadedAt() time.Time {
+	return d.uploadedAt
+}
+
+// IsExpired indica si el documento ya venció en la fecha indicada; vence al terminar su día de
+// vencimiento.
+func (d *EmployeeDocument) IsExpired(at time.Time) bool {
+	if d.expiresAt == nil {
+		return false
+	}
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	return day.After(*d.expiresAt)
+}
+
+// Matches indica si el contenido es el mismo archivo que se subió.
+func (d *EmployeeDocument) Matches(content []byte) bool {
+	return documentHash(content) == d.contentHash
+}
@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// WebhookDeliveryStatus - estado de la entrega de un evento a una suscripción
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"   // Esperando el envío o un reintento
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED" // El destino respondió 2xx
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"    // Agotó los reintentos
+)
+
+// ParseWebhookDeliveryStatus valida un estado recibido como filtro.
+func ParseWebhookDeliveryStatus(status string) (WebhookDeliveryStatus, error) {
+	switch s := WebhookDeliveryStatus(status); s {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryFailed:
+		return s, nil
+	}
+	return "", domain.NewInvalidInputError("webhook.delivery_status_invalid", nil).WithParams(domain.Params{"status": status})
+}
+
+// maxWebhookErrorLength limita el error guardado, que puede incluir la respuesta del destino
+const maxWebhookErrorLength = 1000
+
+// WebhookDelivery - envío de un evento a una suscripción y registro de sus intentos. El cuerpo se
+// arma al encolar la entrega y no cambia entre reintentos, así la firma siempre cubre lo mismo.
+type WebhookDelivery struct {
+	id             string
+	subscriptionID string
+	eventID        string // Igual para todas las suscripciones que reciben el mismo evento
+	eventName      string
+	payload        []byte // Cuerpo JSON que se envía
+	status         WebhookDeliveryStatus
+	attempts       int
+	nextAttemptAt  time.Time
+	lastStatusCode int // 0 si el destino no respondió
+	lastError      string
+	lastAttemptAt  *time.Time
+	deliveredAt    *time.Time
+	createdAt      time.Time
+}
+
+// NewWebhookDelivery encola la entrega para enviarse de inmediato.
+func NewWebhookDelivery(subscriptionID, eventID, eventName string, payload []byte) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		id:             uuid.New().String(),
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventName:      eventName,
+		payload:        payload,
+		status:         WebhookDeliveryPending,
+		nextAttemptAt:  now,
+		createdAt:      now,
+	}
+}
+
+// RestoreWebhookDelivery reconstruye una entrega guardada.
+func RestoreWebhookDelivery(id, subscriptionID, eventID, eventName string, payload []byte, status WebhookDeliveryStatus,
+	attempts int, nextAttemptAt time.Time, lastStatusCode int, lastError string, lastAttemptAt, deliveredAt *time.Time,
+	createdAt time.Time) *WebhookDelivery {
+	return &WebhookDelivery{id: id, subscriptionID: subscriptionID, eventID: eventID, eventName: eventName, payload: payload,
+		status: status, attempts: attempts, nextAttemptAt: nextAttemptAt, lastStatusCode: lastStatusCode, lastError: lastError,
+		lastAttemptAt: lastAttemptAt, deliveredAt: deliveredAt, createdAt: createdAt}
+}
+
+func (d *WebhookDelivery) ID() string {
+	return d.id
+}
+
+func (d *WebhookDelivery) SubscriptionID() string {
+	return d.subscriptionID
+}
+
+func (d *WebhookDelivery) EventID() string {
+	return d.eventID
+}
+
+func (d *WebhookDelivery) EventName() string {
+	return d.eventName
+}
+
+func (d *WebhookDelivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *WebhookDelivery) Status() WebhookDeliveryStatus {
+	return d.status
+}
+
+func (d *WebhookDelivery) Attempts() int {
+	return d.attempts
+}
+
+func (d *WebhookDelivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *WebhookDelivery) LastStatusCode() int {
+	return d.lastStatusCode
+}
+
+func (d *WebhookDelivery) LastError() string {
+	return d.lastError
+}
+
+func (d *WebhookDelivery) LastAttemptAt() *time.Time {
+	return d.lastAttemptAt
+}
+
+func (d *WebhookDelivery) DeliveredAt() *time.Time {
+	return d.deliveredAt
+}
+
+func (d *WebhookDelivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// MarkDelivered registra la respuesta 2xx del destino.
+func (d *WebhookDelivery) MarkDelivered(statusCode int, at time.Time) {
+	d.attempts++
+	d.status = WebhookDeliveryDelivered
+	d.lastStatusCode = statusCode
+	d.lastError = ""
+	d.lastAttemptAt = &at
+	d.deliveredAt = &at
+}
+
+// MarkFailed registra un intento fallido (statusCode 0 si no hubo respuesta) y programa el
+// siguiente según la política. Devuelve true si la entrega agotó los reintentos y quedó FAILED.
+func (d *WebhookDelivery) MarkFailed(statusCode int, cause error, policy domain.RetryPolicy, at time.Time) bool {
+	d.attempts++
+	d.lastStatusCode = statusCode
+	d.lastError = cause.Error()
+	if len(d.lastError) > maxWebhookErrorLength {
+		d.lastError = d.lastError[:maxWebhookErrorLength]
+	}
+	d.lastAttemptAt = &at
+	if policy.Exhausted(d.attempts) {
+		d.status = WebhookDeliveryFailed
+		return true
+	}
+	d.nextAttemptAt = at.Add(policy.Delay(d.attempts))
+	return false
+}
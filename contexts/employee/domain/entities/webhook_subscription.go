@@ -0,0 +1,195 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/events"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// WebhookEvents son los eventos del ciclo de vida del empleado a los que puede suscribirse un webhook
+var WebhookEvents = []string{
+	events.EmployeeRegistered{}.EventName(),
+	events.EmployeeUpdated{}.EventName(),
+	events.SalaryChanged{}.EventName(),
+	events.EmployeeTerminated{}.EventName(),
+}
+
+const (
+	maxWebhookURLLength         = 2048
+	maxWebhookDescriptionLength = 255
+	minWebhookSecretLength      = 16
+	maxWebhookSecretLength      = 255
+)
+
+// WebhookSubscription - sistema externo (control de accesos, correo) que recibe por HTTP los
+// eventos que eligió. Cada entrega se firma con HMAC-SHA256 usando el secreto de la suscripción.
+type WebhookSubscription struct {
+	id          string
+	url         string
+	secret      string
+	events      []string
+	description string
+	active      bool // Una suscripción pausada acumula sus entregas hasta que se reactive
+	createdBy   string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewWebhookSubscription crea una suscripción activa. Sin secreto se genera uno aleatorio.
+func NewWebhookSubscription(endpoint, secret string, eventNames []string, description, createdBy string) (*WebhookSubscription, error) {
+	if secret == "" {
+		var err error
+		if secret, err = GenerateWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	s := &WebhookSubscription{
+		id:          uuid.New().String(),
+		url:         strings.TrimSpace(endpoint),
+		secret:      secret,
+		events:      normalizeWebhookEvents(eventNames),
+		description: strings.TrimSpace(description),
+		active:      true,
+		createdBy:   createdBy,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RestoreWebhookSubscription reconstruye una suscripción guardada.
+func RestoreWebhookSubscription(id, endpoint, secret string, eventNames []string, description string, active bool,
+	createdBy string, createdAt, updatedAt time.Time) *WebhookSubscription {
+	return &WebhookSubscription{id: id, url: endpoint, secret: secret, events: eventNames, description: description,
+		active: active, createdBy: createdBy, createdAt: createdAt, updatedAt: updatedAt}
+}
+
+// GenerateWebhookSecret genera un secreto de 256 bits con el prefijo whsec_.
+func GenerateWebhookSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(raw), nil
+}
+
+func (s *WebhookSubscription) ID() string {
+	return s.id
+}
+
+func (s *WebhookSubscription) URL() string {
+	return s.url
+}
+
+func (s *WebhookSubscription) Secret() string {
+	return s.secret
+}
+
+func (s *WebhookSubscription) Events() []string {
+	return s.events
+}
+
+func (s *WebhookSubscription) Description() string {
+	return s.description
+}
+
+func (s *WebhookSubscription) Active() bool {
+	return s.active
+}
+
+func (s *WebhookSubscription) CreatedBy() string {
+	return s.createdBy
+}
+
+func (s *WebhookSubscription) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *WebhookSubscription) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Subscribes indica si la suscripción recibe el evento.
+func (s *WebhookSubscription) Subscribes(eventName string) bool {
+	return s.active && slices.Contains(s.events, eventName)
+}
+
+// Change reemplaza el destino, los eventos, la descripción y el estado de la suscripción.
+func (s *WebhookSubscription) Change(endpoint string, eventNames []string, description string, active bool) error {
+	s.url = strings.TrimSpace(endpoint)
+	s.events = normalizeWebhookEvents(eventNames)
+	s.description = strings.TrimSpace(description)
+	s.active = active
+	s.updatedAt = time.Now()
+	return s.Validate()
+}
+
+// RotateSecret reemplaza el secreto; sin valor se genera uno aleatorio. Las entregas pendientes
+// se firman con el secreto nuevo.
+func (s *WebhookSubscription) RotateSecret(secret string) error {
+	if secret == "" {
+		var err error
+		if secret, err = GenerateWebhookSecret(); err != nil {
+			return err
+		}
+	}
+	s.secret = secret
+	s.updatedAt = time.Now()
+	return s.Validate()
+}
+
+// Validate valida la URL, el secreto y que los eventos sean conocidos.
+func (s *WebhookSubscription) Validate() error {
+	if s.url == "" {
+		return domain.NewRequiredFieldError("url")
+	}
+	if len(s.url) > maxWebhookURLLength {
+		return domain.NewMaxLengthFieldError("url", maxWebhookURLLength)
+	}
+	endpoint, err := url.Parse(s.url)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return domain.NewFieldError("url", "url", "webhook.url_invalid", nil)
+	}
+	if len(s.secret) < minWebhookSecretLength {
+		return domain.NewFieldError("secret", "min", "validation.min_length", domain.Params{"min": minWebhookSecretLength})
+	}
+	if len(s.secret) > maxWebhookSecretLength {
+		return domain.NewMaxLengthFieldError("secret", maxWebhookSecretLength)
+	}
+	if len(s.events) == 0 {
+		return domain.NewFieldError("events", "required", "webhook.events_required", nil)
+	}
+	for _, event := range s.events {
+		if !slices.Contains(WebhookEvents, event) {
+			return domain.NewFieldError("events", "oneof", "webhook.event_unknown",
+				domain.Params{"event": event, "allowed": strings.Join(WebhookEvents, ", ")})
+		}
+	}
+	if len(s.description) > maxWebhookDescriptionLength {
+		return domain.NewMaxLengthFieldError("description", maxWebhookDescriptionLength)
+	}
+	return nil
+}
+
+// normalizeWebhookEvents quita espacios y repetidos, conservando el orden.
+func normalizeWebhookEvents(eventNames []string) []string {
+	normalized := make([]string, 0, len(eventNames))
+	for _, name := range eventNames {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && !slices.Contains(normalized, name) {
+			normalized = append(normalized, name)
+		}
+	}
+	return normalized
+}
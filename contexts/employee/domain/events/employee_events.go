@@ -0,0 +1,69 @@
+package events
+
+import "time"
+
+// EmployeeRegistered - se registró un vínculo laboral nuevo
+type EmployeeRegistered struct {
+	EmployeeID   string    `json:"employeeId"`
+	PersonID     string    `json:"personId"`
+	ContractType string    `json:"contractType"`
+	Salary       float64   `json:"salary"`
+	StartDate    time.Time `json:"startDate"`
+	At           time.Time `json:"occurredAt"`
+}
+
+func (e EmployeeRegistered) EventName() string {
+	return "employee.registered"
+}
+
+func (e EmployeeRegistered) OccurredAt() time.Time {
+	return e.At
+}
+
+// SalaryChanged - cambió la remuneración mensual del empleado
+type SalaryChanged struct {
+	EmployeeID     string    `json:"employeeId"`
+	PreviousSalary float64   `json:"previousSalary"`
+	NewSalary      float64   `json:"newSalary"`
+	At             time.Time `json:"occurredAt"`
+}
+
+func (e SalaryChanged) EventName() string {
+	return "employee.salary_changed"
+}
+
+func (e SalaryChanged) OccurredAt() time.Time {
+	return e.At
+}
+
+// EmployeeTerminated - se registró (o se corrigió) la fecha de cese del empleado
+type EmployeeTerminated struct {
+	EmployeeID string    `json:"employeeId"`
+	EndDate    time.Time `json:"endDate"`
+	At         time.Time `json:"occurredAt"`
+}
+
+func (e EmployeeTerminated) EventName() string {
+	return "employee.terminated"
+}
+
+func (e EmployeeTerminated) OccurredAt() time.Time {
+	return e.At
+}
+
+// EmployeeUpdated - se guardó una versión nueva del empleado. Lleva los nombres de los campos que
+// cambiaron, no sus valores: el consumidor consulta el empleado si los necesita
+type EmployeeUpdated struct {
+	EmployeeID    string    `json:"employeeId"`
+	Version       int       `json:"version"`
+	ChangedFields []string  `json:"changedFields"`
+	At            time.Time `json:"occurredAt"`
+}
+
+func (e EmployeeUpdated) EventName() string {
+	return "employee.updated"
+}
+
+func (e EmployeeUpdated) OccurredAt() time.Time {
+	return e.At
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+)
+
+// EmployeeDocumentRepository define los métodos de persistencia para los datos de los documentos
+// adjuntos; el archivo va al BlobStore (solo contratos, sin implementación)
+type EmployeeDocumentRepository interface {
+	// SaveDocument guarda los datos del documento
+	SaveDocument(ctx context.Context, document *entities.EmployeeDocument) error
+	// ListDocumentsByEmployee devuelve los documentos del empleado, del más reciente al más antiguo
+	ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.EmployeeDocument, error)
+	// GetDocumentByID devuelve el documento, o nil si no existe
+	GetDocumentByID(ctx context.Context, id string) (*entities.EmployeeDocument, error)
+}
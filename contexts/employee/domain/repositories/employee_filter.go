@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// EmployeeFilter - criterios de búsqueda de empleados; los campos vacíos no filtran.
+// Department y Position se comparan sin distinguir mayúsculas; Position admite coincidencia parcial.
+type EmployeeFilter struct {
+	Department    string
+	ContractType  string
+	Position      string
+	WorkLocation  string
+	AFP           string
+	PersonType    value_objects.PersonType
+	StartDateFrom *time.Time
+	StartDateTo   *time.Time
+	ActiveFrom    *time.Time // Excluye a los empleados cesados antes de esta fecha
+}
+
+// EmployeeRecord - empleado junto con su persona. La persona no incluye contactos: es la vista que
+// se recorre en las exportaciones masivas.
+type EmployeeRecord struct {
+	Employee *entities.Employee
+	Person   *aggregates.PersonAggregate
+}
@@ -11,4 +11,11 @@ import (
 type EmployeeRepository interface {
 	SaveEmployee(ctx context.Context, employee *entities.Employee) error
 	GetEmployeeByID(ctx context.Context, id string) (*entities.Employee, error)
+	// UpdateEmployee falla con VERSION_CONFLICT si el empleado cambió desde que se leyó
+	UpdateEmployee(ctx context.Context, employee *entities.Employee) error
+	// ReassignPerson traslada los empleos de una persona a otra y devuelve cuántos se movieron
+	ReassignPerson(ctx context.Context, fromPersonID, toPersonID string) (int, error)
+	// StreamEmployees recorre los empleados que cumplen el filtro, ordenados por ID, sin cargarlos
+	// todos en memoria; se detiene en el primer error que devuelva fn
+	StreamEmployees(ctx context.Context, filter EmployeeFilter, fn func(EmployeeRecord) error) error
 }
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+)
+
+var monthNames = [...]string{"", "enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto",
+	"setiembre", "octubre", "noviembre", "diciembre"}
+
+// ContractEmployer - datos del empleador que suscribe el contrato, tomados de su persona jurídica
+type ContractEmployer struct {
+	RUC                    string
+	BusinessName           string
+	Address                string
+	RepresentativeName     string
+	RepresentativeDocument string
+}
+
+// ContractTerms - condiciones del contrato que no se guardan en el empleado
+type ContractTerms struct {
+	Cause           string     // causa objetiva del contrato sujeto a modalidad
+	EndDate         *time.Time // término del contrato sujeto a modalidad; sin ella se usa la fecha de cese
+	EducationCenter string     // centro de formación del practicante
+	SignatureDate   time.Time
+}
+
+// ContractBlock - párrafo del contrato; Heading indica el título de una cláusula
+type ContractBlock struct {
+	Heading bool
+	Text    string
+}
+
+// ContractText - contrato con los campos de la plantilla ya reemplazados
+type ContractText struct {
+	Title       string
+	Blocks      []ContractBlock
+	Signatories []string // quienes firman al pie: el empleador y el trabajador
+}
+
+// ContractRenderer - PUERTO de salida: escribe el contrato en un formato de archivo
+type ContractRenderer interface {
+	Format() value_objects.DocumentFormat
+	Render(text ContractText) ([]byte, error)
+}
+
+// ContractDrafter - DOMAIN SERVICE: llena la plantilla de la modalidad del empleado con los datos
+// del empleador, del trabajador y del vínculo laboral
+type ContractDrafter struct{}
+
+func NewContractDrafter() *ContractDrafter {
+	return &ContractDrafter{}
+}
+
+// Draft verifica que el contrato tenga lo que exige su modalidad y devuelve su texto. El trabajador
+// debe ser una persona natural.
+func (d *ContractDrafter) Draft(template *entities.ContractTemplate, employer ContractEmployer, employee *entities.Employee,
+	worker *aggregates.PersonAggregate, terms ContractTerms) (ContractText, error) {
+	if worker == nil || worker.NaturalPerson == nil {
+		return ContractText{}, domain.NewBusinessRuleError("contract.worker_not_natural_person", nil)
+	}
+
+	endDate := terms.EndDate
+	if endDate == nil {
+		endDate = employee.EndDate()
+	}
+	var errs []domain.FieldError
+	switch template.ContractType() {
+	case value_objects.ContractFixedTerm:
+		if strings.TrimSpace(terms.Cause) == "" {
+			errs = append(errs, *domain.NewRequiredFieldError("cause"))
+		}
+		if endDate == nil {
+			errs = append(errs, *domain.NewRequiredFieldError("endDate"))
+		} else if endDate.Before(employee.StartDate()) {
+			errs = append(errs, *domain.NewFieldError("endDate", "gtefield", "employee.end_date_before_start", nil))
+		}
+	case value_objects.ContractInternship:
+		if strings.TrimSpace(terms.EducationCenter) == "" {
+			errs = append(errs, *domain.NewRequiredFieldError("educationCenter"))
+		}
+	}
+	if len(errs) > 0 {
+		return ContractText{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(errs...)
+	}
+
+	np := worker.NaturalPerson
+	workerAddress := worker.Person.Address
+	if worker.Address != nil && worker.Address.Street != "" {
+		workerAddress = worker.Address.Street
+	}
+	workerName := strings.Join(strings.Fields(np.FirstName+" "+np.LastNamePaternal+" "+np.LastNameMaternal), " ")
+	values := map[string]string{
+		"employer.businessName":           employer.BusinessName,
+		"employer.ruc":                    employer.RUC,
+		"employer.address":                employer.Address,
+		"employer.representativeName":     employer.RepresentativeName,
+		"employer.representativeDocument": employer.RepresentativeDocument,
+		"worker.fullName":                 workerName,
+		"worker.documentType":             string(np.DocumentType),
+		"worker.documentNumber":           np.DocumentNumber,
+		"worker.address":                  workerAddress,
+		"worker.nationality":              np.Nationality,
+		"position":                        employee.Position(),
+		"department":                      employee.Department(),
+		"workLocation":                    employee.WorkLocation(),
+		"workSchedule":                    employee.WorkSchedule(),
+		"salary":                          "S/ " + FormatAmount(employee.Salary()),
+		"startDate":                       FormatLongDate(employee.StartDate()),
+		"endDate":                         "",
+		"cause":                           strings.TrimSpace(terms.Cause),
+		"educationCenter":                 strings.TrimSpace(terms.EducationCenter),
+		"signatureDate":                   FormatLongDate(terms.SignatureDate),
+	}
+	if endDate != nil {
+		values["endDate"] = FormatLongDate(*endDate)
+	}
+
+	text := ContractText{
+		Title:       template.Fill(template.Title(), values),
+		Signatories: []string{employer.BusinessName, workerName},
+	}
+	for _, paragraph := range strings.Split(template.Fill(template.Body(), values), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		// El título de la cláusula es la primera línea; lo que sigue es su primer párrafo
+		if rest, ok := strings.CutPrefix(paragraph, "# "); ok {
+			heading, body, _ := strings.Cut(rest, "\n")
+			text.Blocks = append(text.Blocks, ContractBlock{Heading: true, Text: collapseSpaces(heading)})
+			paragraph = body
+		}
+		if paragraph = collapseSpaces(paragraph); paragraph != "" {
+			text.Blocks = append(text.Blocks, ContractBlock{Text: paragraph})
+		}
+	}
+	return text, nil
+}
+
+// collapseSpaces une las líneas de un párrafo: el renderizador decide dónde cortarlas
+func collapseSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// FormatLongDate escribe la fecha como en los contratos: 2 de mayo de 2023
+func FormatLongDate(date time.Time) string {
+	return fmt.Sprintf("%d de %s de %d", date.Day(), monthNames[date.Month()], date.Year())
+}
+
+// FormatAmount escribe el importe con separador de miles: 12,345.67
+func FormatAmount(amount float64) string {
+	value := strconv.FormatFloat(amount, 'f', 2, 64)
+	integer, decimals := value[:len(value)-3], value[len(value)-3:]
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return grouped.String() + decimals
+}
@@ -7,5 +7,7 @@ import (
 
 type LaborService interface {
 	ValidateEmployeeRegistration(employee *entities.Employee, employmentData EmploymentData) error
+	// ValidateSalary verifica que el salario respete la remuneración mínima vital
+	ValidateSalary(salary float64) error
 	CalculateBenefits(employee *entities.Employee) (value_objects.Benefits, error)
 }
@@ -1,11 +1,11 @@
 package services
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
 )
 
 // PeruvianLaborService - DOMAIN SERVICE (lógica de negocio peruana)
@@ -27,18 +27,26 @@ func (s *PeruvianLaborService) ValidateEmployeeRegistration(
 	employee *entities.Employee,
 	employmentData EmploymentData, // O un DTO específico
 ) error {
-	if employmentData.Salary < 1130 {
-		return fmt.Errorf("el salario no puede ser menor al mínimo vital (S/1,130)")
+	if err := s.ValidateSalary(employmentData.Salary); err != nil {
+		return err
 	}
 	if employee.ContractType() == "INDEFINIDO" {
 		// Lógica de validación para contrato indefinido
 		if time.Since(employee.StartDate()).Hours() < 720 { // 720 horas = 30 días
-			return fmt.Errorf("para contrato indefinido, la fecha de inicio debe ser al menos 30 días antes")
+			return domain.NewFieldError("startDate", "min_age", "labor.indefinite_start_date", domain.Params{"days": 30})
 		}
 	}
 	return nil
 }
 
+// ValidateSalary - Remuneración mínima vital vigente
+func (s *PeruvianLaborService) ValidateSalary(salary float64) error {
+	if salary < 1130 {
+		return domain.NewFieldError("salary", "min", "labor.minimum_wage", domain.Params{"amount": "S/1,130"})
+	}
+	return nil
+}
+
 // CalculateBenefits - Cálculo de beneficios según ley peruana
 func (s *PeruvianLaborService) CalculateBenefits(employee *entities.Employee) (value_objects.Benefits, error) {
 	// Las variables se inicializan en su valor "cero" (0.0 para float64)
@@ -0,0 +1,22 @@
+package services
+
+import "context"
+
+// WebhookRequest - entrega lista para enviarse al destino de una suscripción
+type WebhookRequest struct {
+	URL        string
+	Secret     string // Con él se firma el cuerpo (HMAC-SHA256)
+	DeliveryID string
+	EventID    string
+	EventName  string
+	Body       []byte
+}
+
+// WebhookSender - PUERTO de salida hacia los sistemas suscritos. Send devuelve el código HTTP de
+// la respuesta (0 si no la hubo) y error si el destino no la aceptó con un 2xx; el texto del error
+// se guarda en el historial de entregas, así que no incluye el cuerpo de la respuesta.
+type WebhookSender interface {
+	// CheckURL rechaza los destinos a los que Send se negaría a llamar (sin https, redes internas)
+	CheckURL(url string) error
+	Send(ctx context.Context, request WebhookRequest) (int, error)
+}
@@ -1,6 +1,6 @@
 package value_objects
 
-import "errors"
+import "github.com/kevinsoras/employee-management/shared/domain"
 
 // Benefits es un Value Object que representa los beneficios laborales calculados.
 // Es inmutable y se valida en su creación.
@@ -14,13 +14,13 @@ type Benefits struct {
 // Asegura que los valores sean válidos antes de crear el objeto.
 func NewBenefits(cts, gratification float64, vacationDays int) (Benefits, error) {
 	if cts < 0 {
-		return Benefits{}, errors.New("el valor de CTS no puede ser negativo")
+		return Benefits{}, domain.NewFieldError("cts", "min", "validation.min", domain.Params{"min": 0})
 	}
 	if gratification < 0 {
-		return Benefits{}, errors.New("el valor de la gratificación no puede ser negativo")
+		return Benefits{}, domain.NewFieldError("gratification", "min", "validation.min", domain.Params{"min": 0})
 	}
 	if vacationDays < 0 {
-		return Benefits{}, errors.New("los días de vacaciones no pueden ser negativos")
+		return Benefits{}, domain.NewFieldError("vacationDays", "min", "validation.min", domain.Params{"min": 0})
 	}
 
 	return Benefits{
@@ -0,0 +1,59 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// ContractType - modalidad del contrato de trabajo
+type ContractType string
+
+const (
+	ContractIndefinite ContractType = "INDEFINIDO"  // a plazo indeterminado
+	ContractFixedTerm  ContractType = "FIJO"        // sujeto a modalidad: exige causa objetiva y fecha de término
+	ContractInternship ContractType = "PRACTICANTE" // convenio de prácticas (Ley 28518)
+)
+
+// NewContractType valida la modalidad sin distinguir mayúsculas.
+func NewContractType(value string) (ContractType, error) {
+	contractType := ContractType(strings.ToUpper(strings.TrimSpace(value)))
+	switch contractType {
+	case ContractIndefinite, ContractFixedTerm, ContractInternship:
+		return contractType, nil
+	}
+	return "", domain.NewFieldError("contractType", "oneof", "validation.oneof", domain.Params{"values": "INDEFINIDO, FIJO, PRACTICANTE"})
+}
+
+// DocumentFormat - formato de archivo de un documento generado
+type DocumentFormat string
+
+const (
+	FormatPDF  DocumentFormat = "PDF"
+	FormatDOCX DocumentFormat = "DOCX"
+)
+
+// NewDocumentFormat valida el formato; sin formato se genera PDF.
+func NewDocumentFormat(value string) (DocumentFormat, error) {
+	format := DocumentFormat(strings.ToUpper(strings.TrimSpace(value)))
+	switch format {
+	case "":
+		return FormatPDF, nil
+	case FormatPDF, FormatDOCX:
+		return format, nil
+	}
+	return "", domain.NewFieldError("format", "oneof", "validation.oneof", domain.Params{"values": "PDF, DOCX"})
+}
+
+// ContentType es el tipo de medio con el que se descarga el documento.
+func (f DocumentFormat) ContentType() string {
+	if f == FormatDOCX {
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	}
+	return "application/pdf"
+}
+
+// Extension es la extensión del archivo sin punto.
+func (f DocumentFormat) Extension() string {
+	return strings.ToLower(string(f))
+}
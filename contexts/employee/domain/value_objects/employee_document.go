@@ -0,0 +1,31 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// EmployeeDocumentType - clase de documento adjunto al legajo del empleado
+type EmployeeDocumentType string
+
+const (
+	DocumentSignedContract     EmployeeDocumentType = "CONTRATO_FIRMADO"
+	DocumentIdentity           EmployeeDocumentType = "DOCUMENTO_IDENTIDAD" // copia del DNI o carné de extranjería
+	DocumentMedicalCertificate EmployeeDocumentType = "CERTIFICADO_MEDICO"
+	DocumentDegree             EmployeeDocumentType = "TITULO" // grado o título profesional
+	DocumentOther              EmployeeDocumentType = "OTRO"
+)
+
+// NewEmployeeDocumentType valida la clase de documento sin distinguir mayúsculas.
+func NewEmployeeDocumentType(value string) (EmployeeDocumentType, error) {
+	documentType := EmployeeDocumentType(strings.ToUpper(strings.TrimSpace(value)))
+	switch documentType {
+	case DocumentSignedContract, DocumentIdentity, DocumentMedicalCertificate, DocumentDegree, DocumentOther:
+		return documentType, nil
+	case "":
+		return "", domain.NewRequiredFieldError("type")
+	}
+	return "", domain.NewFieldError("type", "oneof", "validation.oneof",
+		domain.Params{"values": "CONTRATO_FIRMADO, DOCUMENTO_IDENTIDAD, CERTIFICADO_MEDICO, TITULO, OTRO"})
+}
@@ -0,0 +1,62 @@
+package contractdocs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/contractdocs"
+)
+
+func contractText(clauses int) services.ContractText {
+	text := services.ContractText{
+		Title:       "CONTRATO DE TRABAJO A PLAZO INDETERMINADO",
+		Signatories: []string{"ACME S.A.C.", "John Doe"},
+	}
+	for i := 0; i < clauses; i++ {
+		text.Blocks = append(text.Blocks,
+			services.ContractBlock{Heading: true, Text: "CLAUSULA"},
+			services.ContractBlock{Text: strings.Repeat("EL TRABAJADOR cumplira sus funciones con diligencia. ", 12)},
+		)
+	}
+	return text
+}
+
+func TestPDFRenderer_BreaksLongContractsIntoNumberedPages(t *testing.T) {
+	content, err := contractdocs.NewPDFRenderer().Render(contractText(12))
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+	pages := bytes.Count(content, []byte("/Type /Page "))
+	require.Greater(t, pages, 1)
+	assert.Contains(t, string(content), "gina 1 de ")
+	assert.Contains(t, string(content), "(CONTRATO DE TRABAJO A PLAZO INDETERMINADO)")
+	assert.Contains(t, string(content), "(John Doe)")
+
+	again, err := contractdocs.NewPDFRenderer().Render(contractText(12))
+	require.NoError(t, err)
+	assert.Equal(t, content, again, "the same contract always produces the same document")
+}
+
+func TestDOCXRenderer_WritesHeadingsParagraphsAndSignatories(t *testing.T) {
+	content, err := contractdocs.NewDOCXRenderer().Render(contractText(1))
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	part, err := archive.Open("word/document.xml")
+	require.NoError(t, err)
+	document, err := io.ReadAll(part)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(document), ">CONTRATO DE TRABAJO A PLAZO INDETERMINADO</w:t>")
+	assert.Contains(t, string(document), ">CLAUSULA</w:t>")
+	assert.Contains(t, string(document), ">ACME S.A.C.</w:t>")
+	assert.Contains(t, string(document), ">John Doe</w:t>")
+}
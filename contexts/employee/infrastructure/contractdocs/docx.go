@@ -0,0 +1,40 @@
+package contractdocs
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/docx"
+)
+
+// DOCXRenderer escribe el contrato como documento de Word, para que RR.HH. pueda ajustarlo antes
+// de firmarlo.
+type DOCXRenderer struct{}
+
+func NewDOCXRenderer() *DOCXRenderer {
+	return &DOCXRenderer{}
+}
+
+func (r *DOCXRenderer) Format() value_objects.DocumentFormat {
+	return value_objects.FormatDOCX
+}
+
+func (r *DOCXRenderer) Render(text services.ContractText) ([]byte, error) {
+	doc := docx.New(text.Title)
+	doc.Title(text.Title)
+	for _, block := range text.Blocks {
+		if block.Heading {
+			doc.Heading(block.Text)
+			continue
+		}
+		doc.Paragraph(block.Text)
+	}
+	// Las firmas van una debajo de la otra
+	for _, name := range text.Signatories {
+		doc.Paragraph("")
+		doc.Paragraph(strings.Repeat("_", 40))
+		doc.Paragraph(name)
+	}
+	return doc.Bytes()
+}
@@ -0,0 +1,116 @@
+package contractdocs
+
+import (
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/pdf"
+)
+
+const (
+	margin      = 71.0 // 2.5 cm
+	textWidth   = pdf.PageWidth - 2*margin
+	titleSize   = 13.0
+	bodySize    = 10.5
+	leading     = 15.0
+	footerSize  = 8.0
+	signatureH  = 90.0 // espacio que ocupan las firmas al pie
+	signatureW  = 190.0
+	footerY     = margin / 2
+	bottomLimit = margin
+)
+
+// PDFRenderer escribe el contrato en páginas A4 con márgenes de 2.5 cm: el título centrado, las
+// cláusulas con su título en negrita, las firmas al pie y el número de página en cada hoja.
+type PDFRenderer struct{}
+
+func NewPDFRenderer() *PDFRenderer {
+	return &PDFRenderer{}
+}
+
+func (r *PDFRenderer) Format() value_objects.DocumentFormat {
+	return value_objects.FormatPDF
+}
+
+func (r *PDFRenderer) Render(text services.ContractText) ([]byte, error) {
+	doc := pdf.New(text.Title)
+	w := &pdfWriter{doc: doc}
+	w.newPage()
+
+	for _, line := range pdf.WrapText(pdf.Bold, titleSize, text.Title, textWidth) {
+		w.page.Text((pdf.PageWidth-pdf.TextWidth(pdf.Bold, titleSize, line))/2, w.y, pdf.Bold, titleSize, line)
+		w.y -= leading + 2
+	}
+	w.y -= leading
+
+	for i, block := range text.Blocks {
+		if block.Heading {
+			// El título no se queda solo al final de la hoja: baja con las dos primeras líneas de su cláusula
+			w.ensure(leading * 3)
+			w.y -= leading / 2
+			w.lines(pdf.Bold, block.Text)
+			continue
+		}
+		w.lines(pdf.Regular, block.Text)
+		if i < len(text.Blocks)-1 {
+			w.y -= leading / 2
+		}
+	}
+
+	w.signatures(text.Signatories)
+
+	for i, page := range w.pages {
+		number := fmt.Sprintf("Página %d de %d", i+1, len(w.pages))
+		page.Text((pdf.PageWidth-pdf.TextWidth(pdf.Regular, footerSize, number))/2, footerY, pdf.Regular, footerSize, number)
+	}
+	return doc.Bytes(), nil
+}
+
+// pdfWriter lleva la posición de escritura y abre una hoja nueva cuando la actual se llena
+type pdfWriter struct {
+	doc   *pdf.Document
+	pages []*pdf.Page
+	page  *pdf.Page
+	y     float64
+}
+
+func (w *pdfWriter) newPage() {
+	w.page = w.doc.AddPage()
+	w.pages = append(w.pages, w.page)
+	w.y = pdf.PageHeight - margin
+}
+
+// ensure abre una hoja nueva si no quedan height puntos en la actual
+func (w *pdfWriter) ensure(height float64) {
+	if w.y-height < bottomLimit {
+		w.newPage()
+	}
+}
+
+func (w *pdfWriter) lines(font pdf.Font, text string) {
+	for _, line := range pdf.WrapText(font, bodySize, text, textWidth) {
+		w.ensure(leading)
+		w.page.Text(margin, w.y, font, bodySize, line)
+		w.y -= leading
+	}
+}
+
+// signatures dibuja una línea de firma por firmante, uno al lado del otro
+func (w *pdfWriter) signatures(signatories []string) {
+	if len(signatories) == 0 {
+		return
+	}
+	w.ensure(signatureH)
+	y := w.y - signatureH + 2*leading
+	gap := (textWidth - float64(len(signatories))*signatureW) / float64(len(signatories)+1)
+	for i, name := range signatories {
+		x := margin + gap + float64(i)*(signatureW+gap)
+		w.page.Line(x, y, x+signatureW, y, 0.5)
+		for j, line := range pdf.WrapText(pdf.Regular, footerSize+1, name, signatureW) {
+			lineY := y - leading - float64(j)*(footerSize+3)
+			w.page.Text(x+(signatureW-pdf.TextWidth(pdf.Regular, footerSize+1, line))/2, lineY, pdf.Regular, footerSize+1, line)
+		}
+	}
+	w.y -= signatureH
+}
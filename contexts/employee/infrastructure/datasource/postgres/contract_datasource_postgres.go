@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/lib/pq"
+)
+
+const uniqueViolationCode = "23505"
+
+const insertContractTemplateQuery = `INSERT INTO contract_templates (
+	template_id, contract_type, version, title, body, created_by, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+const contractTemplateColumns = `template_id, contract_type, version, title, body, COALESCE(created_by, ''), created_at`
+
+const selectLatestContractTemplateQuery = `SELECT ` + contractTemplateColumns + ` FROM contract_templates
+WHERE contract_type = $1 ORDER BY version DESC LIMIT 1`
+
+const selectContractTemplateQuery = `SELECT ` + contractTemplateColumns + ` FROM contract_templates
+WHERE contract_type = $1 AND version = $2`
+
+// selectContractTemplatesQuery lista todas las modalidades cuando $1 es vacío
+const selectContractTemplatesQuery = `SELECT ` + contractTemplateColumns + ` FROM contract_templates
+WHERE $1 = '' OR contract_type = $1 ORDER BY contract_type, version DESC`
+
+const insertContractDocumentQuery = `INSERT INTO contract_documents (
+	document_id, employee_id, template_id, template_version, contract_type, format, content, content_hash, size,
+	generated_by, generated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+const contractDocumentColumns = `document_id, employee_id, template_id, template_version, contract_type, format,
+	content_hash, size, COALESCE(generated_by, ''), generated_at`
+
+const selectContractDocumentsByEmployeeQuery = `SELECT ` + contractDocumentColumns + ` FROM contract_documents
+WHERE employee_id = $1 ORDER BY generated_at DESC, document_id`
+
+const selectContractDocumentQuery = `SELECT ` + contractDocumentColumns + ` FROM contract_documents WHERE document_id = $1`
+
+const selectContractDocumentContentQuery = `SELECT content FROM contract_documents WHERE document_id = $1`
+
+// ContractTemplateDataSourcePostgres implementa ContractTemplateDataSource usando PostgreSQL
+type ContractTemplateDataSourcePostgres struct {
+	db *sql.DB
+}
+
+func NewContractTemplateDataSourcePostgres(db *sql.DB) datasource.ContractTemplateDataSource {
+	return &ContractTemplateDataSourcePostgres{db: db}
+}
+
+func (ds *ContractTemplateDataSourcePostgres) SaveTemplate(ctx context.Context, template *entities.ContractTemplate) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	_, err := querier.ExecContext(ctx, insertContractTemplateQuery,
+		template.ID(), template.ContractType(), template.Version(), template.Title(), template.Body(),
+		template.CreatedBy(), template.CreatedAt(),
+	)
+	if err != nil {
+		return handleContractError(err, "contract.template_version_conflict")
+	}
+	return nil
+}
+
+// GetLatestTemplate devuelve (nil, nil) si la modalidad no tiene plantillas.
+func (ds *ContractTemplateDataSourcePostgres) GetLatestTemplate(ctx context.Context, contractType value_objects.ContractType) (*entities.ContractTemplate, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+	return scanOptionalTemplate(querier.QueryRowContext(ctx, selectLatestContractTemplateQuery, contractType))
+}
+
+// GetTemplate devuelve (nil, nil) si la versión no existe.
+func (ds *ContractTemplateDataSourcePostgres) GetTemplate(ctx context.Context, contractType value_objects.ContractType, version int) (*entities.ContractTemplate, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+	return scanOptionalTemplate(querier.QueryRowContext(ctx, selectContractTemplateQuery, contractType, version))
+}
+
+func (ds *ContractTemplateDataSourcePostgres) ListTemplates(ctx context.Context, contractType value_objects.ContractType) ([]*entities.ContractTemplate, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, selectContractTemplatesQuery, string(contractType))
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	defer rows.Close()
+
+	var templates []*entities.ContractTemplate
+	for rows.Next() {
+		template, err := scanContractTemplate(rows)
+		if err != nil {
+			return nil, handleContractError(err, "")
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return templates, nil
+}
+
+func scanOptionalTemplate(row rowScanner) (*entities.ContractTemplate, error) {
+	template, err := scanContractTemplate(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return template, nil
+}
+
+func scanContractTemplate(row rowScanner) (*entities.ContractTemplate, error) {
+	var (
+		id, contractType, title, body, createdBy string
+		version                                  int
+		createdAt                                time.Time
+	)
+	if err := row.Scan(&id, &contractType, &version, &title, &body, &createdBy, &createdAt); err != nil {
+		return nil, err
+	}
+	return entities.RestoreContractTemplate(id, value_objects.ContractType(contractType), version, title, body, createdBy, createdAt), nil
+}
+
+// ContractDocumentDataSourcePostgres implementa ContractDocumentDataSource usando PostgreSQL
+type ContractDocumentDataSourcePostgres struct {
+	db        *sql.DB
+	encrypter *crypto.FieldEncrypter // El contrato lleva el DNI y la remuneración: se guarda cifrado
+}
+
+func NewContractDocumentDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.ContractDocumentDataSource {
+	return &ContractDocumentDataSourcePostgres{db: db, encrypter: encrypter}
+}
+
+func (ds *ContractDocumentDataSourcePostgres) SaveDocument(ctx context.Context, document *entities.ContractDocument, content []byte) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	sealedContent, err := ds.encrypter.Encrypt(string(content), crypto.PurposeContractContent)
+	if err != nil {
+		return infrastructure.NewDBError("Error al cifrar el contrato", err)
+	}
+	_, err = querier.ExecContext(ctx, insertContractDocumentQuery,
+		document.ID(), document.EmployeeID(), document.TemplateID(), document.TemplateVersion(), document.ContractType(),
+		document.Format(), sealedContent, document.ContentHash(), document.Size(), document.GeneratedBy(), document.GeneratedAt(),
+	)
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	return nil
+}
+
+func (ds *ContractDocumentDataSourcePostgres) ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.ContractDocument, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, selectContractDocumentsByEmployeeQuery, employeeID)
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	defer rows.Close()
+
+	var documents []*entities.ContractDocument
+	for rows.Next() {
+		document, err := scanContractDocument(rows)
+		if err != nil {
+			return nil, handleContractError(err, "")
+		}
+		documents = append(documents, document)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return documents, nil
+}
+
+// GetDocumentByID devuelve (nil, nil) si el contrato no existe.
+func (ds *ContractDocumentDataSourcePostgres) GetDocumentByID(ctx context.Context, id string) (*entities.ContractDocument, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	document, err := scanContractDocument(querier.QueryRowContext(ctx, selectContractDocumentQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return document, nil
+}
+
+// GetDocumentContent devuelve (nil, nil) si el contrato no existe.
+func (ds *ContractDocumentDataSourcePostgres) GetDocumentContent(ctx context.Context, id string) ([]byte, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var sealedContent string
+	err := querier.QueryRowContext(ctx, selectContractDocumentContentQuery, id).Scan(&sealedContent)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	content, err := ds.encrypter.Decrypt(sealedContent, crypto.PurposeContractContent)
+	if err != nil {
+		return nil, infrastructure.NewDBError("Error al descifrar el contrato", err)
+	}
+	return []byte(content), nil
+}
+
+func scanContractDocument(row rowScanner) (*entities.ContractDocument, error) {
+	var (
+		id, employeeID, templateID, contractType, format, contentHash, generatedBy string
+		templateVersion, size                                                      int
+		generatedAt                                                                time.Time
+	)
+	err := row.Scan(&id, &employeeID, &templateID, &templateVersion, &contractType, &format, &contentHash, &size,
+		&generatedBy, &generatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return entities.RestoreContractDocument(id, employeeID, templateID, templateVersion, value_objects.ContractType(contractType),
+		value_objects.DocumentFormat(format), contentHash, size, generatedBy, generatedAt), nil
+}
+
+// rowScanner es lo común a *sql.Row y *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// handleContractError traduce los errores de PostgreSQL; conflictKey es el mensaje de una clave
+// duplicada, si la operación puede producirla
+func handleContractError(err error, conflictKey string) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if pqErr.Code == uniqueViolationCode && conflictKey != "" {
+			return domain.NewAlreadyExistsError(conflictKey, err)
+		}
+		return infrastructure.NewDBError(fmt.Sprintf("Error de base de datos: %s", pqErr.Message), err)
+	}
+	return infrastructure.NewDBError("Error inesperado de infraestructura", err)
+}
@@ -3,30 +3,57 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
 	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
 	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
 )
 
+const reassignPersonQuery = `UPDATE employees SET person_id = $2, updated_at = now(), version = version + 1 WHERE person_id = $1`
+
+const selectEmployeeQuery = `SELECT employee_id, person_id, salary, contract_type, position, work_schedule, department,
+	COALESCE(work_location, ''), COALESCE(bank_account, ''), afp, eps, COALESCE(cuspp, ''), start_date, end_date,
+	COALESCE(has_cts, false), COALESCE(has_gratification, false), COALESCE(has_vacation, false),
+	COALESCE(cts, 0), COALESCE(gratification, 0), COALESCE(vacation_days, 0), version, created_at, updated_at
+FROM employees WHERE employee_id = $1`
+
+// updateEmployeeQuery solo actualiza si nadie guardó otra versión desde que se leyó el empleado
+const updateEmployeeQuery = `UPDATE employees SET
+	salary = $2, position = $3, work_schedule = $4, department = $5, work_location = $6,
+	bank_account = $7, afp = $8, eps = $9, cts = $10, gratification = $11, vacation_days = $12,
+	updated_at = $13, cuspp = NULLIF($15, ''), end_date = $16, version = version + 1
+WHERE employee_id = $1 AND version = $14`
+
+const existsEmployeeQuery = `SELECT EXISTS (SELECT 1 FROM employees WHERE employee_id = $1)`
+
 // EmployeeDataSourcePostgres implementa EmployeeDataSource usando PostgreSQL
 
 type EmployeeDataSourcePostgres struct {
-	db *sql.DB
+	db        *sql.DB
+	encrypter *crypto.FieldEncrypter // La cuenta bancaria se guarda cifrada
 }
 
-func NewEmployeeDataSourcePostgres(db *sql.DB) datasource.EmployeeDataSource {
-	return &EmployeeDataSourcePostgres{db: db}
+func NewEmployeeDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.EmployeeDataSource {
+	return &EmployeeDataSourcePostgres{db: db, encrypter: encrypter}
 }
 
 func (ds *EmployeeDataSourcePostgres) SaveEmployee(ctx context.Context, employee *entities.Employee) error {
 	querier := db.GetQuerier(ctx, ds.db)
+	bankAccount, err := ds.encrypter.Encrypt(employee.BankAccount(), crypto.PurposeBankAccount)
+	if err != nil {
+		return err
+	}
 	query := `INSERT INTO employees (
-		employee_id, person_id, salary, contract_type, position, work_schedule, department, work_location, bank_account, afp, eps, start_date, has_cts, has_gratification, has_vacation, cts, gratification, vacation_days, created_at, updated_at
+		employee_id, person_id, salary, contract_type, position, work_schedule, department, work_location, bank_account, afp, eps, start_date, has_cts, has_gratification, has_vacation, cts, gratification, vacation_days, cuspp, end_date, created_at, updated_at
 	) VALUES (
-		$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, now(), now()
+		$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NULLIF($19, ''), $20, now(), now()
 	)`
-	_, err := querier.ExecContext(ctx, query,
+	_, err = querier.ExecContext(ctx, query,
 		employee.ID(),
 		employee.PersonID(),
 		employee.Salary(),
@@ -35,7 +62,7 @@ func (ds *EmployeeDataSourcePostgres) SaveEmployee(ctx context.Context, employee
 		employee.WorkSchedule(),
 		employee.Department(),
 		employee.WorkLocation(),
-		employee.BankAccount(),
+		bankAccount,
 		employee.AFP(),
 		employee.EPS(),
 		employee.StartDate(),
@@ -45,11 +72,120 @@ func (ds *EmployeeDataSourcePostgres) SaveEmployee(ctx context.Context, employee
 		employee.Benefits().CTS(),
 		employee.Benefits().Gratification(),
 		employee.Benefits().VacationDays(),
+		employee.CUSPP(),
+		employee.EndDate(),
 	)
 	return err
 }
 
+// GetEmployeeByID reconstruye el empleado. Devuelve (nil, nil) si no existe.
 func (ds *EmployeeDataSourcePostgres) GetEmployeeByID(ctx context.Context, id string) (*entities.Employee, error) {
-	// Aquí iría la lógica SQL para buscar un empleado por ID
-	return nil, nil
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var (
+		employeeID, personID, contractType, position, workSchedule, department string
+		workLocation, bankAccount, afp, eps, cuspp                             string
+		salary, cts, gratification                                             float64
+		vacationDays, version                                                  int
+		hasCTS, hasGratification, hasVacation                                  bool
+		startDate, createdAt, updatedAt                                        time.Time
+		endDate                                                                sql.NullTime
+	)
+	err := querier.QueryRowContext(ctx, selectEmployeeQuery, id).Scan(
+		&employeeID, &personID, &salary, &contractType, &position, &workSchedule, &department,
+		&workLocation, &bankAccount, &afp, &eps, &cuspp, &startDate, &endDate,
+		&hasCTS, &hasGratification, &hasVacation,
+		&cts, &gratification, &vacationDays, &version, &createdAt, &updatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if bankAccount, err = ds.encrypter.Decrypt(bankAccount, crypto.PurposeBankAccount); err != nil {
+		return nil, err
+	}
+	benefits, err := value_objects.NewBenefits(cts, gratification, vacationDays)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewEmployeeBuilder(personID, salary, contractType, startDate).
+		WithJobDetails(position, department, workSchedule, workLocation).
+		WithPayroll(bankAccount, afp, eps).
+		WithPension(cuspp).
+		WithEndDate(nullTime(endDate)).
+		WithBenefitFlags(hasCTS, hasGratification, hasVacation).
+		Restore(employeeID, benefits, version, createdAt, updatedAt), nil
+}
+
+// nullTime convierte una fecha opcional de la base de datos en un puntero
+func nullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
+
+// UpdateEmployee guarda los datos laborales modificables si la versión leída sigue vigente.
+func (ds *EmployeeDataSourcePostgres) UpdateEmployee(ctx context.Context, employee *entities.Employee) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	bankAccount, err := ds.encrypter.Encrypt(employee.BankAccount(), crypto.PurposeBankAccount)
+	if err != nil {
+		return err
+	}
+
+	result, err := querier.ExecContext(ctx, updateEmployeeQuery,
+		employee.ID(),
+		employee.Salary(),
+		employee.Position(),
+		employee.WorkSchedule(),
+		employee.Department(),
+		employee.WorkLocation(),
+		bankAccount,
+		employee.AFP(),
+		employee.EPS(),
+		employee.Benefits().CTS(),
+		employee.Benefits().Gratification(),
+		employee.Benefits().VacationDays(),
+		employee.UpdatedAt(),
+		employee.Version(),
+		employee.CUSPP(),
+		employee.EndDate(),
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		// Distingue un empleado inexistente de uno modificado por otro usuario
+		var exists bool
+		if err := querier.QueryRowContext(ctx, existsEmployeeQuery, employee.ID()).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return domain.NewNotFoundError("employee.not_found", nil)
+		}
+		return domain.NewVersionConflictError("employee.version_conflict", nil)
+	}
+	employee.IncrementVersion()
+	return nil
+}
+
+// ReassignPerson re-apunta los empleos de fromPersonID a toPersonID (fusión de personas duplicadas).
+func (ds *EmployeeDataSourcePostgres) ReassignPerson(ctx context.Context, fromPersonID, toPersonID string) (int, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+	result, err := querier.ExecContext(ctx, reassignPersonQuery, fromPersonID, toPersonID)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
 }
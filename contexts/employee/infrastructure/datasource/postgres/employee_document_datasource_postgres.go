@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+)
+
+const insertEmployeeDocumentQuery = `INSERT INTO employee_documents (
+	document_id, employee_id, document_type, file_name, content_type, size, content_hash, storage_key, expires_at,
+	uploaded_by, uploaded_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+const employeeDocumentColumns = `document_id, employee_id, document_type, file_name, content_type, size, content_hash,
+	storage_key, expires_at, COALESCE(uploaded_by, ''), uploaded_at`
+
+const selectEmployeeDocumentsByEmployeeQuery = `SELECT ` + employeeDocumentColumns + ` FROM employee_documents
+WHERE employee_id = $1 ORDER BY uploaded_at DESC, document_id`
+
+const selectEmployeeDocumentQuery = `SELECT ` + employeeDocumentColumns + ` FROM employee_documents WHERE document_id = $1`
+
+// EmployeeDocumentDataSourcePostgres implementa EmployeeDocumentDataSource usando PostgreSQL
+type EmployeeDocumentDataSourcePostgres struct {
+	db *sql.DB
+}
+
+func NewEmployeeDocumentDataSourcePostgres(db *sql.DB) datasource.EmployeeDocumentDataSource {
+	return &EmployeeDocumentDataSourcePostgres{db: db}
+}
+
+func (ds *EmployeeDocumentDataSourcePostgres) SaveDocument(ctx context.Context, document *entities.EmployeeDocument) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	_, err := querier.ExecContext(ctx, insertEmployeeDocumentQuery,
+		document.ID(), document.EmployeeID(), document.DocumentType(), document.FileName(), document.ContentType(),
+		document.Size(), document.ContentHash(), document.StorageKey(), document.ExpiresAt(), document.UploadedBy(),
+		document.UploadedAt(),
+	)
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	return nil
+}
+
+func (ds *EmployeeDocumentDataSourcePostgres) ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.EmployeeDocument, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, selectEmployeeDocumentsByEmployeeQuery, employeeID)
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	defer rows.Close()
+
+	var documents []*entities.EmployeeDocument
+	for rows.Next() {
+		document, err := scanEmployeeDocument(rows)
+		if err != nil {
+			return nil, handleContractError(err, "")
+		}
+		documents = append(documents, document)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return documents, nil
+}
+
+// GetDocumentByID devuelve (nil, nil) si el documento no existe.
+func (ds *EmployeeDocumentDataSourcePostgres) GetDocumentByID(ctx context.Context, id string) (*entities.EmployeeDocument, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	document, err := scanEmployeeDocument(querier.QueryRowContext(ctx, selectEmployeeDocumentQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return document, nil
+}
+
+func scanEmployeeDocument(row rowScanner) (*entities.EmployeeDocument, error) {
+	var (
+		id, employeeID, documentType, fileName, contentType, contentHash, storageKey, uploadedBy string
+		size                                                                                     int
+		expiresAt                                                                                sql.NullTime
+		uploadedAt                                                                               time.Time
+	)
+	err := row.Scan(&id, &employeeID, &documentType, &fileName, &contentType, &size, &contentHash, &storageKey,
+		&expiresAt, &uploadedBy, &uploadedAt)
+	if err != nil {
+		return nil, err
+	}
+	var expiry *time.Time
+	if expiresAt.Valid {
+		expiry = &expiresAt.Time
+	}
+	return entities.RestoreEmployeeDocument(id, employeeID, value_objects.EmployeeDocumentType(documentType), fileName,
+		contentType, size, contentHash, storageKey, expiry, uploadedBy, uploadedAt), nil
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	sharedEntities "github.com/kevinsoras/employee-management/shared/domain/entities"
+	sharedValueObjects "github.com/kevinsoras/employee-management/shared/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+)
+
+// streamEmployeesQuery une cada empleado con su persona; la parte natural o jurídica llega en NULL
+// según el tipo, igual que el domicilio estructurado si no se registró. Los filtros se agregan en {where}.
+const streamEmployeesQuery = `SELECT e.employee_id, e.person_id, e.salary, e.contract_type, e.position, e.work_schedule, e.department,
+	COALESCE(e.work_location, ''), COALESCE(e.bank_account, ''), e.afp, e.eps, COALESCE(e.cuspp, ''), e.start_date, e.end_date,
+	COALESCE(e.has_cts, false), COALESCE(e.has_gratification, false), COALESCE(e.has_vacation, false),
+	COALESCE(e.cts, 0), COALESCE(e.gratification, 0), COALESCE(e.vacation_days, 0), e.version, e.created_at, e.updated_at,
+	p.person_type, COALESCE(p.email, ''), COALESCE(p.phone, ''), COALESCE(p.address, ''), COALESCE(p.country, ''), p.version, p.created_at, p.updated_at,
+	np.document_type, np.document_number, np.first_name, np.last_name_paternal, np.last_name_maternal,
+	np.birth_date, np.gender, np.nationality, np.work_permit_expiry,
+	jp.document_number, jp.business_name, jp.trade_name, jp.constitution_date, jp.representative_name, jp.representative_document,
+	pa.street, pa.reference, pa.ubigeo
+FROM employees e
+JOIN persons p ON p.person_id = e.person_id
+LEFT JOIN natural_persons np ON np.person_id = p.person_id
+LEFT JOIN juridical_persons jp ON jp.person_id = p.person_id
+LEFT JOIN person_addresses pa ON pa.person_id = p.person_id
+{where}
+ORDER BY e.employee_id`
+
+// StreamEmployees recorre los empleados filtrados con un cursor del servidor, por lotes.
+func (ds *EmployeeDataSourcePostgres) StreamEmployees(ctx context.Context, filter repositories.EmployeeFilter, fn func(repositories.EmployeeRecord) error) error {
+	where, args := employeeFilterClause(filter)
+	query := strings.Replace(streamEmployeesQuery, "{where}", where, 1)
+
+	return db.StreamCursor(ctx, ds.db, "employees_stream", query, args, db.DefaultCursorBatchSize, func(rows *sql.Rows) error {
+		record, err := ds.scanEmployeeRecord(rows)
+		if err != nil {
+			return err
+		}
+		return fn(record)
+	})
+}
+
+// employeeFilterClause arma el WHERE con parámetros posicionales; nunca concatena valores del usuario.
+func employeeFilterClause(filter repositories.EmployeeFilter) (string, []any) {
+	var conditions []string
+	var args []any
+	add := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Department != "" {
+		add("lower(e.department) = lower($%d)", filter.Department)
+	}
+	if filter.ContractType != "" {
+		add("e.contract_type = $%d", filter.ContractType)
+	}
+	if filter.Position != "" {
+		add("e.position ILIKE '%%' || $%d || '%%'", escapeLike(filter.Position))
+	}
+	if filter.WorkLocation != "" {
+		add("lower(e.work_location) = lower($%d)", filter.WorkLocation)
+	}
+	if filter.AFP != "" {
+		add("lower(e.afp) = lower($%d)", filter.AFP)
+	}
+	if filter.PersonType != "" {
+		add("p.person_type = $%d", string(filter.PersonType))
+	}
+	if filter.StartDateFrom != nil {
+		add("e.start_date >= $%d", *filter.StartDateFrom)
+	}
+	if filter.StartDateTo != nil {
+		add("e.start_date <= $%d", *filter.StartDateTo)
+	}
+	if filter.ActiveFrom != nil {
+		add("(e.end_date IS NULL OR e.end_date >= $%d)", *filter.ActiveFrom)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// escapeLike evita que % y _ del texto buscado actúen como comodines.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+func (ds *EmployeeDataSourcePostgres) scanEmployeeRecord(rows *sql.Rows) (repositories.EmployeeRecord, error) {
+	var (
+		employeeID, personID, contractType, position, workSchedule, department string
+		workLocation, bankAccount, afp, eps, cuspp                             string
+		salary, cts, gratification                                             float64
+		vacationDays, version                                                  int
+		hasCTS, hasGratification, hasVacation                                  bool
+		startDate, createdAt, updatedAt                                        time.Time
+		endDate                                                                sql.NullTime
+
+		person                                                     sharedEntities.Person
+		npDocumentType, npDocumentNumber, npFirstName              sql.NullString
+		npLastNamePaternal, npLastNameMaternal, npGender, npNation sql.NullString
+		npBirthDate, npWorkPermitExpiry                            sql.NullTime
+		jpDocumentNumber, jpBusinessName, jpTradeName              sql.NullString
+		jpRepresentativeName, jpRepresentativeDocument             sql.NullString
+		jpConstitutionDate                                         sql.NullTime
+		addressStreet, addressReference, addressUbigeo             sql.NullString
+	)
+	err := rows.Scan(
+		&employeeID, &personID, &salary, &contractType, &position, &workSchedule, &department,
+		&workLocation, &bankAccount, &afp, &eps, &cuspp, &startDate, &endDate,
+		&hasCTS, &hasGratification, &hasVacation,
+		&cts, &gratification, &vacationDays, &version, &createdAt, &updatedAt,
+		&person.Type, &person.Email, &person.Phone, &person.Address, &person.Country, &person.Version, &person.CreatedAt, &person.UpdatedAt,
+		&npDocumentType, &npDocumentNumber, &npFirstName, &npLastNamePaternal, &npLastNameMaternal,
+		&npBirthDate, &npGender, &npNation, &npWorkPermitExpiry,
+		&jpDocumentNumber, &jpBusinessName, &jpTradeName, &jpConstitutionDate, &jpRepresentativeName, &jpRepresentativeDocument,
+		&addressStreet, &addressReference, &addressUbigeo,
+	)
+	if err != nil {
+		return repositories.EmployeeRecord{}, err
+	}
+
+	if bankAccount, err = ds.encrypter.Decrypt(bankAccount, crypto.PurposeBankAccount); err != nil {
+		return repositories.EmployeeRecord{}, err
+	}
+	benefits, err := value_objects.NewBenefits(cts, gratification, vacationDays)
+	if err != nil {
+		return repositories.EmployeeRecord{}, err
+	}
+	employee := entities.NewEmployeeBuilder(personID, salary, contractType, startDate).
+		WithJobDetails(position, department, workSchedule, workLocation).
+		WithPayroll(bankAccount, afp, eps).
+		WithPension(cuspp).
+		WithEndDate(nullTime(endDate)).
+		WithBenefitFlags(hasCTS, hasGratification, hasVacation).
+		Restore(employeeID, benefits, version, createdAt, updatedAt)
+
+	person.ID = personID
+	agg := aggregates.NewPersonAggregate(&person, nil, nil)
+	switch person.Type {
+	case sharedValueObjects.Natural:
+		documentNumber, err := ds.encrypter.Decrypt(npDocumentNumber.String, crypto.PurposeDocumentNumber)
+		if err != nil {
+			return repositories.EmployeeRecord{}, err
+		}
+		agg.NaturalPerson = &sharedEntities.NaturalPerson{
+			PersonID:         personID,
+			DocumentType:     sharedValueObjects.DocumentType(npDocumentType.String),
+			DocumentNumber:   documentNumber,
+			FirstName:        npFirstName.String,
+			LastNamePaternal: npLastNamePaternal.String,
+			LastNameMaternal: npLastNameMaternal.String,
+			BirthDate:        npBirthDate.Time,
+			Gender:           npGender.String,
+			Nationality:      npNation.String,
+			WorkPermitExpiry: npWorkPermitExpiry.Time,
+		}
+	case sharedValueObjects.Juridical:
+		agg.JuridicalPerson = &sharedEntities.JuridicalPerson{
+			PersonID:               personID,
+			DocumentNumber:         jpDocumentNumber.String,
+			BusinessName:           jpBusinessName.String,
+			TradeName:              jpTradeName.String,
+			ConstitutionDate:       jpConstitutionDate.Time,
+			RepresentativeName:     jpRepresentativeName.String,
+			RepresentativeDocument: jpRepresentativeDocument.String,
+		}
+	}
+	if addressUbigeo.Valid {
+		agg.Address = &sharedEntities.Address{
+			PersonID:  personID,
+			Street:    addressStreet.String,
+			Reference: addressReference.String,
+			Ubigeo:    sharedValueObjects.Ubigeo(addressUbigeo.String),
+		}
+	}
+	return repositories.EmployeeRecord{Employee: employee, Person: agg}, nil
+}
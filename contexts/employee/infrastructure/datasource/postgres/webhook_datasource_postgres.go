@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/lib/pq"
+)
+
+const insertWebhookSubscriptionQuery = `INSERT INTO webhook_subscriptions (
+	subscription_id, url, secret, events, description, active, created_by, created_at, updated_at
+) VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9)`
+
+const updateWebhookSubscriptionQuery = `UPDATE webhook_subscriptions SET
+	url = $2, secret = $3, events = $4, description = NULLIF($5, ''), active = $6, updated_at = $7
+WHERE subscription_id = $1`
+
+const deleteWebhookSubscriptionQuery = `DELETE FROM webhook_subscriptions WHERE subscription_id = $1`
+
+const webhookSubscriptionColumns = `subscription_id, url, secret, events, COALESCE(description, ''), active,
+	COALESCE(created_by, ''), created_at, updated_at`
+
+const selectWebhookSubscriptionQuery = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions
+WHERE subscription_id = $1`
+
+const selectWebhookSubscriptionsQuery = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions
+ORDER BY created_at DESC, subscription_id`
+
+const selectWebhookSubscribersQuery = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions
+WHERE active AND $1 = ANY(events) ORDER BY created_at, subscription_id`
+
+const insertWebhookDeliveryQuery = `INSERT INTO webhook_deliveries (
+	delivery_id, subscription_id, event_id, event_name, payload, status, attempts, next_attempt_at, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (subscription_id, event_id) DO NOTHING`
+
+const webhookDeliveryColumns = `d.delivery_id, d.subscription_id, d.event_id, d.event_name, d.payload, d.status, d.attempts,
+	d.next_attempt_at, COALESCE(d.last_status_code, 0), COALESCE(d.last_error, ''), d.last_attempt_at, d.delivered_at, d.created_at`
+
+// claimWebhookDeliveriesQuery reserva las pendientes vencidas de suscripciones activas en una sola
+// sentencia, moviendo su próximo intento al fin de la reserva; las de una suscripción pausada
+// esperan a que se reactive. SKIP LOCKED reparte el trabajo entre procesos sin que se esperen.
+const claimWebhookDeliveriesQuery = `WITH claimed AS (
+	UPDATE webhook_deliveries SET next_attempt_at = $3
+	WHERE delivery_id IN (
+		SELECT d.delivery_id
+		FROM webhook_deliveries d JOIN webhook_subscriptions s ON s.subscription_id = d.subscription_id
+		WHERE d.status = 'PENDING' AND d.next_attempt_at <= $1 AND s.active
+		ORDER BY d.sequence LIMIT $2
+		FOR UPDATE OF d SKIP LOCKED
+	)
+	RETURNING *
+)
+SELECT ` + webhookDeliveryColumns + ` FROM claimed d ORDER BY d.sequence`
+
+const updateWebhookDeliveryAttemptQuery = `UPDATE webhook_deliveries SET status = $2, attempts = $3, next_attempt_at = $4,
+	last_status_code = NULLIF($5, 0), last_error = NULLIF($6, ''), last_attempt_at = $7, delivered_at = $8
+WHERE delivery_id = $1`
+
+// selectWebhookDeliveriesQuery lista todos los estados cuando $2 es vacío
+const selectWebhookDeliveriesQuery = `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries d
+WHERE d.subscription_id = $1 AND ($2 = '' OR d.status = $2)
+ORDER BY d.sequence DESC LIMIT $3`
+
+// WebhookSubscriptionDataSourcePostgres implementa WebhookSubscriptionDataSource usando PostgreSQL
+type WebhookSubscriptionDataSourcePostgres struct {
+	db        *sql.DB
+	encrypter *crypto.FieldEncrypter // Con el secreto cualquiera podría firmar entregas falsas: se guarda cifrado
+}
+
+func NewWebhookSubscriptionDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.WebhookSubscriptionDataSource {
+	return &WebhookSubscriptionDataSourcePostgres{db: db, encrypter: encrypter}
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) SaveSubscription(ctx context.Context, s *entities.WebhookSubscription) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	sealedSecret, err := ds.encrypter.Encrypt(s.Secret(), crypto.PurposeWebhookSecret)
+	if err != nil {
+		return infrastructure.NewDBError("Error al cifrar el secreto del webhook", err)
+	}
+	_, err = querier.ExecContext(ctx, insertWebhookSubscriptionQuery,
+		s.ID(), s.URL(), sealedSecret, pq.Array(s.Events()), s.Description(), s.Active(), s.CreatedBy(), s.CreatedAt(), s.UpdatedAt())
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	return nil
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) UpdateSubscription(ctx context.Context, s *entities.WebhookSubscription) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	sealedSecret, err := ds.encrypter.Encrypt(s.Secret(), crypto.PurposeWebhookSecret)
+	if err != nil {
+		return infrastructure.NewDBError("Error al cifrar el secreto del webhook", err)
+	}
+	result, err := querier.ExecContext(ctx, updateWebhookSubscriptionQuery,
+		s.ID(), s.URL(), sealedSecret, pq.Array(s.Events()), s.Description(), s.Active(), s.UpdatedAt())
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	return subscriptionAffected(result)
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) DeleteSubscription(ctx context.Context, id string) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	result, err := querier.ExecContext(ctx, deleteWebhookSubscriptionQuery, id)
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	return subscriptionAffected(result)
+}
+
+// GetSubscription devuelve (nil, nil) si la suscripción no existe.
+func (ds *WebhookSubscriptionDataSourcePostgres) GetSubscription(ctx context.Context, id string) (*entities.WebhookSubscription, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	subscription, err := ds.scanSubscription(querier.QueryRowContext(ctx, selectWebhookSubscriptionQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return subscription, nil
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) ListSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	return ds.listSubscriptions(ctx, selectWebhookSubscriptionsQuery)
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) ListSubscribers(ctx context.Context, eventName string) ([]*entities.WebhookSubscription, error) {
+	return ds.listSubscriptions(ctx, selectWebhookSubscribersQuery, eventName)
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) listSubscriptions(ctx context.Context, query string, args ...any) ([]*entities.WebhookSubscription, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	defer rows.Close()
+
+	var subscriptions []*entities.WebhookSubscription
+	for rows.Next() {
+		subscription, err := ds.scanSubscription(rows)
+		if err != nil {
+			return nil, handleContractError(err, "")
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return subscriptions, nil
+}
+
+func (ds *WebhookSubscriptionDataSourcePostgres) scanSubscription(row rowScanner) (*entities.WebhookSubscription, error) {
+	var (
+		id, url, sealedSecret, description, createdBy string
+		events                                        []string
+		active                                        bool
+		createdAt, updatedAt                          time.Time
+	)
+	err := row.Scan(&id, &url, &sealedSecret, pq.Array(&events), &description, &active, &createdBy, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := ds.encrypter.Decrypt(sealedSecret, crypto.PurposeWebhookSecret)
+	if err != nil {
+		return nil, infrastructure.NewDBError("Error al descifrar el secreto del webhook", err)
+	}
+	return entities.RestoreWebhookSubscription(id, url, secret, events, description, active, createdBy, createdAt, updatedAt), nil
+}
+
+func subscriptionAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	if affected == 0 {
+		return domain.NewNotFoundError("webhook.subscription_not_found", nil)
+	}
+	return nil
+}
+
+// WebhookDeliveryDataSourcePostgres implementa WebhookDeliveryDataSource usando PostgreSQL
+type WebhookDeliveryDataSourcePostgres struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryDataSourcePostgres(db *sql.DB) datasource.WebhookDeliveryDataSource {
+	return &WebhookDeliveryDataSourcePostgres{db: db}
+}
+
+// SaveDeliveries omite las entregas que ya existen (UNIQUE subscription_id, event_id): el relay
+// reparte de nuevo un evento cuando su publicación falló.
+func (ds *WebhookDeliveryDataSourcePostgres) SaveDeliveries(ctx context.Context, deliveries ...*entities.WebhookDelivery) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	for _, d := range deliveries {
+		_, err := querier.ExecContext(ctx, insertWebhookDeliveryQuery,
+			d.ID(), d.SubscriptionID(), d.EventID(), d.EventName(), string(d.Payload()), d.Status(), d.Attempts(),
+			d.NextAttemptAt(), d.CreatedAt())
+		if err != nil {
+			return handleContractError(err, "")
+		}
+	}
+	return nil
+}
+
+func (ds *WebhookDeliveryDataSourcePostgres) ClaimDueDeliveries(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.WebhookDelivery, error) {
+	return ds.listDeliveries(ctx, claimWebhookDeliveriesQuery, now, limit, leaseUntil)
+}
+
+func (ds *WebhookDeliveryDataSourcePostgres) SaveAttempt(ctx context.Context, d *entities.WebhookDelivery) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	_, err := querier.ExecContext(ctx, updateWebhookDeliveryAttemptQuery,
+		d.ID(), d.Status(), d.Attempts(), d.NextAttemptAt(), d.LastStatusCode(), d.LastError(), d.LastAttemptAt(), d.DeliveredAt())
+	if err != nil {
+		return handleContractError(err, "")
+	}
+	return nil
+}
+
+func (ds *WebhookDeliveryDataSourcePostgres) ListDeliveries(ctx context.Context, subscriptionID string, status entities.WebhookDeliveryStatus, limit int) ([]*entities.WebhookDelivery, error) {
+	return ds.listDeliveries(ctx, selectWebhookDeliveriesQuery, subscriptionID, string(status), limit)
+}
+
+func (ds *WebhookDeliveryDataSourcePostgres) listDeliveries(ctx context.Context, query string, args ...any) ([]*entities.WebhookDelivery, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, handleContractError(err, "")
+	}
+	defer rows.Close()
+
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, handleContractError(err, "")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, handleContractError(err, "")
+	}
+	return deliveries, nil
+}
+
+func scanWebhookDelivery(row rowScanner) (*entities.WebhookDelivery, error) {
+	var (
+		id, subscriptionID, eventID, eventName, status, lastError string
+		payload                                                   []byte
+		attempts, lastStatusCode                                  int
+		nextAttemptAt, createdAt                                  time.Time
+		lastAttemptAt, deliveredAt                                sql.NullTime
+	)
+	err := row.Scan(&id, &subscriptionID, &eventID, &eventName, &payload, &status, &attempts, &nextAttemptAt,
+		&lastStatusCode, &lastError, &lastAttemptAt, &deliveredAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return entities.RestoreWebhookDelivery(id, subscriptionID, eventID, eventName, payload, entities.WebhookDeliveryStatus(status),
+		attempts, nextAttemptAt, lastStatusCode, lastError, nullTime(lastAttemptAt), nullTime(deliveredAt), createdAt), nil
+}
@@ -0,0 +1,2 @@
+-- Requiere que las cuentas bancarias estén nuevamente en texto plano
+ALTER TABLE employees ALTER COLUMN bank_account TYPE VARCHAR(50);
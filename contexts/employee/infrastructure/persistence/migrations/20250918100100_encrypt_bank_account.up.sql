@@ -0,0 +1,2 @@
+-- Cifrado en reposo de la cuenta bancaria: el valor cifrado no entra en VARCHAR(50)
+ALTER TABLE employees ALTER COLUMN bank_account TYPE TEXT;
@@ -0,0 +1,2 @@
+ALTER TABLE employees DROP COLUMN IF EXISTS end_date;
+ALTER TABLE employees DROP COLUMN IF EXISTS cuspp;
@@ -0,0 +1,3 @@
+-- CUSPP del afiliado a una AFP y fecha de cese: con ellos se declaran los movimientos en AFPnet
+ALTER TABLE employees ADD COLUMN cuspp VARCHAR(12);
+ALTER TABLE employees ADD COLUMN end_date DATE;
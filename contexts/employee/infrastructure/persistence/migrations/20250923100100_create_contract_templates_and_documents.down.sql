@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS contract_documents;
+DROP TABLE IF EXISTS contract_templates;
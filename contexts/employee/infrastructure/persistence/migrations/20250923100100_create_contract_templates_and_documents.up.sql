@@ -0,0 +1,91 @@
+-- Plantillas de contrato por modalidad. No se modifican: cada cambio es una versión nueva
+CREATE TABLE contract_templates (
+    template_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    contract_type VARCHAR(30) NOT NULL, -- INDEFINIDO, FIJO o PRACTICANTE
+    version INT NOT NULL,
+    title VARCHAR(150) NOT NULL,
+    body TEXT NOT NULL,                 -- campos como {{worker.fullName}}
+    created_by VARCHAR(100),            -- NULL en las plantillas iniciales
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (contract_type, version)
+);
+
+-- Contratos generados para un empleado. El documento se guarda cifrado con su hash SHA-256
+CREATE TABLE contract_documents (
+    document_id UUID PRIMARY KEY,
+    employee_id UUID NOT NULL REFERENCES employees(employee_id) ON DELETE CASCADE,
+    template_id UUID NOT NULL REFERENCES contract_templates(template_id),
+    template_version INT NOT NULL,
+    contract_type VARCHAR(30) NOT NULL,
+    format VARCHAR(4) NOT NULL,         -- PDF o DOCX
+    content TEXT NOT NULL,              -- cifrado
+    content_hash CHAR(64) NOT NULL,
+    size INT NOT NULL,
+    generated_by VARCHAR(100),
+    generated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_contract_documents_employee ON contract_documents(employee_id);
+
+-- Versión 1 de cada modalidad, para que RR.HH. parta de un texto y lo ajuste con una versión nueva
+INSERT INTO contract_templates (contract_type, version, title, body) VALUES
+('INDEFINIDO', 1, 'CONTRATO DE TRABAJO A PLAZO INDETERMINADO',
+'Conste por el presente documento el contrato de trabajo a plazo indeterminado que celebran, de una parte, {{employer.businessName}}, con RUC {{employer.ruc}} y domicilio en {{employer.address}}, representada por {{employer.representativeName}}, identificado con documento {{employer.representativeDocument}}, a quien en adelante se denominará EL EMPLEADOR; y de la otra parte, {{worker.fullName}}, identificado con {{worker.documentType}} {{worker.documentNumber}} y domicilio en {{worker.address}}, a quien en adelante se denominará EL TRABAJADOR; en los términos y condiciones siguientes:
+
+# PRIMERA: OBJETO
+EL EMPLEADOR contrata a EL TRABAJADOR para que desempeñe el cargo de {{position}} en el área de {{department}}, en {{workLocation}}.
+
+# SEGUNDA: PLAZO
+El presente contrato es a plazo indeterminado y rige desde el {{startDate}}.
+
+# TERCERA: JORNADA
+EL TRABAJADOR cumplirá el horario {{workSchedule}}, dentro de la jornada máxima establecida por ley.
+
+# CUARTA: REMUNERACIÓN
+EL TRABAJADOR percibirá una remuneración mensual de {{salary}}, sujeta a los descuentos y aportes de ley.
+
+# QUINTA: NORMAS APLICABLES
+En lo no previsto por el presente contrato rige el Texto Único Ordenado del Decreto Legislativo N.° 728, aprobado por Decreto Supremo N.° 003-97-TR.
+
+Firmado en dos ejemplares el {{signatureDate}}.'),
+('FIJO', 1, 'CONTRATO DE TRABAJO SUJETO A MODALIDAD',
+'Conste por el presente documento el contrato de trabajo sujeto a modalidad que celebran, de una parte, {{employer.businessName}}, con RUC {{employer.ruc}} y domicilio en {{employer.address}}, representada por {{employer.representativeName}}, identificado con documento {{employer.representativeDocument}}, a quien en adelante se denominará EL EMPLEADOR; y de la otra parte, {{worker.fullName}}, identificado con {{worker.documentType}} {{worker.documentNumber}} y domicilio en {{worker.address}}, a quien en adelante se denominará EL TRABAJADOR; en los términos y condiciones siguientes:
+
+# PRIMERA: CAUSA OBJETIVA
+EL EMPLEADOR requiere contratar personal a plazo fijo por la siguiente causa objetiva: {{cause}}.
+
+# SEGUNDA: OBJETO
+EL EMPLEADOR contrata a EL TRABAJADOR para que desempeñe el cargo de {{position}} en el área de {{department}}, en {{workLocation}}.
+
+# TERCERA: PLAZO
+El presente contrato rige desde el {{startDate}} hasta el {{endDate}}, fecha en la que concluye sin necesidad de aviso previo.
+
+# CUARTA: JORNADA
+EL TRABAJADOR cumplirá el horario {{workSchedule}}, dentro de la jornada máxima establecida por ley.
+
+# QUINTA: REMUNERACIÓN
+EL TRABAJADOR percibirá una remuneración mensual de {{salary}}, sujeta a los descuentos y aportes de ley.
+
+# SEXTA: NORMAS APLICABLES
+El presente contrato se celebra al amparo del Título II del Texto Único Ordenado del Decreto Legislativo N.° 728, aprobado por Decreto Supremo N.° 003-97-TR, y se presentará a la Autoridad Administrativa de Trabajo.
+
+Firmado en tres ejemplares el {{signatureDate}}.'),
+('PRACTICANTE', 1, 'CONVENIO DE PRÁCTICAS',
+'Conste por el presente documento el convenio de prácticas que celebran, de una parte, {{employer.businessName}}, con RUC {{employer.ruc}} y domicilio en {{employer.address}}, representada por {{employer.representativeName}}, identificado con documento {{employer.representativeDocument}}, a quien en adelante se denominará LA EMPRESA; y de la otra parte, {{worker.fullName}}, identificado con {{worker.documentType}} {{worker.documentNumber}} y domicilio en {{worker.address}}, estudiante o egresado de {{educationCenter}}, a quien en adelante se denominará EL PRACTICANTE; en los términos y condiciones siguientes:
+
+# PRIMERA: OBJETO
+LA EMPRESA brinda a EL PRACTICANTE la oportunidad de realizar prácticas como {{position}} en el área de {{department}}, en {{workLocation}}, para complementar su formación en {{educationCenter}}.
+
+# SEGUNDA: PLAZO
+El presente convenio rige desde el {{startDate}}.
+
+# TERCERA: JORNADA
+EL PRACTICANTE cumplirá el horario {{workSchedule}}, compatible con su actividad académica.
+
+# CUARTA: SUBVENCIÓN
+EL PRACTICANTE percibirá una subvención económica mensual de {{salary}}, que no tiene carácter remunerativo.
+
+# QUINTA: NORMAS APLICABLES
+El presente convenio se rige por la Ley N.° 28518, Ley sobre Modalidades Formativas Laborales, y su reglamento. No genera relación laboral.
+
+Firmado en tres ejemplares el {{signatureDate}}.');
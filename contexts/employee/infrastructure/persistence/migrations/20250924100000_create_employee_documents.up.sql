@@ -0,0 +1,19 @@
+-- Documentos adjuntos al legajo del empleado. El archivo está en el almacenamiento de archivos
+-- (disco o S3) bajo storage_key; aquí quedan sus datos y su hash SHA-256
+CREATE TABLE employee_documents (
+    document_id UUID PRIMARY KEY,
+    employee_id UUID NOT NULL REFERENCES employees(employee_id) ON DELETE CASCADE,
+    document_type VARCHAR(30) NOT NULL, -- CONTRATO_FIRMADO, DOCUMENTO_IDENTIDAD, CERTIFICADO_MEDICO, TITULO u OTRO
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL, -- reconocido por el contenido del archivo
+    size INT NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    expires_at DATE,                    -- NULL si el documento no vence
+    uploaded_by VARCHAR(100),
+    uploaded_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_employee_documents_employee ON employee_documents(employee_id);
+-- Para buscar los documentos por vencer
+CREATE INDEX idx_employee_documents_expires_at ON employee_documents(expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,37 @@
+-- Suscripciones de los sistemas externos (control de accesos, correo) a los eventos del empleado
+CREATE TABLE webhook_subscriptions (
+    subscription_id UUID PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL,
+    secret TEXT NOT NULL,               -- Secreto de la firma HMAC, cifrado
+    events TEXT[] NOT NULL,             -- Eventos que recibe (ej: employee.registered)
+    description VARCHAR(255),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by VARCHAR(100),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Entregas de cada evento a cada suscripción y resultado de su último intento. Se encolan en la
+-- misma transacción que el cambio del empleado; el despachador de webhooks las envía.
+CREATE TABLE webhook_deliveries (
+    delivery_id UUID PRIMARY KEY,
+    sequence BIGSERIAL NOT NULL,        -- Orden de llegada; created_at se repite dentro de una transacción
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(subscription_id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,             -- Igual en todas las suscripciones que reciben el mismo evento
+    event_name VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,             -- Cuerpo que se envía y se firma
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'DELIVERED', 'FAILED')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_status_code INT,               -- NULL si el destino no respondió
+    last_error TEXT,
+    last_attempt_at TIMESTAMP,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (subscription_id, event_id)
+);
+
+-- El despachador solo recorre las pendientes, en orden de llegada
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(sequence) WHERE status = 'PENDING';
+-- Historial de entregas de una suscripción
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, sequence DESC);
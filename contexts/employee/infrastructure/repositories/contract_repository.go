@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+)
+
+// ContractTemplateRepositoryImpl implementa ContractTemplateRepository usando un DataSource
+type ContractTemplateRepositoryImpl struct {
+	dataSource datasource.ContractTemplateDataSource
+}
+
+func NewContractTemplateRepositoryImpl(dataSource datasource.ContractTemplateDataSource) repositories.ContractTemplateRepository {
+	return &ContractTemplateRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *ContractTemplateRepositoryImpl) SaveTemplate(ctx context.Context, template *entities.ContractTemplate) error {
+	return r.dataSource.SaveTemplate(ctx, template)
+}
+
+func (r *ContractTemplateRepositoryImpl) GetLatestTemplate(ctx context.Context, contractType value_objects.ContractType) (*entities.ContractTemplate, error) {
+	return r.dataSource.GetLatestTemplate(ctx, contractType)
+}
+
+func (r *ContractTemplateRepositoryImpl) GetTemplate(ctx context.Context, contractType value_objects.ContractType, version int) (*entities.ContractTemplate, error) {
+	return r.dataSource.GetTemplate(ctx, contractType, version)
+}
+
+func (r *ContractTemplateRepositoryImpl) ListTemplates(ctx context.Context, contractType value_objects.ContractType) ([]*entities.ContractTemplate, error) {
+	return r.dataSource.ListTemplates(ctx, contractType)
+}
+
+// ContractDocumentRepositoryImpl implementa ContractDocumentRepository usando un DataSource
+type ContractDocumentRepositoryImpl struct {
+	dataSource datasource.ContractDocumentDataSource
+}
+
+func NewContractDocumentRepositoryImpl(dataSource datasource.ContractDocumentDataSource) repositories.ContractDocumentRepository {
+	return &ContractDocumentRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *ContractDocumentRepositoryImpl) SaveDocument(ctx context.Context, document *entities.ContractDocument, content []byte) error {
+	return r.dataSource.SaveDocument(ctx, document, content)
+}
+
+func (r *ContractDocumentRepositoryImpl) ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.ContractDocument, error) {
+	return r.dataSource.ListDocumentsByEmployee(ctx, employeeID)
+}
+
+func (r *ContractDocumentRepositoryImpl) GetDocumentByID(ctx context.Context, id string) (*entities.ContractDocument, error) {
+	return r.dataSource.GetDocumentByID(ctx, id)
+}
+
+func (r *ContractDocumentRepositoryImpl) GetDocumentContent(ctx context.Context, id string) ([]byte, error) {
+	return r.dataSource.GetDocumentContent(ctx, id)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+)
+
+// EmployeeDocumentRepositoryImpl implementa EmployeeDocumentRepository usando un DataSource
+type EmployeeDocumentRepositoryImpl struct {
+	dataSource datasource.EmployeeDocumentDataSource
+}
+
+func NewEmployeeDocumentRepositoryImpl(dataSource datasource.EmployeeDocumentDataSource) repositories.EmployeeDocumentRepository {
+	return &EmployeeDocumentRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *EmployeeDocumentRepositoryImpl) SaveDocument(ctx context.Context, document *entities.EmployeeDocument) error {
+	return r.dataSource.SaveDocument(ctx, document)
+}
+
+func (r *EmployeeDocumentRepositoryImpl) ListDocumentsByEmployee(ctx context.Context, employeeID string) ([]*entities.EmployeeDocument, error) {
+	return r.dataSource.ListDocumentsByEmployee(ctx, employeeID)
+}
+
+func (r *EmployeeDocumentRepositoryImpl) GetDocumentByID(ctx context.Context, id string) (*entities.EmployeeDocument, error) {
+	return r.dataSource.GetDocumentByID(ctx, id)
+}
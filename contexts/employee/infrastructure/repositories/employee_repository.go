@@ -26,3 +26,15 @@ func (r *EmployeeRepositoryImpl) SaveEmployee(ctx context.Context, employee *ent
 func (r *EmployeeRepositoryImpl) GetEmployeeByID(ctx context.Context, id string) (*entities.Employee, error) {
 	return r.dataSource.GetEmployeeByID(ctx, id)
 }
+
+func (r *EmployeeRepositoryImpl) UpdateEmployee(ctx context.Context, employee *entities.Employee) error {
+	return r.dataSource.UpdateEmployee(ctx, employee)
+}
+
+func (r *EmployeeRepositoryImpl) ReassignPerson(ctx context.Context, fromPersonID, toPersonID string) (int, error) {
+	return r.dataSource.ReassignPerson(ctx, fromPersonID, toPersonID)
+}
+
+func (r *EmployeeRepositoryImpl) StreamEmployees(ctx context.Context, filter repositories.EmployeeFilter, fn func(repositories.EmployeeRecord) error) error {
+	return r.dataSource.StreamEmployees(ctx, filter, fn)
+}
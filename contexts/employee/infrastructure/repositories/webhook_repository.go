@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+)
+
+// WebhookSubscriptionRepositoryImpl implementa WebhookSubscriptionRepository usando un DataSource
+type WebhookSubscriptionRepositoryImpl struct {
+	dataSource datasource.WebhookSubscriptionDataSource
+}
+
+func NewWebhookSubscriptionRepositoryImpl(dataSource datasource.WebhookSubscriptionDataSource) repositories.WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) SaveSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error {
+	return r.dataSource.SaveSubscription(ctx, subscription)
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) UpdateSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error {
+	return r.dataSource.UpdateSubscription(ctx, subscription)
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) DeleteSubscription(ctx context.Context, id string) error {
+	return r.dataSource.DeleteSubscription(ctx, id)
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) GetSubscription(ctx context.Context, id string) (*entities.WebhookSubscription, error) {
+	return r.dataSource.GetSubscription(ctx, id)
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) ListSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	return r.dataSource.ListSubscriptions(ctx)
+}
+
+func (r *WebhookSubscriptionRepositoryImpl) ListSubscribers(ctx context.Context, eventName string) ([]*entities.WebhookSubscription, error) {
+	return r.dataSource.ListSubscribers(ctx, eventName)
+}
+
+// WebhookDeliveryRepositoryImpl implementa WebhookDeliveryRepository usando un DataSource
+type WebhookDeliveryRepositoryImpl struct {
+	dataSource datasource.WebhookDeliveryDataSource
+}
+
+func NewWebhookDeliveryRepositoryImpl(dataSource datasource.WebhookDeliveryDataSource) repositories.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *WebhookDeliveryRepositoryImpl) SaveDeliveries(ctx context.Context, deliveries ...*entities.WebhookDelivery) error {
+	return r.dataSource.SaveDeliveries(ctx, deliveries...)
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ClaimDueDeliveries(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.WebhookDelivery, error) {
+	return r.dataSource.ClaimDueDeliveries(ctx, limit, now, leaseUntil)
+}
+
+func (r *WebhookDeliveryRepositoryImpl) SaveAttempt(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	return r.dataSource.SaveAttempt(ctx, delivery)
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ListDeliveries(ctx context.Context, subscriptionID string, status entities.WebhookDeliveryStatus, limit int) ([]*entities.WebhookDelivery, error) {
+	return r.dataSource.ListDeliveries(ctx, subscriptionID, status, limit)
+}
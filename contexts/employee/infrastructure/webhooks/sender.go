@@ -0,0 +1,85 @@
+// Package webhooks implements services.WebhookSender over HTTP, signing every delivery with the
+// secret of its subscription.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/webhook"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderID        = "X-Webhook-Id" // Delivery ID, stable across retries
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Config configures the HTTP client that sends the deliveries.
+type Config struct {
+	Timeout time.Duration
+	// AllowHTTP and AllowPrivateNetworks relax the checks on the subscription URLs, which users
+	// choose; meant for local development only
+	AllowHTTP            bool
+	AllowPrivateNetworks bool
+}
+
+// HTTPSender POSTs each delivery as JSON. Any 2xx response acknowledges it; other statuses,
+// redirects included, and network errors are retried by the dispatcher. The errors carry the status
+// code or a generic reason, never the response body nor the network error, since they end up in
+// the delivery log of the subscription.
+type HTTPSender struct {
+	client *webhook.Client
+	now    func() time.Time
+}
+
+func NewHTTPSender(config Config) *HTTPSender {
+	client := webhook.NewClient(webhook.Config{Timeout: config.Timeout, AllowHTTP: config.AllowHTTP,
+		AllowPrivateNetworks: config.AllowPrivateNetworks})
+	return &HTTPSender{client: client, now: time.Now}
+}
+
+// Sign returns the X-Webhook-Signature value: "t=<timestamp>,v1=<hex HMAC-SHA256>", where the MAC
+// covers "<timestamp>.<body>" so a captured delivery cannot be replayed with a fresh timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	ts := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckURL rejects endpoints the sender would refuse to call: not https, or an IP literal of a
+// loopback, private or link-local address.
+func (s *HTTPSender) CheckURL(url string) error {
+	return s.client.CheckURL(url)
+}
+
+func (s *HTTPSender) Send(ctx context.Context, request services.WebhookRequest) (int, error) {
+	timestamp := s.now().Unix()
+	header := http.Header{}
+	header.Set("User-Agent", "employee-management-webhooks")
+	header.Set(HeaderID, request.DeliveryID)
+	header.Set(HeaderEvent, request.EventName)
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	header.Set(HeaderSignature, Sign(request.Secret, timestamp, request.Body))
+
+	status, err := s.client.Post(ctx, request.URL, request.Body, header)
+	if err != nil && status == 0 {
+		return 0, infrastructure.NewExternalServiceError("No se pudo enviar el webhook", err)
+	}
+	if err != nil {
+		return status, infrastructure.NewExternalServiceError("El destino rechazó el webhook", err)
+	}
+	return status, nil
+}
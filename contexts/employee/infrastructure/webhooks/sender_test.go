@@ -0,0 +1,107 @@
+package webhooks_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/employee/infrastructure/webhooks"
+)
+
+func newRequest(url string) services.WebhookRequest {
+	return services.WebhookRequest{
+		URL:        url,
+		Secret:     "whsec_test_secret_value",
+		DeliveryID: "d5b0c1f2-4e3a-4b6c-9d8e-7f6a5b4c3d2e",
+		EventID:    "6f1c2a54-8a1e-4d5f-9f1a-2b3c4d5e6f70",
+		EventName:  "employee.salary_changed",
+		Body:       []byte(`{"id":"6f1c2a54-8a1e-4d5f-9f1a-2b3c4d5e6f70","event":"employee.salary_changed","data":{}}`),
+	}
+}
+
+// localConfig lets the sender reach the httptest servers
+var localConfig = webhooks.Config{AllowHTTP: true, AllowPrivateNetworks: true}
+
+func TestSign_MatchesAnHMACOfTimestampAndBody(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("whsec_test_secret_value"))
+	mac.Write([]byte(`1758794400.{"a":1}`))
+
+	assert.Equal(t, "t=1758794400,v1="+hex.EncodeToString(mac.Sum(nil)),
+		webhooks.Sign("whsec_test_secret_value", 1758794400, []byte(`{"a":1}`)))
+	assert.NotEqual(t, webhooks.Sign("whsec_test_secret_value", 1758794400, []byte(`{"a":1}`)),
+		webhooks.Sign("whsec_test_secret_value", 1758794401, []byte(`{"a":1}`)), "the timestamp is signed")
+}
+
+func TestHTTPSender_PostsTheSignedBody(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	request := newRequest(server.URL + "/hooks/hr")
+
+	status, err := webhooks.NewHTTPSender(localConfig).Send(context.Background(), request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "/hooks/hr", received.URL.Path)
+	assert.Equal(t, request.Body, body)
+	assert.Equal(t, request.DeliveryID, received.Header.Get(webhooks.HeaderID))
+	assert.Equal(t, "employee.salary_changed", received.Header.Get(webhooks.HeaderEvent))
+	timestamp, err := strconv.ParseInt(received.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, webhooks.Sign(request.Secret, timestamp, body), received.Header.Get(webhooks.HeaderSignature))
+}
+
+func TestHTTPSender_RejectionsAndRedirectsAreErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+			return
+		}
+		http.Error(w, "maintenance", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	sender := webhooks.NewHTTPSender(localConfig)
+
+	status, err := sender.Send(context.Background(), newRequest(server.URL))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Contains(t, err.Error(), "status 503")
+	assert.NotContains(t, err.Error(), "maintenance", "the response body never reaches the delivery log")
+
+	status, err = sender.Send(context.Background(), newRequest(server.URL+"/moved"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusFound, status, "redirects are not followed")
+}
+
+func TestHTTPSender_RefusesPrivateDestinationsByDefault(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true }))
+	defer server.Close()
+	sender := webhooks.NewHTTPSender(webhooks.Config{AllowHTTP: true})
+
+	assert.Error(t, sender.CheckURL(server.URL), "an IP literal is checked before the subscription is saved")
+	status, err := sender.Send(context.Background(), newRequest(strings.Replace(server.URL, "127.0.0.1", "localhost", 1)))
+
+	require.Error(t, err)
+	assert.Zero(t, status)
+	assert.Contains(t, err.Error(), "destination address not allowed")
+	assert.False(t, called)
+	assert.Error(t, webhooks.NewHTTPSender(webhooks.Config{}).CheckURL("http://hooks.example.com"), "https only unless allowed")
+}
@@ -0,0 +1,187 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// ContractController handles the contract templates and the contracts generated from them.
+type ContractController struct {
+	logger                        *slog.Logger
+	createContractTemplateUseCase application.UseCase[usecases.CreateContractTemplateCommand, dto.ContractTemplateResponse]
+	listContractTemplatesUseCase  application.UseCase[usecases.ListContractTemplatesQuery, []dto.ContractTemplateResponse]
+	generateContractUseCase       application.UseCase[usecases.GenerateContractCommand, dto.ContractDocumentResponse]
+	listEmployeeContractsUseCase  application.UseCase[usecases.ListEmployeeContractsQuery, []dto.ContractDocumentResponse]
+	downloadContractUseCase       application.UseCase[usecases.DownloadContractQuery, dto.ContractFileResponse]
+}
+
+// NewContractController creates a new controller with dependencies wired up.
+func NewContractController(
+	logger *slog.Logger,
+	createContractTemplateUseCase application.UseCase[usecases.CreateContractTemplateCommand, dto.ContractTemplateResponse],
+	listContractTemplatesUseCase application.UseCase[usecases.ListContractTemplatesQuery, []dto.ContractTemplateResponse],
+	generateContractUseCase application.UseCase[usecases.GenerateContractCommand, dto.ContractDocumentResponse],
+	listEmployeeContractsUseCase application.UseCase[usecases.ListEmployeeContractsQuery, []dto.ContractDocumentResponse],
+	downloadContractUseCase application.UseCase[usecases.DownloadContractQuery, dto.ContractFileResponse],
+) *ContractController {
+	return &ContractController{
+		logger:                        logger,
+		createContractTemplateUseCase: createContractTemplateUseCase,
+		listContractTemplatesUseCase:  listContractTemplatesUseCase,
+		generateContractUseCase:       generateContractUseCase,
+		listEmployeeContractsUseCase:  listEmployeeContractsUseCase,
+		downloadContractUseCase:       downloadContractUseCase,
+	}
+}
+
+// HandleCreateTemplate publishes a new version of a contract template.
+// @Summary Create a contract template version
+// @Description Stores the template as the next version of its contract type. Placeholders are written as {{worker.fullName}}; FIJO templates must use {{cause}} and {{endDate}} and PRACTICANTE templates {{educationCenter}}. Paragraphs are separated by a blank line and a paragraph starting with "# " is a clause heading.
+// @Tags Contracts
+// @Accept json
+// @Produce json
+// @Param template body dto.ContractTemplateRequest true "Template text"
+// @Success 201 {object} utils.APIResponse "Contract template created"
+// @Failure 400 {object} utils.ProblemDetails "Bad request or unknown placeholder"
+// @Failure 409 {object} utils.ProblemDetails "Another version was published at the same time"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /contract-templates [post]
+func (c *ContractController) HandleCreateTemplate(w http.ResponseWriter, r *http.Request) {
+	var templateDTO dto.ContractTemplateRequest
+	if err := utils.ValidateAndBind(r, &templateDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	cmd := usecases.CreateContractTemplateCommand{Data: templateDTO}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+	}
+	resp, err := c.createContractTemplateUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Contract template created", "contractType", resp.ContractType, "version", resp.Version, "executedBy", cmd.ExecutingUserID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "contract.template_created", resp))
+}
+
+// HandleListTemplates lists the contract template versions.
+// @Summary List contract templates
+// @Tags Contracts
+// @Produce json
+// @Param contractType query string false "INDEFINIDO, FIJO or PRACTICANTE"
+// @Success 200 {object} utils.APIResponse "Contract templates found"
+// @Failure 400 {object} utils.ProblemDetails "Unknown contract type"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /contract-templates [get]
+func (c *ContractController) HandleListTemplates(w http.ResponseWriter, r *http.Request) {
+	query := usecases.ListContractTemplatesQuery{ContractType: r.URL.Query().Get("contractType")}
+	resp, err := c.listContractTemplatesUseCase.Execute(r.Context(), query)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "contract.templates_found", resp))
+}
+
+// HandleGenerate generates the contract of an employee.
+// @Summary Generate an employment contract
+// @Description Fills the template of the employee's contract type with the employer, person and employment data and stores the PDF or DOCX document with its SHA-256 hash.
+// @Tags Contracts
+// @Accept json
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Param contract body dto.ContractGenerationRequest true "Format and contract terms"
+// @Success 201 {object} utils.APIResponse "Contract generated"
+// @Failure 400 {object} utils.ProblemDetails "Bad request or missing contract terms"
+// @Failure 404 {object} utils.ProblemDetails "Employee not found"
+// @Failure 422 {object} utils.ProblemDetails "No template for the contract type or employer not registered"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/{id}/contracts [post]
+func (c *ContractController) HandleGenerate(w http.ResponseWriter, r *http.Request) {
+	var contractDTO dto.ContractGenerationRequest
+	if err := utils.ValidateAndBind(r, &contractDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	cmd := usecases.GenerateContractCommand{EmployeeID: r.PathValue("id"), Data: contractDTO}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+	}
+	resp, err := c.generateContractUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Contract generated", "employeeID", resp.EmployeeID, "documentID", resp.ID, "templateVersion", resp.TemplateVersion, "executedBy", cmd.ExecutingUserID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "contract.generated", resp))
+}
+
+// HandleListByEmployee lists the contracts generated for an employee.
+// @Summary List the contracts of an employee
+// @Tags Contracts
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Success 200 {object} utils.APIResponse "Contracts found"
+// @Failure 404 {object} utils.ProblemDetails "Employee not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/{id}/contracts [get]
+func (c *ContractController) HandleListByEmployee(w http.ResponseWriter, r *http.Request) {
+	resp, err := c.listEmployeeContractsUseCase.Execute(r.Context(), usecases.ListEmployeeContractsQuery{EmployeeID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "contract.found", resp))
+}
+
+// HandleDownload downloads a generated contract.
+// @Summary Download a contract
+// @Description Returns the document as generated, with its SHA-256 in the X-Content-SHA256 header and as ETag.
+// @Tags Contracts
+// @Produce application/pdf
+// @Produce application/vnd.openxmlformats-officedocument.wordprocessingml.document
+// @Param id path string true "Contract ID"
+// @Success 200 {file} file "Contract document"
+// @Header 200 {string} X-Content-SHA256 "SHA-256 of the document"
+// @Failure 404 {object} utils.ProblemDetails "Contract not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /contracts/{id}/file [get]
+func (c *ContractController) HandleDownload(w http.ResponseWriter, r *http.Request) {
+	file, err := c.downloadContractUseCase.Execute(r.Context(), usecases.DownloadContractQuery{DocumentID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	w.Header().Set("ETag", `"`+file.ContentHash+`"`)
+	w.Header().Set("X-Content-SHA256", file.ContentHash)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Content)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
 	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
 	"github.com/kevinsoras/employee-management/shared/application"
-	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
 	"github.com/kevinsoras/employee-management/shared/utils"
 )
 
@@ -16,13 +16,22 @@ import (
 type EmployeeController struct {
 	logger                  *slog.Logger
 	registerEmployeeUseCase application.UseCase[usecases.RegisterEmployeeCommand, dto.EmployeeResponse]
+	getEmployeeUseCase      application.UseCase[usecases.GetEmployeeQuery, dto.EmployeeResponse]
+	updateEmployeeUseCase   application.UseCase[usecases.UpdateEmployeeCommand, dto.EmployeeResponse]
 }
 
 // NewEmployeeController creates a new controller with dependencies wired up.
-func NewEmployeeController(logger *slog.Logger, registerEmployeeUseCase application.UseCase[usecases.RegisterEmployeeCommand, dto.EmployeeResponse]) *EmployeeController {
+func NewEmployeeController(
+	logger *slog.Logger,
+	registerEmployeeUseCase application.UseCase[usecases.RegisterEmployeeCommand, dto.EmployeeResponse],
+	getEmployeeUseCase application.UseCase[usecases.GetEmployeeQuery, dto.EmployeeResponse],
+	updateEmployeeUseCase application.UseCase[usecases.UpdateEmployeeCommand, dto.EmployeeResponse],
+) *EmployeeController {
 	return &EmployeeController{
 		logger:                  logger,
 		registerEmployeeUseCase: registerEmployeeUseCase,
+		getEmployeeUseCase:      getEmployeeUseCase,
+		updateEmployeeUseCase:   updateEmployeeUseCase,
 	}
 }
 
@@ -33,39 +42,111 @@ func NewEmployeeController(logger *slog.Logger, registerEmployeeUseCase applicat
 // @Accept json
 // @Produce json
 // @Param employee body dto.EmployeeRegistrationRequest true "Employee registration details"
+// @Param Idempotency-Key header string false "Key that makes retries replay the original response"
 // @Success 201 {object} utils.APIResponse "Employee registered successfully"
-// @Failure 400 {object} utils.APIResponse "Bad request"
-// @Failure 409 {object} utils.APIResponse "Conflict - Employee already exists"
-// @Failure 500 {object} utils.APIResponse "Internal server error"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 409 {object} utils.ProblemDetails "Conflict - Employee already exists"
+// @Failure 422 {object} utils.ProblemDetails "Idempotency-Key reused with a different payload"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
 // @Router /employee [post]
 func (c *EmployeeController) HandleRegister(w http.ResponseWriter, r *http.Request) {
 	c.logger.Info("Received request to register employee")
-	if r.Method != http.MethodPost {
-		c.logger.Warn("Invalid method for employee registration", "method", r.Method)
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		w.Write([]byte("Method not allowed"))
-		return
-	}
 
 	var registrationDTO dto.EmployeeRegistrationRequest
 	if err := utils.ValidateAndBind(r, &registrationDTO); err != nil {
 		c.logger.Error("Failed to validate or bind request DTO", "error", err)
-		utils.HandleHTTPError(w, c.logger, sharedDomain.NewInvalidInputError(err.Error(), err)) // Use NewInvalidInputError
+		utils.HandleHTTPError(w, r, c.logger, err)
 		return
 	}
 
 	// Create the command object to pass to the use case
 	cmd := usecases.RegisterEmployeeCommand{Data: registrationDTO}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+		cmd.UserRoles = principal.Roles
+	}
 	c.logger.Debug("Executing RegisterEmployeeCommand", "command", cmd)
 
 	resp, err := c.registerEmployeeUseCase.Execute(r.Context(), cmd)
 	if err != nil {
-		utils.HandleHTTPError(w, c.logger, err)
+		utils.HandleHTTPError(w, r, c.logger, err)
 		return
 	}
 
-	c.logger.Info("Successfully registered employee", "employeeID", resp)
+	c.logger.Info("Successfully registered employee", "employeeID", resp.Employment.ID, "executedBy", cmd.ExecutingUserID)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	_ = json.NewEncoder(w).Encode(utils.SuccessResponse("Empleado registrado exitosamente", resp))
-}
\ No newline at end of file
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "employee.registered", resp))
+}
+
+// HandleGet returns an employee with its version as ETag.
+// @Summary Get an employee
+// @Description Returns the employment and person data (masked according to the caller's roles); the ETag is required as If-Match to update it.
+// @Tags Employees
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Success 200 {object} utils.APIResponse "Employee found"
+// @Header 200 {string} ETag "Current version of the employee"
+// @Failure 404 {object} utils.ProblemDetails "Employee not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/{id} [get]
+func (c *EmployeeController) HandleGet(w http.ResponseWriter, r *http.Request) {
+	employeeID := r.PathValue("id")
+
+	resp, err := c.getEmployeeUseCase.Execute(r.Context(), usecases.GetEmployeeQuery{EmployeeID: employeeID})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	utils.SetETag(w, resp.Employment.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "employee.found", resp))
+}
+
+// HandleUpdate applies a partial update to an employee.
+// @Summary Update an employee
+// @Description Changes salary (recalculating benefits), job details and payroll data. Only the fields sent are modified.
+// @Tags Employees
+// @Accept json
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Param If-Match header string true "ETag returned when the employee was read"
+// @Param employee body dto.EmployeeUpdateRequest true "Fields to change"
+// @Success 200 {object} utils.APIResponse "Employee updated successfully"
+// @Header 200 {string} ETag "New version of the employee"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 404 {object} utils.ProblemDetails "Employee not found"
+// @Failure 412 {object} utils.ProblemDetails "The employee was modified by another user"
+// @Failure 428 {object} utils.ProblemDetails "If-Match header missing"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/{id} [patch]
+func (c *EmployeeController) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	employeeID := r.PathValue("id")
+	expectedVersion, err := utils.IfMatchVersion(r)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	var updateDTO dto.EmployeeUpdateRequest
+	if err := utils.ValidateAndBind(r, &updateDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	cmd := usecases.UpdateEmployeeCommand{EmployeeID: employeeID, ExpectedVersion: expectedVersion, Data: updateDTO}
+	resp, err := c.updateEmployeeUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Successfully updated employee", "employeeID", employeeID, "version", resp.Employment.Version)
+	utils.SetETag(w, resp.Employment.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "employee.updated", resp))
+}
@@ -0,0 +1,168 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// EmployeeDocumentController handles the documents attached to the employees.
+type EmployeeDocumentController struct {
+	logger                          *slog.Logger
+	uploadEmployeeDocumentUseCase   application.UseCase[usecases.UploadEmployeeDocumentCommand, dto.EmployeeDocumentResponse]
+	listEmployeeDocumentsUseCase    application.UseCase[usecases.ListEmployeeDocumentsQuery, []dto.EmployeeDocumentResponse]
+	downloadEmployeeDocumentUseCase application.UseCase[usecases.DownloadEmployeeDocumentQuery, dto.EmployeeDocumentFileResponse]
+}
+
+// NewEmployeeDocumentController creates a new controller with dependencies wired up.
+func NewEmployeeDocumentController(
+	logger *slog.Logger,
+	uploadEmployeeDocumentUseCase application.UseCase[usecases.UploadEmployeeDocumentCommand, dto.EmployeeDocumentResponse],
+	listEmployeeDocumentsUseCase application.UseCase[usecases.ListEmployeeDocumentsQuery, []dto.EmployeeDocumentResponse],
+	downloadEmployeeDocumentUseCase application.UseCase[usecases.DownloadEmployeeDocumentQuery, dto.EmployeeDocumentFileResponse],
+) *EmployeeDocumentController {
+	return &EmployeeDocumentController{
+		logger:                          logger,
+		uploadEmployeeDocumentUseCase:   uploadEmployeeDocumentUseCase,
+		listEmployeeDocumentsUseCase:    listEmployeeDocumentsUseCase,
+		downloadEmployeeDocumentUseCase: downloadEmployeeDocumentUseCase,
+	}
+}
+
+// HandleUpload attaches a document to an employee.
+// @Summary Upload an employee document
+// @Description Stores the file in the document storage and its metadata with its SHA-256 hash. The file type is detected from its content; PDF, JPEG and PNG up to 10 MB are accepted.
+// @Tags Employee documents
+// @Accept mpfd
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Param file formData file true "PDF, JPEG or PNG file"
+// @Param type formData string true "Document type" Enums(CONTRATO_FIRMADO, DOCUMENTO_IDENTIDAD, CERTIFICADO_MEDICO, TITULO, OTRO)
+// @Param expiresAt formData string false "Expiry date (YYYY-MM-DD)"
+// @Success 201 {object} utils.APIResponse{data=dto.EmployeeDocumentResponse} "Document uploaded"
+// @Failure 400 {object} utils.ProblemDetails "Missing file, unknown type or invalid expiry date"
+// @Failure 404 {object} utils.ProblemDetails "Employee not found"
+// @Failure 413 {object} utils.ProblemDetails "File too large"
+// @Failure 415 {object} utils.ProblemDetails "File type not allowed"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/{id}/documents [post]
+func (c *EmployeeDocumentController) HandleUpload(w http.ResponseWriter, r *http.Request) {
+	cmd, err := c.parseUpload(w, r)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+	}
+
+	resp, err := c.uploadEmployeeDocumentUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Employee document uploaded", "employeeID", resp.EmployeeID, "documentID", resp.ID, "type", resp.Type, "size", resp.Size, "executedBy", cmd.ExecutingUserID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "document.uploaded", resp))
+}
+
+// parseUpload reads the multipart form; the file content is validated by the use case.
+func (c *EmployeeDocumentController) parseUpload(w http.ResponseWriter, r *http.Request) (usecases.UploadEmployeeDocumentCommand, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, entities.MaxEmployeeDocumentSize+1<<20)
+	if err := r.ParseMultipartForm(entities.MaxEmployeeDocumentSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return usecases.UploadEmployeeDocumentCommand{}, documentTooLargeError()
+		}
+		return usecases.UploadEmployeeDocumentCommand{}, domain.NewInvalidInputError("document.file_required", err)
+	}
+
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		return usecases.UploadEmployeeDocumentCommand{}, domain.NewInvalidInputError("document.file_required", err)
+	}
+	defer file.Close()
+	if fileHeader.Size > entities.MaxEmployeeDocumentSize {
+		return usecases.UploadEmployeeDocumentCommand{}, documentTooLargeError()
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return usecases.UploadEmployeeDocumentCommand{}, domain.NewInvalidInputError("document.file_required", err)
+	}
+
+	return usecases.UploadEmployeeDocumentCommand{
+		EmployeeID: r.PathValue("id"),
+		Type:       r.FormValue("type"),
+		ExpiresAt:  r.FormValue("expiresAt"),
+		FileName:   fileHeader.Filename,
+		Content:    content,
+	}, nil
+}
+
+func documentTooLargeError() *domain.DomainError {
+	return domain.NewPayloadTooLargeError("document.too_large", nil).WithParams(domain.Params{"maxMB": entities.MaxEmployeeDocumentSize >> 20})
+}
+
+// HandleListByEmployee lists the documents attached to an employee.
+// @Summary List the documents of an employee
+// @Tags Employee documents
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Success 200 {object} utils.APIResponse{data=[]dto.EmployeeDocumentResponse} "Documents found"
+// @Failure 404 {object} utils.ProblemDetails "Employee not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/{id}/documents [get]
+func (c *EmployeeDocumentController) HandleListByEmployee(w http.ResponseWriter, r *http.Request) {
+	resp, err := c.listEmployeeDocumentsUseCase.Execute(r.Context(), usecases.ListEmployeeDocumentsQuery{EmployeeID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "document.found", resp))
+}
+
+// HandleDownload downloads the file of an employee document.
+// @Summary Download an employee document
+// @Description Returns the file as uploaded, with its SHA-256 in the X-Content-SHA256 header and as ETag.
+// @Tags Employee documents
+// @Produce application/pdf
+// @Produce image/jpeg
+// @Produce image/png
+// @Param id path string true "Document ID"
+// @Success 200 {file} file "Document file"
+// @Header 200 {string} X-Content-SHA256 "SHA-256 of the file"
+// @Failure 404 {object} utils.ProblemDetails "Document not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /documents/{id}/file [get]
+func (c *EmployeeDocumentController) HandleDownload(w http.ResponseWriter, r *http.Request) {
+	file, err := c.downloadEmployeeDocumentUseCase.Execute(r.Context(), usecases.DownloadEmployeeDocumentQuery{DocumentID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	// The file name comes from the uploader: FormatMediaType quotes it or encodes it as RFC 2231
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	w.Header().Set("ETag", `"`+file.ContentHash+`"`)
+	w.Header().Set("X-Content-SHA256", file.ContentHash)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Content)
+}
@@ -0,0 +1,179 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// EmployeeExportController streams the employee list as a downloadable file.
+type EmployeeExportController struct {
+	logger                 *slog.Logger
+	exportEmployeesUseCase application.UseCase[usecases.ExportEmployeesQuery, usecases.ExportEmployeesResult]
+	// exportTimeout replaces the request timeout: a large export legitimately takes minutes
+	exportTimeout time.Duration
+}
+
+// NewEmployeeExportController creates a new controller with dependencies wired up.
+func NewEmployeeExportController(
+	logger *slog.Logger,
+	exportEmployeesUseCase application.UseCase[usecases.ExportEmployeesQuery, usecases.ExportEmployeesResult],
+	exportTimeout time.Duration,
+) *EmployeeExportController {
+	return &EmployeeExportController{
+		logger:                 logger,
+		exportEmployeesUseCase: exportEmployeesUseCase,
+		exportTimeout:          exportTimeout,
+	}
+}
+
+// HandleExport streams the filtered employees as CSV, XLSX or JSON Lines.
+// @Summary Export employees
+// @Description Streams the employees matching the filters; sensitive columns are masked according to the caller's roles. Person columns are flattened by person type: natural-person columns are empty for juridical persons and vice versa.
+// @Tags Employees
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/x-ndjson
+// @Param format query string false "csv (default), xlsx or jsonl" Enums(csv, xlsx, jsonl)
+// @Param columns query string false "Comma-separated columns, e.g. employeeId,fullName,salary"
+// @Param department query string false "Department (case-insensitive)"
+// @Param contractType query string false "INDEFINIDO, FIJO or PRACTICANTE"
+// @Param position query string false "Part of the position"
+// @Param workLocation query string false "Work location"
+// @Param afp query string false "Pension fund"
+// @Param personType query string false "NATURAL or JURIDICAL"
+// @Param startDateFrom query string false "First start date (2006-01-02)"
+// @Param startDateTo query string false "Last start date (2006-01-02)"
+// @Success 200 {file} file "Export file"
+// @Failure 400 {object} utils.ProblemDetails "Invalid filter, format or column"
+// @Failure 403 {object} utils.ProblemDetails "Forbidden"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/export [get]
+func (c *EmployeeExportController) HandleExport(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	format, err := exportFormat(r)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	filterDTO := dto.EmployeeFilterRequest{
+		Department:    params.Get("department"),
+		ContractType:  strings.ToUpper(strings.TrimSpace(params.Get("contractType"))),
+		Position:      params.Get("position"),
+		WorkLocation:  params.Get("workLocation"),
+		AFP:           params.Get("afp"),
+		PersonType:    strings.ToUpper(strings.TrimSpace(params.Get("personType"))),
+		StartDateFrom: strings.TrimSpace(params.Get("startDateFrom")),
+		StartDateTo:   strings.TrimSpace(params.Get("startDateTo")),
+	}
+	if err := utils.ValidateStruct(&filterDTO); err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	// The response is committed with the first byte; until then a failure can still be a problem
+	out := &exportResponseWriter{w: w, onFirstWrite: func() {
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="employees-%s.%s"`, time.Now().Format("20060102"), format))
+		w.WriteHeader(http.StatusOK)
+	}}
+	writer, err := spreadsheet.NewWriter(format, out)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	ctx, cancel := c.exportContext(r)
+	defer cancel()
+	result, err := c.exportEmployeesUseCase.Execute(ctx, usecases.ExportEmployeesQuery{
+		Filter:  filterDTO,
+		Columns: splitColumns(params.Get("columns")),
+		Sink:    writer,
+	})
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil && !out.started {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	if err != nil {
+		// Half of the file was already sent: aborting the connection tells the client the download
+		// failed instead of leaving it with a silently truncated file
+		c.logger.Error("Employee export failed while streaming", "error", err, "rows", result.Rows, "traceId", utils.TraceIDFromContext(r.Context()))
+		panic(http.ErrAbortHandler)
+	}
+	c.logger.Info("Employee export finished", "format", format, "rows", result.Rows)
+}
+
+// exportContext detaches the export from the request timeout, bounding it by exportTimeout
+// instead, while still stopping when the client goes away.
+func (c *EmployeeExportController) exportContext(r *http.Request) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), c.exportTimeout)
+	stop := context.AfterFunc(r.Context(), func() {
+		if errors.Is(r.Context().Err(), context.Canceled) {
+			cancel()
+		}
+	})
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// exportFormat takes ?format=, or the Accept header when it asks for one of the formats.
+func exportFormat(r *http.Request) (spreadsheet.Format, error) {
+	value := r.URL.Query().Get("format")
+	if value == "" {
+		switch accept := r.Header.Get("Accept"); {
+		case strings.Contains(accept, spreadsheet.XLSX.ContentType()):
+			return spreadsheet.XLSX, nil
+		case strings.Contains(accept, spreadsheet.JSONL.ContentType()):
+			return spreadsheet.JSONL, nil
+		}
+		return spreadsheet.CSV, nil
+	}
+	format, err := spreadsheet.ParseFormat(value)
+	if err != nil {
+		return "", domain.NewInvalidInputError("validation.failed", err).WithFieldErrors(
+			*domain.NewFieldError("format", "oneof", "validation.oneof", domain.Params{"values": "csv, xlsx, jsonl"}))
+	}
+	return format, nil
+}
+
+func splitColumns(value string) []string {
+	var columns []string
+	for _, column := range strings.Split(value, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// exportResponseWriter writes the response headers right before the first byte of the file.
+type exportResponseWriter struct {
+	w            io.Writer
+	started      bool
+	onFirstWrite func()
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.onFirstWrite()
+	}
+	return e.w.Write(p)
+}
@@ -0,0 +1,202 @@
+package interfaces
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// Limits of an import file; bigger loads must be split so each request fits in the request timeout.
+const (
+	MaxImportFileSize = 10 << 20
+	MaxImportRows     = 1000
+)
+
+// csvMediaType is the Accept value that downloads the report as CSV instead of JSON.
+const csvMediaType = "text/csv"
+
+// EmployeeImportController handles the bulk import of employees from CSV or XLSX files.
+type EmployeeImportController struct {
+	logger                 *slog.Logger
+	importEmployeesUseCase application.UseCase[usecases.ImportEmployeesCommand, dto.EmployeeImportReport]
+}
+
+// NewEmployeeImportController creates a new controller with dependencies wired up.
+func NewEmployeeImportController(logger *slog.Logger, importEmployeesUseCase application.UseCase[usecases.ImportEmployeesCommand, dto.EmployeeImportReport]) *EmployeeImportController {
+	return &EmployeeImportController{
+		logger:                 logger,
+		importEmployeesUseCase: importEmployeesUseCase,
+	}
+}
+
+// HandleImport registers the employees of an uploaded file and reports the status of each row.
+// @Summary Import employees from CSV or XLSX
+// @Description Each row is validated and registered like POST /employee. The header names are the JSON paths of the registration request (e.g. person.documentNumber, employment.salary) or their unambiguous leaf names; `mapping` renames them. Modes: DRY_RUN (default, nothing is saved), ALL_OR_NOTHING and PER_ROW.
+// @Tags Employees
+// @Accept mpfd
+// @Produce json
+// @Produce text/csv
+// @Param file formData file true "CSV or XLSX file, first row is the header"
+// @Param mode formData string false "DRY_RUN, ALL_OR_NOTHING or PER_ROW" Enums(DRY_RUN, ALL_OR_NOTHING, PER_ROW)
+// @Param mapping formData string false "JSON object header -> field path; \"-\" skips a column"
+// @Success 200 {object} utils.APIResponse{data=dto.EmployeeImportReport} "Import report (CSV with Accept: text/csv)"
+// @Failure 400 {object} utils.ProblemDetails "Invalid file, mapping or mode"
+// @Failure 413 {object} utils.ProblemDetails "File too large"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /employees/import [post]
+func (c *EmployeeImportController) HandleImport(w http.ResponseWriter, r *http.Request) {
+	cmd, err := c.parseImport(w, r)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+		cmd.UserRoles = principal.Roles
+	}
+
+	report, err := c.importEmployeesUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	for i := range report.Rows {
+		if report.Rows[i].Err != nil {
+			describeRowError(r, &report.Rows[i])
+		}
+	}
+
+	c.logger.Info("Employee import finished", "mode", report.Mode, "total", report.Total, "succeeded", report.Succeeded, "failed", report.Failed, "committed", report.Committed, "executedBy", cmd.ExecutingUserID)
+	if wantsCSV(r) {
+		writeImportReportCSV(w, report)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "employee.imported", report))
+}
+
+// parseImport reads the multipart form and maps the rows of the file to registration requests.
+func (c *EmployeeImportController) parseImport(w http.ResponseWriter, r *http.Request) (usecases.ImportEmployeesCommand, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, MaxImportFileSize+1<<20)
+	if err := r.ParseMultipartForm(MaxImportFileSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return usecases.ImportEmployeesCommand{}, fileTooLargeError()
+		}
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.file_required", err)
+	}
+
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.file_required", err)
+	}
+	defer file.Close()
+	if fileHeader.Size > MaxImportFileSize {
+		return usecases.ImportEmployeesCommand{}, fileTooLargeError()
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.file_invalid", err)
+	}
+
+	records, err := spreadsheet.Read(fileHeader.Filename, content)
+	if errors.Is(err, spreadsheet.ErrUnsupportedFormat) {
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.unsupported_format", err)
+	}
+	if err != nil {
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.file_invalid", err)
+	}
+	if len(records) < 2 {
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.empty", nil)
+	}
+	if len(records)-1 > MaxImportRows {
+		return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.too_many_rows", nil).WithParams(domain.Params{"max": MaxImportRows})
+	}
+
+	var columnMapping map[string]string
+	if raw := r.FormValue("mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &columnMapping); err != nil {
+			return usecases.ImportEmployeesCommand{}, domain.NewInvalidInputError("import.mapping_invalid", err)
+		}
+	}
+	mapping, err := NewEmployeeImportMapping(records[0], columnMapping)
+	if err != nil {
+		return usecases.ImportEmployeesCommand{}, err
+	}
+
+	mode := strings.ToUpper(strings.TrimSpace(r.FormValue("mode")))
+	if mode == "" {
+		mode = dto.ImportModeDryRun
+	}
+	cmd := usecases.ImportEmployeesCommand{Mode: mode}
+	for i, cells := range records[1:] {
+		if spreadsheet.IsBlank(cells) {
+			continue
+		}
+		// Line 1 is the header
+		cmd.Rows = append(cmd.Rows, mapping.Row(i+2, cells))
+	}
+	return cmd, nil
+}
+
+func fileTooLargeError() *domain.DomainError {
+	return domain.NewPayloadTooLargeError("import.file_too_large", nil).WithParams(domain.Params{"max": MaxImportFileSize >> 20})
+}
+
+// describeRowError renders the error of a row like the problem the API would return for it.
+func describeRowError(r *http.Request, row *dto.EmployeeImportRowResult) {
+	problem := utils.ProblemFromError(r, row.Err)
+	row.Code, row.Message = problem.Code, problem.Detail
+	for _, field := range problem.Errors {
+		row.Errors = append(row.Errors, dto.ImportRowError{Field: field.Field, Rule: field.Rule, Message: field.Message})
+	}
+}
+
+func wantsCSV(r *http.Request) bool {
+	if r.URL.Query().Get("format") == "csv" {
+		return true
+	}
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(accepted); err == nil && mediaType == csvMediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// writeImportReportCSV writes the report as a downloadable CSV, one line per row of the file.
+func writeImportReportCSV(w http.ResponseWriter, report dto.EmployeeImportReport) {
+	w.Header().Set("Content-Type", csvMediaType+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="import-report.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	// The BOM makes Excel open the file as UTF-8
+	_, _ = io.WriteString(w, "\xEF\xBB\xBF")
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{"line", "documentNumber", "status", "employeeId", "code", "message", "errors"})
+	for _, row := range report.Rows {
+		fieldErrs := make([]string, 0, len(row.Errors))
+		for _, fieldErr := range row.Errors {
+			fieldErrs = append(fieldErrs, fieldErr.Field+": "+fieldErr.Message)
+		}
+		_ = writer.Write([]string{
+			strconv.Itoa(row.Line), row.DocumentNumber, row.Status, row.EmployeeID, row.Code, row.Message, strings.Join(fieldErrs, "; "),
+		})
+	}
+	writer.Flush()
+}
@@ -0,0 +1,228 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// ignoredColumn maps a header to no field: the column is skipped.
+const ignoredColumn = "-"
+
+// excelEpoch is day 0 of the Excel serial dates (it absorbs Excel's 1900 leap-year bug).
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// importDateLayouts are the date formats accepted in the cells, besides Excel serial dates.
+var importDateLayouts = []string{"2006-01-02", time.RFC3339, "02/01/2006", "2/1/2006", "02-01-2006"}
+
+// importField is a scalar field of EmployeeRegistrationRequest a column can be mapped to.
+type importField struct {
+	path string // JSON path, e.g. person.documentNumber
+	typ  reflect.Type
+}
+
+// importFields lists the mappable fields by JSON path. The leaf name is also accepted as an alias of
+// its shallowest path (email is person.email, not existingPerson.personUpdate.email) unless two paths
+// tie: documentNumber exists in person and existingPerson and must be written in full.
+var importFields, importAliases = collectImportFields()
+
+func collectImportFields() (map[string]importField, map[string]string) {
+	fields := make(map[string]importField)
+	walkImportFields(reflect.TypeOf(dto.EmployeeRegistrationRequest{}), "", fields)
+
+	leaves := make(map[string][]string)
+	for path := range fields {
+		leaf := path[strings.LastIndex(path, ".")+1:]
+		leaves[strings.ToLower(leaf)] = append(leaves[strings.ToLower(leaf)], path)
+	}
+	aliases := make(map[string]string)
+	for leaf, paths := range leaves {
+		sort.Slice(paths, func(i, j int) bool { return strings.Count(paths[i], ".") < strings.Count(paths[j], ".") })
+		if len(paths) == 1 || strings.Count(paths[0], ".") < strings.Count(paths[1], ".") {
+			aliases[leaf] = paths[0]
+		}
+	}
+	return fields, aliases
+}
+
+// walkImportFields collects the scalar fields; slices (contacts) cannot be expressed as columns.
+func walkImportFields(t reflect.Type, prefix string, fields map[string]importField) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name := strings.SplitN(sf.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		path := prefix + name
+		switch {
+		case ft == reflect.TypeOf(time.Time{}):
+			fields[path] = importField{path: path, typ: ft}
+		case ft.Kind() == reflect.Struct:
+			walkImportFields(ft, path+".", fields)
+		case ft.Kind() == reflect.String, ft.Kind() == reflect.Float64, ft.Kind() == reflect.Bool:
+			fields[path] = importField{path: path, typ: ft}
+		}
+	}
+}
+
+// EmployeeImportMapping assigns each column of an import file to a field of the registration request.
+type EmployeeImportMapping struct {
+	columns []*importField // nil for ignored columns
+}
+
+// NewEmployeeImportMapping resolves the header of the file. mapping overrides the header names
+// (header -> JSON path, or "-" to skip the column); the other headers must be a JSON path or an alias.
+func NewEmployeeImportMapping(header []string, mapping map[string]string) (*EmployeeImportMapping, error) {
+	overrides := make(map[string]string, len(mapping))
+	for column, target := range mapping {
+		overrides[normalizeHeader(column)] = target
+	}
+
+	m := &EmployeeImportMapping{columns: make([]*importField, len(header))}
+	used := make(map[string]string)
+	for i, column := range header {
+		target, ok := overrides[normalizeHeader(column)]
+		if !ok {
+			target = strings.TrimSpace(column)
+		}
+		if target == ignoredColumn || (!ok && target == "") {
+			continue
+		}
+		field, found := lookupImportField(target)
+		if !found {
+			return nil, domain.NewInvalidInputError("import.unknown_column", nil).WithParams(domain.Params{"column": column})
+		}
+		if previous, dup := used[field.path]; dup {
+			return nil, domain.NewInvalidInputError("import.duplicated_column", nil).WithParams(domain.Params{"column": column, "previous": previous})
+		}
+		used[field.path] = column
+		m.columns[i] = &field
+	}
+	return m, nil
+}
+
+func lookupImportField(target string) (importField, bool) {
+	if field, ok := importFields[target]; ok {
+		return field, true
+	}
+	for path, field := range importFields {
+		if strings.EqualFold(path, target) {
+			return field, true
+		}
+	}
+	if path, ok := importAliases[strings.ToLower(target)]; ok {
+		return importFields[path], true
+	}
+	return importField{}, false
+}
+
+func normalizeHeader(column string) string {
+	return strings.ToLower(strings.TrimSpace(column))
+}
+
+// Row converts the cells of a data row into a registration request, validated with the same rules
+// as POST /employee. line is the line of the row in the file (1 is the header). Empty cells are omitted.
+func (m *EmployeeImportMapping) Row(line int, cells []string) dto.EmployeeImportRow {
+	row := dto.EmployeeImportRow{Line: line}
+
+	document := make(map[string]any)
+	var fieldErrs []domain.FieldError
+	for i, field := range m.columns {
+		if field == nil || i >= len(cells) || strings.TrimSpace(cells[i]) == "" {
+			continue
+		}
+		value, err := convertCell(strings.TrimSpace(cells[i]), field.typ)
+		if err != nil {
+			fieldErrs = append(fieldErrs, *domain.NewFieldError(field.path, "type", "validation.type", domain.Params{"type": field.typ.String()}))
+			continue
+		}
+		setPath(document, field.path, value)
+	}
+	if len(fieldErrs) > 0 {
+		row.Err = domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(fieldErrs...)
+		return row
+	}
+
+	// The cells were converted to the field types, so the round trip through JSON cannot fail
+	raw, _ := json.Marshal(document)
+	if err := json.Unmarshal(raw, &row.Data); err != nil {
+		row.Err = domain.NewInvalidInputError("validation.failed", err)
+		return row
+	}
+	row.Err = utils.ValidateStruct(&row.Data)
+	return row
+}
+
+func convertCell(cell string, typ reflect.Type) (any, error) {
+	switch {
+	case typ == reflect.TypeOf(time.Time{}):
+		return parseImportDate(cell)
+	case typ.Kind() == reflect.Float64:
+		return parseImportNumber(cell)
+	case typ.Kind() == reflect.Bool:
+		return parseImportBool(cell)
+	default:
+		return cell, nil
+	}
+}
+
+func parseImportDate(cell string) (time.Time, error) {
+	for _, layout := range importDateLayouts {
+		if t, err := time.Parse(layout, cell); err == nil {
+			return t, nil
+		}
+	}
+	serial, err := strconv.ParseFloat(cell, 64)
+	if err != nil || serial < 1 {
+		return time.Time{}, strconv.ErrSyntax
+	}
+	days := math.Floor(serial)
+	return excelEpoch.AddDate(0, 0, int(days)), nil
+}
+
+// parseImportNumber accepts "1500.50" as well as the decimal comma of Spanish spreadsheets
+// ("1500,50"); with both separators ("1,500.50" or "1.500,50") the last one is the decimal one.
+func parseImportNumber(cell string) (float64, error) {
+	if strings.LastIndex(cell, ",") > strings.LastIndex(cell, ".") {
+		cell = strings.ReplaceAll(cell, ".", "")
+		cell = strings.ReplaceAll(cell, ",", ".")
+	} else {
+		cell = strings.ReplaceAll(cell, ",", "")
+	}
+	return strconv.ParseFloat(cell, 64)
+}
+
+func parseImportBool(cell string) (bool, error) {
+	switch strings.ToLower(cell) {
+	case "true", "1", "si", "sí", "x", "yes", "verdadero":
+		return true, nil
+	case "false", "0", "no", "falso":
+		return false, nil
+	}
+	return false, strconv.ErrSyntax
+}
+
+func setPath(document map[string]any, path string, value any) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := document[part].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			document[part] = child
+		}
+		document = child
+	}
+	document[parts[len(parts)-1]] = value
+}
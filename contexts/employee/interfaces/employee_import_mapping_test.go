@@ -0,0 +1,75 @@
+package interfaces_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/interfaces"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+)
+
+var importHeader = []string{
+	"Tipo", "email", "phone", "address", "country", "person.documentNumber", "firstName", "lastNamePaternal",
+	"lastNameMaternal", "birthDate", "gender", "Sueldo", "contractType", "startDate", "position",
+	"workSchedule", "department", "afp", "eps", "hasCTS", "Observaciones",
+}
+
+var importMapping = map[string]string{"tipo": "person.type", "Sueldo": "employment.salary", "Observaciones": "-"}
+
+func TestEmployeeImportMapping_Row(t *testing.T) {
+	mapping, err := interfaces.NewEmployeeImportMapping(importHeader, importMapping)
+	require.NoError(t, err)
+
+	row := mapping.Row(2, []string{
+		"NATURAL", "ana@example.com", "987654321", "Av. Arequipa 123", "PE", "12345678", "Ana", "Pérez",
+		"Gómez", "15/03/1990", "F", "1.500,50", "INDEFINIDO", "45292", "Analista",
+		"L-V 9-18", "Finanzas", "Prima", "Pacífico", "sí", "ignorada",
+	})
+
+	require.NoError(t, row.Err)
+	assert.Equal(t, 2, row.Line)
+	assert.Equal(t, "12345678", row.DocumentNumber())
+	assert.Equal(t, "NATURAL", row.Data.PersonData.Type)
+	assert.Equal(t, time.Date(1990, 3, 15, 0, 0, 0, 0, time.UTC), row.Data.PersonData.BirthDate)
+	assert.Equal(t, 1500.50, row.Data.EmploymentData.Salary)
+	// 45292 is the Excel serial date of 2024-01-01
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), row.Data.EmploymentData.StartDate)
+	assert.True(t, row.Data.EmploymentData.HasCTS)
+}
+
+func TestEmployeeImportMapping_RowReportsFieldErrors(t *testing.T) {
+	mapping, err := interfaces.NewEmployeeImportMapping(importHeader, importMapping)
+	require.NoError(t, err)
+
+	row := mapping.Row(3, []string{"NATURAL", "ana@example.com", "987654321", "Av. Arequipa 123", "PE", "12345678", "", "", "", "ayer", "", "mil"})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, row.Err, &domainErr)
+	fields := map[string]string{}
+	for _, field := range domainErr.Fields {
+		fields[field.Field] = field.Rule
+	}
+	assert.Equal(t, map[string]string{"person.birthDate": "type", "employment.salary": "type"}, fields)
+}
+
+func TestNewEmployeeImportMapping_UnknownOrAmbiguousColumn(t *testing.T) {
+	for _, column := range []string{"sueldo", "documentNumber"} {
+		_, err := interfaces.NewEmployeeImportMapping([]string{column}, nil)
+
+		var domainErr *sharedDomain.DomainError
+		require.ErrorAs(t, err, &domainErr, column)
+		assert.Equal(t, "import.unknown_column", domainErr.MessageKey)
+		assert.Equal(t, column, domainErr.Params["column"])
+	}
+}
+
+func TestNewEmployeeImportMapping_DuplicatedColumn(t *testing.T) {
+	_, err := interfaces.NewEmployeeImportMapping([]string{"salary", "employment.salary"}, nil)
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "import.duplicated_column", domainErr.MessageKey)
+}
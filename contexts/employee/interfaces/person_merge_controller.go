@@ -0,0 +1,61 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	sharedDto "github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// PersonMergeController handles the merge of duplicate persons.
+type PersonMergeController struct {
+	logger              *slog.Logger
+	mergePersonsUseCase application.UseCase[usecases.MergePersonsCommand, sharedDto.PersonMergeResponse]
+}
+
+// NewPersonMergeController creates a new controller with dependencies wired up.
+func NewPersonMergeController(logger *slog.Logger, mergePersonsUseCase application.UseCase[usecases.MergePersonsCommand, sharedDto.PersonMergeResponse]) *PersonMergeController {
+	return &PersonMergeController{
+		logger:              logger,
+		mergePersonsUseCase: mergePersonsUseCase,
+	}
+}
+
+// HandleMerge merges a duplicate person into the surviving one.
+// @Summary Merge duplicate persons
+// @Description Re-points the employees of the duplicate to the survivor, keeps a merge audit record and deletes the duplicate.
+// @Tags Persons
+// @Accept json
+// @Produce json
+// @Param merge body sharedDto.PersonMergeRequest true "Persons to merge"
+// @Param Idempotency-Key header string false "Key that makes retries replay the original response"
+// @Success 200 {object} utils.APIResponse "Persons merged successfully"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 404 {object} utils.ProblemDetails "Person not found"
+// @Failure 422 {object} utils.ProblemDetails "Idempotency-Key reused with a different payload"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /persons/merge [post]
+func (c *PersonMergeController) HandleMerge(w http.ResponseWriter, r *http.Request) {
+
+	var mergeDTO sharedDto.PersonMergeRequest
+	if err := utils.ValidateAndBind(r, &mergeDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	resp, err := c.mergePersonsUseCase.Execute(r.Context(), usecases.MergePersonsCommand{Data: mergeDTO})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Successfully merged persons", "survivorPersonID", resp.SurvivorPersonID, "mergedPersonID", resp.MergedPersonID, "employeesMoved", resp.EmployeesMoved)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "person.merged", resp))
+}
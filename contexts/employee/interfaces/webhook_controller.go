@@ -0,0 +1,220 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/contexts/employee/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/employee/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// WebhookController handles the webhook subscriptions and their delivery log.
+type WebhookController struct {
+	logger                           *slog.Logger
+	createWebhookSubscriptionUseCase application.UseCase[usecases.CreateWebhookSubscriptionCommand, dto.WebhookSubscriptionResponse]
+	listWebhookSubscriptionsUseCase  application.UseCase[usecases.ListWebhookSubscriptionsQuery, []dto.WebhookSubscriptionResponse]
+	getWebhookSubscriptionUseCase    application.UseCase[usecases.GetWebhookSubscriptionQuery, dto.WebhookSubscriptionResponse]
+	updateWebhookSubscriptionUseCase application.UseCase[usecases.UpdateWebhookSubscriptionCommand, dto.WebhookSubscriptionResponse]
+	deleteWebhookSubscriptionUseCase application.UseCase[usecases.DeleteWebhookSubscriptionCommand, dto.WebhookSubscriptionResponse]
+	listWebhookDeliveriesUseCase     application.UseCase[usecases.ListWebhookDeliveriesQuery, []dto.WebhookDeliveryResponse]
+}
+
+// NewWebhookController creates a new controller with dependencies wired up.
+func NewWebhookController(
+	logger *slog.Logger,
+	createWebhookSubscriptionUseCase application.UseCase[usecases.CreateWebhookSubscriptionCommand, dto.WebhookSubscriptionResponse],
+	listWebhookSubscriptionsUseCase application.UseCase[usecases.ListWebhookSubscriptionsQuery, []dto.WebhookSubscriptionResponse],
+	getWebhookSubscriptionUseCase application.UseCase[usecases.GetWebhookSubscriptionQuery, dto.WebhookSubscriptionResponse],
+	updateWebhookSubscriptionUseCase application.UseCase[usecases.UpdateWebhookSubscriptionCommand, dto.WebhookSubscriptionResponse],
+	deleteWebhookSubscriptionUseCase application.UseCase[usecases.DeleteWebhookSubscriptionCommand, dto.WebhookSubscriptionResponse],
+	listWebhookDeliveriesUseCase application.UseCase[usecases.ListWebhookDeliveriesQuery, []dto.WebhookDeliveryResponse],
+) *WebhookController {
+	return &WebhookController{
+		logger:                           logger,
+		createWebhookSubscriptionUseCase: createWebhookSubscriptionUseCase,
+		listWebhookSubscriptionsUseCase:  listWebhookSubscriptionsUseCase,
+		getWebhookSubscriptionUseCase:    getWebhookSubscriptionUseCase,
+		updateWebhookSubscriptionUseCase: updateWebhookSubscriptionUseCase,
+		deleteWebhookSubscriptionUseCase: deleteWebhookSubscriptionUseCase,
+		listWebhookDeliveriesUseCase:     listWebhookDeliveriesUseCase,
+	}
+}
+
+// HandleCreate registers a webhook subscription.
+// @Summary Create a webhook subscription
+// @Description Registers an HTTPS endpoint to receive the chosen employee events (employee.registered, employee.updated, employee.salary_changed, employee.terminated). Every delivery is signed with HMAC-SHA256 in the X-Webhook-Signature header. The secret is generated when omitted and returned only in this response.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param subscription body dto.WebhookSubscriptionRequest true "Endpoint and events"
+// @Success 201 {object} utils.APIResponse "Webhook subscription created"
+// @Failure 400 {object} utils.ProblemDetails "Bad request, invalid URL or unknown event"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /webhook-subscriptions [post]
+func (c *WebhookController) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var subscriptionDTO dto.WebhookSubscriptionRequest
+	if err := utils.ValidateAndBind(r, &subscriptionDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	cmd := usecases.CreateWebhookSubscriptionCommand{Data: subscriptionDTO}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+	}
+	resp, err := c.createWebhookSubscriptionUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Webhook subscription created", "subscriptionID", resp.ID, "events", resp.Events, "executedBy", cmd.ExecutingUserID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "webhook.subscription_created", resp))
+}
+
+// HandleList lists the webhook subscriptions.
+// @Summary List webhook subscriptions
+// @Tags Webhooks
+// @Produce json
+// @Success 200 {object} utils.APIResponse "Webhook subscriptions found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /webhook-subscriptions [get]
+func (c *WebhookController) HandleList(w http.ResponseWriter, r *http.Request) {
+	resp, err := c.listWebhookSubscriptionsUseCase.Execute(r.Context(), usecases.ListWebhookSubscriptionsQuery{})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "webhook.subscriptions_found", resp))
+}
+
+// HandleGet reads a webhook subscription.
+// @Summary Get a webhook subscription
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} utils.APIResponse "Webhook subscription found"
+// @Failure 404 {object} utils.ProblemDetails "Subscription not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /webhook-subscriptions/{id} [get]
+func (c *WebhookController) HandleGet(w http.ResponseWriter, r *http.Request) {
+	resp, err := c.getWebhookSubscriptionUseCase.Execute(r.Context(), usecases.GetWebhookSubscriptionQuery{SubscriptionID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "webhook.subscription_found", resp))
+}
+
+// HandleUpdate replaces the settings of a webhook subscription.
+// @Summary Update a webhook subscription
+// @Description Replaces the URL, events and description. active=false pauses the subscription: its deliveries wait until it is resumed. rotateSecret=true replaces the secret with the given one or a generated one, returned only in this response.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param subscription body dto.WebhookSubscriptionUpdateRequest true "New settings"
+// @Success 200 {object} utils.APIResponse "Webhook subscription updated"
+// @Failure 400 {object} utils.ProblemDetails "Bad request, invalid URL or unknown event"
+// @Failure 404 {object} utils.ProblemDetails "Subscription not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /webhook-subscriptions/{id} [put]
+func (c *WebhookController) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	var subscriptionDTO dto.WebhookSubscriptionUpdateRequest
+	if err := utils.ValidateAndBind(r, &subscriptionDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	cmd := usecases.UpdateWebhookSubscriptionCommand{SubscriptionID: r.PathValue("id"), Data: subscriptionDTO}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+	}
+	resp, err := c.updateWebhookSubscriptionUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Webhook subscription updated", "subscriptionID", resp.ID, "active", resp.Active,
+		"secretRotated", subscriptionDTO.RotateSecret, "executedBy", cmd.ExecutingUserID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "webhook.subscription_updated", resp))
+}
+
+// HandleDelete removes a webhook subscription.
+// @Summary Delete a webhook subscription
+// @Description Deletes the subscription with its delivery log; pending deliveries are dropped. Pause it instead to keep them.
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} utils.APIResponse "Webhook subscription deleted"
+// @Failure 404 {object} utils.ProblemDetails "Subscription not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /webhook-subscriptions/{id} [delete]
+func (c *WebhookController) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	cmd := usecases.DeleteWebhookSubscriptionCommand{SubscriptionID: r.PathValue("id")}
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		cmd.ExecutingUserID = principal.UserID
+	}
+	resp, err := c.deleteWebhookSubscriptionUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Webhook subscription deleted", "subscriptionID", resp.ID, "executedBy", cmd.ExecutingUserID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "webhook.subscription_deleted", resp))
+}
+
+// HandleListDeliveries lists the delivery log of a webhook subscription.
+// @Summary List webhook deliveries
+// @Description Newest first, with the status, attempts and last response of each delivery. PENDING deliveries show when they will be retried.
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param status query string false "PENDING, DELIVERED or FAILED"
+// @Param limit query int false "Maximum deliveries (1-500, default 50)"
+// @Success 200 {object} utils.APIResponse "Webhook deliveries found"
+// @Failure 400 {object} utils.ProblemDetails "Unknown status or limit out of range"
+// @Failure 404 {object} utils.ProblemDetails "Subscription not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /webhook-subscriptions/{id}/deliveries [get]
+func (c *WebhookController) HandleListDeliveries(w http.ResponseWriter, r *http.Request) {
+	filterDTO := dto.WebhookDeliveriesRequest{
+		Status: r.URL.Query().Get("status"),
+		Limit:  r.URL.Query().Get("limit"),
+	}
+	if err := utils.ValidateStruct(&filterDTO); err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	query := usecases.ListWebhookDeliveriesQuery{SubscriptionID: r.PathValue("id"), Filter: filterDTO}
+	resp, err := c.listWebhookDeliveriesUseCase.Execute(r.Context(), query)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "webhook.deliveries_found", resp))
+}
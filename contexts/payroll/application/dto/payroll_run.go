@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+)
+
+// CreatePayrollRunRequest - periodo a calcular; sin fecha de pago se paga el último día del mes
+type CreatePayrollRunRequest struct {
+	Period      string `json:"period" validate:"required,datetime=2006-01"`
+	PaymentDate string `json:"paymentDate" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type PayrollRunResponse struct {
+	ID                         string                `json:"id"`
+	Period                     string                `json:"period"`
+	PaymentDate                string                `json:"paymentDate"`
+	Status                     string                `json:"status"`
+	EmployeeCount              int                   `json:"employeeCount"`
+	TotalGross                 float64               `json:"totalGross"`
+	TotalDeductions            float64               `json:"totalDeductions"`
+	TotalNet                   float64               `json:"totalNet"`
+	TotalEmployerContributions float64               `json:"totalEmployerContributions"`
+	CreatedAt                  time.Time             `json:"createdAt"`
+	Items                      []PayrollItemResponse `json:"items"`
+}
+
+type PayrollItemResponse struct {
+	ID                    string             `json:"id"`
+	EmployeeID            string             `json:"employeeId"`
+	DocumentType          string             `json:"documentType"`
+	DocumentNumber        string             `json:"documentNumber"`
+	FullName              string             `json:"fullName"`
+	BankAccount           string             `json:"bankAccount"`
+	PensionSystem         string             `json:"pensionSystem"`
+	ContractType          string             `json:"contractType"`
+	BaseSalary            float64            `json:"baseSalary"`
+	DaysWorked            int                `json:"daysWorked"`
+	GrossPay              float64            `json:"grossPay"`
+	TotalDeductions       float64            `json:"totalDeductions"`
+	NetPay                float64            `json:"netPay"`
+	EmployerContributions float64            `json:"employerContributions"`
+	Concepts              []entities.Concept `json:"concepts"`
+}
+
+// NewPayrollRunResponse arma la respuesta del cálculo; documento y cuenta bancaria se enmascaran
+// según los roles del viewer.
+func NewPayrollRunResponse(run *entities.PayrollRun, viewer masking.Viewer) PayrollRunResponse {
+	resp := PayrollRunResponse{
+		ID:                         run.ID(),
+		Period:                     run.Period().String(),
+		PaymentDate:                run.PaymentDate().Format("2006-01-02"),
+		Status:                     string(run.Status()),
+		EmployeeCount:              len(run.Items()),
+		TotalGross:                 run.TotalGross(),
+		TotalDeductions:            run.TotalDeductions(),
+		TotalNet:                   run.TotalNet(),
+		TotalEmployerContributions: run.TotalEmployerContributions(),
+		CreatedAt:                  run.CreatedAt(),
+		Items:                      make([]PayrollItemResponse, 0, len(run.Items())),
+	}
+	for _, item := range run.Items() {
+		employee := item.Employee()
+		resp.Items = append(resp.Items, PayrollItemResponse{
+			ID:                    item.ID(),
+			EmployeeID:            employee.EmployeeID,
+			DocumentType:          employee.DocumentType,
+			DocumentNumber:        viewer.String(masking.FieldDocumentNumber, employee.DocumentNumber),
+			FullName:              employee.FullName,
+			BankAccount:           viewer.String(masking.FieldBankAccount, employee.BankAccount),
+			PensionSystem:         string(employee.PensionSystem),
+			ContractType:          employee.ContractType,
+			BaseSalary:            employee.BaseSalary,
+			DaysWorked:            item.DaysWorked(),
+			GrossPay:              item.GrossPay(),
+			TotalDeductions:       item.TotalDeductions(),
+			NetPay:                item.NetPay(),
+			EmployerContributions: item.EmployerContributions(),
+			Concepts:              item.Concepts(),
+		})
+	}
+	return resp
+}
+
+// BankFileResponse - archivo de pagos listo para descargar, con los totales del lote
+type BankFileResponse struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+	Payments    int
+	TotalAmount float64
+}
+
+// AFPnetFileResponse - planilla de aportes de una AFP lista para subir a AFPnet
+type AFPnetFileResponse struct {
+	FileName       string
+	ContentType    string
+	Content        []byte
+	Affiliates     int
+	TotalInsurable float64
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+)
+
+// AcknowledgePayslipRequest - constancia de recepción: el trabajador firma el hash del documento
+// que descargó
+type AcknowledgePayslipRequest struct {
+	ContentHash string `json:"contentHash" validate:"required,len=64,hexadecimal"`
+}
+
+type PayslipResponse struct {
+	ID             string     `json:"id"`
+	RunID          string     `json:"runId"`
+	EmployeeID     string     `json:"employeeId"`
+	Period         string     `json:"period"`
+	ContentHash    string     `json:"contentHash"`
+	Size           int        `json:"size"`
+	GeneratedAt    time.Time  `json:"generatedAt"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt,omitempty"`
+	AcknowledgedBy string     `json:"acknowledgedBy,omitempty"`
+}
+
+// PayslipListResponse - boletas emitidas de un cálculo; Generated cuenta las emitidas en esta llamada
+type PayslipListResponse struct {
+	Generated    int               `json:"generated"`
+	Acknowledged int               `json:"acknowledged"`
+	Payslips     []PayslipResponse `json:"payslips"`
+}
+
+// PayslipFileResponse - documento de la boleta listo para descargar
+type PayslipFileResponse struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+	ContentHash string
+}
+
+func NewPayslipResponse(payslip *entities.Payslip) PayslipResponse {
+	return PayslipResponse{
+		ID:             payslip.ID(),
+		RunID:          payslip.RunID(),
+		EmployeeID:     payslip.EmployeeID(),
+		Period:         payslip.Period().String(),
+		ContentHash:    payslip.ContentHash(),
+		Size:           payslip.Size(),
+		GeneratedAt:    payslip.GeneratedAt(),
+		AcknowledgedAt: payslip.AcknowledgedAt(),
+		AcknowledgedBy: payslip.AcknowledgedBy(),
+	}
+}
+
+// NewPayslipListResponse arma la lista de boletas con el avance de las constancias de recepción.
+func NewPayslipListResponse(payslips []*entities.Payslip, generated int) PayslipListResponse {
+	resp := PayslipListResponse{Generated: generated, Payslips: make([]PayslipResponse, 0, len(payslips))}
+	for _, payslip := range payslips {
+		if payslip.IsAcknowledged() {
+			resp.Acknowledged++
+		}
+		resp.Payslips = append(resp.Payslips, NewPayslipResponse(payslip))
+	}
+	return resp
+}
@@ -0,0 +1,14 @@
+package dto
+
+// TRegistroRequest - rango de fechas de ingreso de los trabajadores a declarar (altas del periodo)
+type TRegistroRequest struct {
+	StartDateFrom string `json:"startDateFrom" validate:"omitempty,datetime=2006-01-02"`
+	StartDateTo   string `json:"startDateTo" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// SunatFileResponse - archivo de importación listo para descargar
+type SunatFileResponse struct {
+	FileName string
+	Content  []byte
+	Records  int // trabajadores incluidos
+}
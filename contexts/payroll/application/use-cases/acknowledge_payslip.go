@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// AcknowledgePayslipCommand holds the payslip and the hash of the document the employee received.
+type AcknowledgePayslipCommand struct {
+	PayslipID string
+	Data      dto.AcknowledgePayslipRequest
+}
+
+// AcknowledgePayslipUseCase records the employee's acknowledgement of receipt of their payslip.
+// Only the employee can acknowledge it; HR cannot sign on their behalf.
+type AcknowledgePayslipUseCase struct {
+	payslipRepo repositories.PayslipRepository
+}
+
+// NewAcknowledgePayslipUseCase creates a new AcknowledgePayslipUseCase.
+func NewAcknowledgePayslipUseCase(payslipRepo repositories.PayslipRepository) *AcknowledgePayslipUseCase {
+	return &AcknowledgePayslipUseCase{payslipRepo: payslipRepo}
+}
+
+// Execute is idempotent: acknowledging again keeps the date of the first acknowledgement.
+func (uc *AcknowledgePayslipUseCase) Execute(ctx context.Context, cmd AcknowledgePayslipCommand) (dto.PayslipResponse, error) {
+	payslip, err := findPayslip(ctx, uc.payslipRepo, cmd.PayslipID)
+	if err != nil {
+		return dto.PayslipResponse{}, err
+	}
+	principal, _ := security.PrincipalFromContext(ctx)
+	alreadyAcknowledged := payslip.IsAcknowledged()
+	if err := payslip.Acknowledge(cmd.Data.ContentHash, principal.UserID, time.Now()); err != nil {
+		return dto.PayslipResponse{}, err
+	}
+	if !alreadyAcknowledged {
+		if err := uc.payslipRepo.SaveAcknowledgement(ctx, payslip); err != nil {
+			return dto.PayslipResponse{}, fmt.Errorf("error saving acknowledgement: %w", err)
+		}
+	}
+	return dto.NewPayslipResponse(payslip), nil
+}
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	employeeRepositories "github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+)
+
+// CreatePayrollRunCommand holds the period to calculate.
+type CreatePayrollRunCommand struct {
+	Data dto.CreatePayrollRunRequest
+}
+
+// CreatePayrollRunUseCase calculates the payroll of a period for every employee hired by its
+// last day and not terminated before its first one, and stores it with a snapshot of each
+// employee's data.
+type CreatePayrollRunUseCase struct {
+	employeeRepo employeeRepositories.EmployeeRepository
+	payrollRepo  repositories.PayrollRunRepository
+	calculator   services.PayrollCalculator
+}
+
+// NewCreatePayrollRunUseCase creates a new CreatePayrollRunUseCase.
+func NewCreatePayrollRunUseCase(employeeRepo employeeRepositories.EmployeeRepository, payrollRepo repositories.PayrollRunRepository, calculator services.PayrollCalculator) *CreatePayrollRunUseCase {
+	return &CreatePayrollRunUseCase{employeeRepo: employeeRepo, payrollRepo: payrollRepo, calculator: calculator}
+}
+
+// Execute rejects the whole run when any employee cannot be calculated, listing every offending
+// employee, so that a partial payroll is never stored.
+func (uc *CreatePayrollRunUseCase) Execute(ctx context.Context, cmd CreatePayrollRunCommand) (dto.PayrollRunResponse, error) {
+	period, err := value_objects.NewPeriod(cmd.Data.Period)
+	if err != nil {
+		return dto.PayrollRunResponse{}, err
+	}
+	paymentDate := period.End()
+	if cmd.Data.PaymentDate != "" {
+		if paymentDate, err = time.Parse("2006-01-02", cmd.Data.PaymentDate); err != nil {
+			return dto.PayrollRunResponse{}, domain.NewFieldError("paymentDate", "datetime", "validation.datetime", domain.Params{"format": "YYYY-MM-DD"})
+		}
+	}
+
+	run := entities.NewPayrollRun(period, paymentDate)
+	var fieldErrs []domain.FieldError
+	periodStart, periodEnd := period.Start(), period.End()
+	filter := employeeRepositories.EmployeeFilter{StartDateTo: &periodEnd, ActiveFrom: &periodStart}
+	err = uc.employeeRepo.StreamEmployees(ctx, filter, func(record employeeRepositories.EmployeeRecord) error {
+		employee := record.Employee
+		pensionSystem, err := value_objects.NewPensionSystem(employee.AFP())
+		if err != nil {
+			fieldErrs = append(fieldErrs, *domain.NewFieldError(
+				fmt.Sprintf("employees[%s].afp", employee.ID()), "oneof", "payroll.pension_system_invalid", domain.Params{"value": employee.AFP()}))
+			return nil
+		}
+
+		daysWorked, concepts := uc.calculator.Calculate(period, services.PayrollInput{
+			Salary:           employee.Salary(),
+			StartDate:        employee.StartDate(),
+			EndDate:          employee.EndDate(),
+			HasGratification: employee.HasGratification(),
+			HasEPS:           value_objects.HasEPS(employee.EPS()),
+			PensionSystem:    pensionSystem,
+		})
+		snapshot := entities.EmployeeSnapshot{
+			EmployeeID:     employee.ID(),
+			PersonID:       employee.PersonID(),
+			DocumentType:   documentType(record.Person),
+			DocumentNumber: documentNumber(record.Person),
+			FullName:       payrollName(record.Person),
+			BankAccount:    employee.BankAccount(),
+			PensionSystem:  pensionSystem,
+			CUSPP:          employee.CUSPP(),
+			ContractType:   employee.ContractType(),
+			BaseSalary:     employee.Salary(),
+			StartDate:      employee.StartDate(),
+			EndDate:        employee.EndDate(),
+		}
+		if np := record.Person.NaturalPerson; np != nil {
+			snapshot.FirstName, snapshot.LastNamePaternal, snapshot.LastNameMaternal = np.FirstName, np.LastNamePaternal, np.LastNameMaternal
+		}
+		run.AddItem(entities.NewPayrollItem(snapshot, daysWorked, concepts))
+		return nil
+	})
+	if err != nil {
+		return dto.PayrollRunResponse{}, fmt.Errorf("error reading employees: %w", err)
+	}
+	if len(fieldErrs) > 0 {
+		return dto.PayrollRunResponse{}, domain.NewBusinessRuleError("payroll.employees_invalid", nil).
+			WithParams(domain.Params{"count": len(fieldErrs)}).
+			WithFieldErrors(fieldErrs...)
+	}
+	if len(run.Items()) == 0 {
+		return dto.PayrollRunResponse{}, domain.NewBusinessRuleError("payroll.no_employees", nil).
+			WithParams(domain.Params{"period": period.String()})
+	}
+
+	if err := uc.payrollRepo.SavePayrollRun(ctx, run); err != nil {
+		return dto.PayrollRunResponse{}, fmt.Errorf("error saving payroll run: %w", err)
+	}
+	return dto.NewPayrollRunResponse(run, masking.ViewerFromContext(ctx)), nil
+}
+
+func documentType(agg *aggregates.PersonAggregate) string {
+	switch {
+	case agg.NaturalPerson != nil:
+		return string(agg.NaturalPerson.DocumentType)
+	case agg.JuridicalPerson != nil:
+		return "RUC"
+	}
+	return ""
+}
+
+func documentNumber(agg *aggregates.PersonAggregate) string {
+	switch {
+	case agg.NaturalPerson != nil:
+		return agg.NaturalPerson.DocumentNumber
+	case agg.JuridicalPerson != nil:
+		return agg.JuridicalPerson.DocumentNumber
+	}
+	return ""
+}
+
+// payrollName - apellidos y nombres, el orden que usan los bancos y SUNAT; razón social si es jurídica.
+func payrollName(agg *aggregates.PersonAggregate) string {
+	switch {
+	case agg.NaturalPerson != nil:
+		np := agg.NaturalPerson
+		return strings.Join(strings.Fields(np.LastNamePaternal+" "+np.LastNameMaternal+" "+np.FirstName), " ")
+	case agg.JuridicalPerson != nil:
+		return agg.JuridicalPerson.BusinessName
+	}
+	return ""
+}
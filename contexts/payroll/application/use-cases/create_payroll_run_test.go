@@ -0,0 +1,117 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	employeeEntities "github.com/kevinsoras/employee-management/contexts/employee/domain/entities"
+	employeeRepositories "github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	employee_value_objects "github.com/kevinsoras/employee-management/contexts/employee/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	sharedEntities "github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	shared_vo "github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+type MockPayrollRunRepository struct {
+	mock.Mock
+}
+
+func (m *MockPayrollRunRepository) SavePayrollRun(ctx context.Context, run *entities.PayrollRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+
+func (m *MockPayrollRunRepository) GetPayrollRunByID(ctx context.Context, id string) (*entities.PayrollRun, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PayrollRun), args.Error(1)
+}
+
+// employeeSource streams fixed records; the payroll only reads employees through StreamEmployees
+type employeeSource struct {
+	employeeRepositories.EmployeeRepository
+	records []employeeRepositories.EmployeeRecord
+	filter  employeeRepositories.EmployeeFilter
+}
+
+func (s *employeeSource) StreamEmployees(_ context.Context, filter employeeRepositories.EmployeeFilter, fn func(employeeRepositories.EmployeeRecord) error) error {
+	s.filter = filter
+	for _, record := range s.records {
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func employeeRecord(id, afp, bankAccount string) employeeRepositories.EmployeeRecord {
+	person := sharedEntities.NewPerson(shared_vo.Natural, "ana@empresa.pe", "987654321", "Av. Arequipa 100", "Peru")
+	firstName, lastPat, lastMat, gender := "Ana", "Quispe", "Mamani", "F"
+	birthDate := time.Date(1990, 3, 1, 0, 0, 0, 0, time.UTC)
+	natural, _ := sharedEntities.NewNaturalPerson(person.ID, shared_vo.DNI, "12345678", &firstName, &lastPat, &lastMat, &gender, &birthDate, nil, nil)
+	benefits, _ := employee_value_objects.NewBenefits(0, 0, 30)
+	employee := employeeEntities.NewEmployeeBuilder(person.ID, 3000, "INDEFINIDO", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)).
+		WithJobDetails("Analyst", "Finance", "L-V", "Lima").
+		WithPayroll(bankAccount, afp, "Ninguna").
+		Restore(id, benefits, 1, time.Now(), time.Now())
+	return employeeRepositories.EmployeeRecord{Employee: employee, Person: aggregates.NewPersonAggregate(person, natural, nil)}
+}
+
+func contextWithRoles(roles ...security.Role) context.Context {
+	return security.WithPrincipal(context.Background(), &security.Principal{UserID: "user-1", Roles: roles})
+}
+
+func TestCreatePayrollRun_CalculatesEveryEmployeeHiredByPeriodEnd(t *testing.T) {
+	source := &employeeSource{records: []employeeRepositories.EmployeeRecord{
+		employeeRecord("emp-1", "Integra", "19312345678012"),
+		employeeRecord("emp-2", "ONP", "00310000456789123451"),
+	}}
+	repo := new(MockPayrollRunRepository)
+	repo.On("SavePayrollRun", mock.Anything, mock.AnythingOfType("*entities.PayrollRun")).Return(nil)
+	uc := usecases.NewCreatePayrollRunUseCase(source, repo, services.NewPeruvianPayrollCalculator())
+
+	resp, err := uc.Execute(contextWithRoles(security.RoleHRAnalyst), usecases.CreatePayrollRunCommand{Data: dto.CreatePayrollRunRequest{Period: "2025-09"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC), *source.filter.StartDateTo)
+	assert.Equal(t, time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), *source.filter.ActiveFrom, "employees terminated before the period are left out")
+	assert.Equal(t, "2025-09-30", resp.PaymentDate)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, "Quispe Mamani Ana", resp.Items[0].FullName)
+	assert.Equal(t, "INTEGRA", resp.Items[0].PensionSystem)
+	assert.Equal(t, "****8012", resp.Items[0].BankAccount, "HR_ANALYST sees masked accounts")
+	assert.Equal(t, resp.Items[0].NetPay+resp.Items[1].NetPay, resp.TotalNet)
+	repo.AssertExpectations(t)
+}
+
+func TestCreatePayrollRun_RejectsUnknownPensionSystems(t *testing.T) {
+	source := &employeeSource{records: []employeeRepositories.EmployeeRecord{
+		employeeRecord("emp-1", "Integra", "19312345678012"),
+		employeeRecord("emp-2", "Horizonte", "19312345678012"),
+	}}
+	repo := new(MockPayrollRunRepository)
+	uc := usecases.NewCreatePayrollRunUseCase(source, repo, services.NewPeruvianPayrollCalculator())
+
+	_, err := uc.Execute(context.Background(), usecases.CreatePayrollRunCommand{Data: dto.CreatePayrollRunRequest{Period: "2025-09"}})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, domainErr.HTTPStatusCode)
+	require.Len(t, domainErr.Fields, 1)
+	assert.Equal(t, "employees[emp-2].afp", domainErr.Fields[0].Field)
+	repo.AssertNotCalled(t, "SavePayrollRun", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// DownloadPayslipQuery identifies the payslip to download.
+type DownloadPayslipQuery struct {
+	PayslipID string
+}
+
+// DownloadPayslipUseCase returns the stored payslip document. HR staff download any payslip;
+// employees only their own.
+type DownloadPayslipUseCase struct {
+	payslipRepo repositories.PayslipRepository
+	renderer    services.PayslipRenderer
+}
+
+// NewDownloadPayslipUseCase creates a new DownloadPayslipUseCase.
+func NewDownloadPayslipUseCase(payslipRepo repositories.PayslipRepository, renderer services.PayslipRenderer) *DownloadPayslipUseCase {
+	return &DownloadPayslipUseCase{payslipRepo: payslipRepo, renderer: renderer}
+}
+
+// Execute checks the document against the hash recorded when it was issued, so a tampered
+// document is never handed out as the one the employee signs.
+func (uc *DownloadPayslipUseCase) Execute(ctx context.Context, query DownloadPayslipQuery) (dto.PayslipFileResponse, error) {
+	payslip, err := findPayslip(ctx, uc.payslipRepo, query.PayslipID, security.RoleHRAdmin, security.RoleHRAnalyst)
+	if err != nil {
+		return dto.PayslipFileResponse{}, err
+	}
+	content, err := uc.payslipRepo.GetPayslipContent(ctx, payslip.ID())
+	if err != nil {
+		return dto.PayslipFileResponse{}, fmt.Errorf("error loading payslip content: %w", err)
+	}
+	if !payslip.Matches(content) {
+		return dto.PayslipFileResponse{}, fmt.Errorf("payslip %s does not match its content hash", payslip.ID())
+	}
+	return dto.PayslipFileResponse{
+		FileName:    fmt.Sprintf("boleta-%s-%s.%s", payslip.Period().Compact(), payslip.EmployeeID(), uc.renderer.Extension()),
+		ContentType: uc.renderer.ContentType(),
+		Content:     content,
+		ContentHash: payslip.ContentHash(),
+	}, nil
+}
+
+// findPayslip loads a payslip the principal may access: their own, or any when they hold one of
+// the given roles. Payslips of other employees are reported as not found so that their
+// existence is not disclosed.
+func findPayslip(ctx context.Context, payslipRepo repositories.PayslipRepository, id string, anyPayslipRoles ...security.Role) (*entities.Payslip, error) {
+	payslip, err := payslipRepo.GetPayslipByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error loading payslip: %w", err)
+	}
+	if payslip == nil {
+		return nil, domain.NewNotFoundError("payroll.payslip_not_found", nil)
+	}
+	principal, ok := security.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.NewUnauthorizedError("auth.required", nil)
+	}
+	if !principal.IsEmployee(payslip.EmployeeID()) && !principal.HasAnyRole(anyPayslipRoles...) {
+		return nil, domain.NewNotFoundError("payroll.payslip_not_found", nil)
+	}
+	return payslip, nil
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// ExportAFPnetQuery identifies the payroll run and the AFP whose contribution file is requested.
+type ExportAFPnetQuery struct {
+	RunID string
+	AFP   string // habitat, integra, prima or profuturo
+}
+
+// ExportAFPnetUseCase builds the AFPnet upload file of one AFP from a payroll run: each AFP
+// receives its own declaration with the affiliates whose payslip was calculated under it.
+type ExportAFPnetUseCase struct {
+	payrollRepo repositories.PayrollRunRepository
+	generator   services.AFPnetFileGenerator
+}
+
+// NewExportAFPnetUseCase creates a new ExportAFPnetUseCase.
+func NewExportAFPnetUseCase(payrollRepo repositories.PayrollRunRepository, generator services.AFPnetFileGenerator) *ExportAFPnetUseCase {
+	return &ExportAFPnetUseCase{payrollRepo: payrollRepo, generator: generator}
+}
+
+// Execute checks every affiliate first and only writes the file when all of them can be declared;
+// otherwise every missing CUSPP or unsupported document is reported.
+func (uc *ExportAFPnetUseCase) Execute(ctx context.Context, query ExportAFPnetQuery) (dto.AFPnetFileResponse, error) {
+	afp, err := value_objects.NewPensionSystem(query.AFP)
+	if err != nil || !afp.IsAFP() {
+		return dto.AFPnetFileResponse{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(
+			*domain.NewFieldError("afp", "oneof", "validation.oneof", domain.Params{"values": "habitat, integra, prima, profuturo"}))
+	}
+
+	run, err := uc.payrollRepo.GetPayrollRunByID(ctx, query.RunID)
+	if err != nil {
+		return dto.AFPnetFileResponse{}, fmt.Errorf("error loading payroll run: %w", err)
+	}
+	if run == nil {
+		return dto.AFPnetFileResponse{}, domain.NewNotFoundError("payroll.run_not_found", nil)
+	}
+
+	var affiliates []services.AFPnetAffiliate
+	var fieldErrs []domain.FieldError
+	var totalInsurable float64
+	for _, item := range run.Items() {
+		if item.Employee().PensionSystem != afp {
+			continue
+		}
+		affiliate, errs := services.NewAFPnetAffiliate(run.Period(), item)
+		if len(errs) > 0 {
+			fieldErrs = append(fieldErrs, employeeFieldErrors(item.Employee().EmployeeID, errs)...)
+			continue
+		}
+		affiliates = append(affiliates, affiliate)
+		totalInsurable += affiliate.InsurableWage
+	}
+	if len(fieldErrs) > 0 {
+		return dto.AFPnetFileResponse{}, domain.NewBusinessRuleError("afpnet.validation_failed", nil).
+			WithParams(domain.Params{"count": len(fieldErrs)}).
+			WithFieldErrors(fieldErrs...)
+	}
+	if len(affiliates) == 0 {
+		return dto.AFPnetFileResponse{}, domain.NewBusinessRuleError("afpnet.no_affiliates", nil).
+			WithParams(domain.Params{"afp": string(afp)})
+	}
+
+	content, err := uc.generator.Generate(affiliates)
+	if err != nil {
+		return dto.AFPnetFileResponse{}, fmt.Errorf("error generating AFPnet file: %w", err)
+	}
+	return dto.AFPnetFileResponse{
+		FileName:       fmt.Sprintf("afpnet-%s-%s.%s", run.Period().Compact(), strings.ToLower(string(afp)), uc.generator.Extension()),
+		ContentType:    uc.generator.ContentType(),
+		Content:        content,
+		Affiliates:     len(affiliates),
+		TotalInsurable: entities.RoundAmount(totalInsurable),
+	}, nil
+}
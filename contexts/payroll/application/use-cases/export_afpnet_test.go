@@ -0,0 +1,76 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/afpnet"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+)
+
+func affiliate(id string, system value_objects.PensionSystem, cuspp string, startDate time.Time, endDate *time.Time) *entities.PayrollItem {
+	return entities.NewPayrollItem(entities.EmployeeSnapshot{
+		EmployeeID: id, DocumentType: "DNI", DocumentNumber: "12345678", FirstName: "Ana",
+		LastNamePaternal: "Quispe", LastNameMaternal: "Mamani", PensionSystem: system, CUSPP: cuspp,
+		StartDate: startDate, EndDate: endDate,
+	}, 30, []entities.Concept{
+		{Code: services.ConceptBasicPay, Kind: entities.ConceptIncome, Amount: 3000},
+		{Code: services.ConceptGratification, Kind: entities.ConceptIncome, Amount: 3000},
+		{Code: services.ConceptAFPContribution, Kind: entities.ConceptDeduction, Amount: 300},
+	})
+}
+
+func TestExportAFPnet_DeclaresTheAffiliatesOfOneAFPWithTheirMovements(t *testing.T) {
+	period, _ := value_objects.NewPeriod("2025-09")
+	endDate := time.Date(2025, 9, 20, 0, 0, 0, 0, time.UTC)
+	run := entities.NewPayrollRun(period, period.End())
+	run.AddItem(affiliate("emp-1", value_objects.PensionPrima, "580201AQMMA5", time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), nil))
+	run.AddItem(affiliate("emp-2", value_objects.PensionPrima, "611030JPRZL0", time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC), &endDate))
+	run.AddItem(affiliate("emp-3", value_objects.PensionIntegra, "", time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC), nil))
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(run, nil)
+
+	file, err := usecases.NewExportAFPnetUseCase(repo, afpnet.NewGenerator()).Execute(context.Background(), usecases.ExportAFPnetQuery{RunID: "run-1", AFP: "prima"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "afpnet-202509-prima.xlsx", file.FileName)
+	assert.Equal(t, 2, file.Affiliates)
+	assert.Equal(t, 6000.0, file.TotalInsurable, "the gratification is not subject to contributions")
+	rows, err := spreadsheet.ReadXLSX(file.Content)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"S", "S", "N"}, rows[0][7:10], "hired in the period")
+	assert.Equal(t, []string{"S", "N", "S"}, rows[1][7:10], "terminated in the period")
+}
+
+func TestExportAFPnet_ReportsAffiliatesWithoutCUSPP(t *testing.T) {
+	period, _ := value_objects.NewPeriod("2025-09")
+	run := entities.NewPayrollRun(period, period.End())
+	run.AddItem(affiliate("emp-3", value_objects.PensionIntegra, "", time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC), nil))
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(run, nil)
+	uc := usecases.NewExportAFPnetUseCase(repo, afpnet.NewGenerator())
+
+	_, err := uc.Execute(context.Background(), usecases.ExportAFPnetQuery{RunID: "run-1", AFP: "integra"})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, domainErr.HTTPStatusCode)
+	require.Len(t, domainErr.Fields, 1)
+	assert.Equal(t, "employees[emp-3].cuspp", domainErr.Fields[0].Field)
+
+	_, err = uc.Execute(context.Background(), usecases.ExportAFPnetQuery{RunID: "run-1", AFP: "onp"})
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusBadRequest, domainErr.HTTPStatusCode)
+}
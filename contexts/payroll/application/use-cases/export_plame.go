@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// ExportPlameQuery identifies the payroll run and the PLAME file.
+type ExportPlameQuery struct {
+	RunID string
+	File  string // rem, jor or snl
+}
+
+// ExportPlameUseCase builds a PLAME (PDT 601) import file from a payroll run.
+type ExportPlameUseCase struct {
+	payrollRepo repositories.PayrollRunRepository
+	validator   *services.SunatValidator
+	generator   services.SunatFileGenerator
+	employerRUC string
+}
+
+// NewExportPlameUseCase creates a new ExportPlameUseCase.
+func NewExportPlameUseCase(payrollRepo repositories.PayrollRunRepository, validator *services.SunatValidator, generator services.SunatFileGenerator, employerRUC string) *ExportPlameUseCase {
+	return &ExportPlameUseCase{payrollRepo: payrollRepo, validator: validator, generator: generator, employerRUC: employerRUC}
+}
+
+// Execute checks the document type and the concept codes of every payslip before writing the file.
+func (uc *ExportPlameUseCase) Execute(ctx context.Context, query ExportPlameQuery) (dto.SunatFileResponse, error) {
+	file := services.SunatFile(query.File)
+	if !file.IsPlame() {
+		return dto.SunatFileResponse{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(
+			*domain.NewFieldError("file", "oneof", "validation.oneof", domain.Params{"values": "rem, jor, snl"}))
+	}
+	if !value_objects.ValidRUC(uc.employerRUC) {
+		return dto.SunatFileResponse{}, domain.NewBusinessRuleError("sunat.employer_ruc_invalid", nil)
+	}
+
+	run, err := uc.payrollRepo.GetPayrollRunByID(ctx, query.RunID)
+	if err != nil {
+		return dto.SunatFileResponse{}, fmt.Errorf("error loading payroll run: %w", err)
+	}
+	if run == nil {
+		return dto.SunatFileResponse{}, domain.NewNotFoundError("payroll.run_not_found", nil)
+	}
+
+	var payslips []services.SunatPayslip
+	var fieldErrs []domain.FieldError
+	for _, item := range run.Items() {
+		payslip, errs := uc.validator.ValidatePayslip(item)
+		if len(errs) > 0 {
+			fieldErrs = append(fieldErrs, employeeFieldErrors(item.Employee().EmployeeID, errs)...)
+			continue
+		}
+		payslips = append(payslips, payslip)
+	}
+	if len(fieldErrs) > 0 {
+		return dto.SunatFileResponse{}, domain.NewBusinessRuleError("sunat.validation_failed", nil).
+			WithParams(domain.Params{"count": len(fieldErrs)}).
+			WithFieldErrors(fieldErrs...)
+	}
+
+	content, err := uc.generator.Plame(file, payslips)
+	if err != nil {
+		return dto.SunatFileResponse{}, fmt.Errorf("error generating PLAME file: %w", err)
+	}
+	return dto.SunatFileResponse{
+		FileName: fmt.Sprintf("0601%s%s.%s", run.Period().Compact(), uc.employerRUC, file),
+		Content:  content,
+		Records:  len(payslips),
+	}, nil
+}
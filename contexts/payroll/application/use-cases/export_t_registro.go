@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	employeeRepositories "github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	sharedValueObjects "github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// ExportTRegistroQuery selects the T-Registro file and the hiring dates of the workers to declare.
+type ExportTRegistroQuery struct {
+	File   string // ide, tra or per
+	Filter dto.TRegistroRequest
+}
+
+// ExportTRegistroUseCase builds a T-Registro import file from the current persons and employees.
+type ExportTRegistroUseCase struct {
+	employeeRepo employeeRepositories.EmployeeRepository
+	validator    *services.SunatValidator
+	generator    services.SunatFileGenerator
+	employerRUC  string
+}
+
+// NewExportTRegistroUseCase creates a new ExportTRegistroUseCase.
+func NewExportTRegistroUseCase(employeeRepo employeeRepositories.EmployeeRepository, validator *services.SunatValidator, generator services.SunatFileGenerator, employerRUC string) *ExportTRegistroUseCase {
+	return &ExportTRegistroUseCase{employeeRepo: employeeRepo, validator: validator, generator: generator, employerRUC: employerRUC}
+}
+
+// Execute validates every worker against the SUNAT tables first and only writes the file when
+// all of them can be declared; otherwise every rejected field of every worker is reported.
+func (uc *ExportTRegistroUseCase) Execute(ctx context.Context, query ExportTRegistroQuery) (dto.SunatFileResponse, error) {
+	file := services.SunatFile(query.File)
+	if !file.IsTRegistro() {
+		return dto.SunatFileResponse{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(
+			*domain.NewFieldError("file", "oneof", "validation.oneof", domain.Params{"values": "ide, tra, per"}))
+	}
+	if !value_objects.ValidRUC(uc.employerRUC) {
+		return dto.SunatFileResponse{}, domain.NewBusinessRuleError("sunat.employer_ruc_invalid", nil)
+	}
+
+	filter := employeeRepositories.EmployeeFilter{PersonType: sharedValueObjects.Natural}
+	if query.Filter.StartDateFrom != "" {
+		from, _ := time.Parse("2006-01-02", query.Filter.StartDateFrom)
+		filter.StartDateFrom = &from
+	}
+	if query.Filter.StartDateTo != "" {
+		to, _ := time.Parse("2006-01-02", query.Filter.StartDateTo)
+		filter.StartDateTo = &to
+	}
+
+	var workers []services.SunatWorker
+	var fieldErrs []domain.FieldError
+	err := uc.employeeRepo.StreamEmployees(ctx, filter, func(record employeeRepositories.EmployeeRecord) error {
+		worker, errs := uc.validator.ValidateWorker(workerData(record))
+		if len(errs) > 0 {
+			fieldErrs = append(fieldErrs, employeeFieldErrors(record.Employee.ID(), errs)...)
+			return nil
+		}
+		workers = append(workers, worker)
+		return nil
+	})
+	if err != nil {
+		return dto.SunatFileResponse{}, fmt.Errorf("error reading employees: %w", err)
+	}
+	if len(fieldErrs) > 0 {
+		return dto.SunatFileResponse{}, domain.NewBusinessRuleError("sunat.validation_failed", nil).
+			WithParams(domain.Params{"count": len(fieldErrs)}).
+			WithFieldErrors(fieldErrs...)
+	}
+
+	content, err := uc.generator.TRegistro(file, workers)
+	if err != nil {
+		return dto.SunatFileResponse{}, fmt.Errorf("error generating T-Registro file: %w", err)
+	}
+	return dto.SunatFileResponse{
+		FileName: fmt.Sprintf("RP_%s.%s", uc.employerRUC, file),
+		Content:  content,
+		Records:  len(workers),
+	}, nil
+}
+
+// workerData takes what T-Registro needs from the employee and its natural person.
+func workerData(record employeeRepositories.EmployeeRecord) services.SunatWorkerData {
+	employee := record.Employee
+	data := services.SunatWorkerData{
+		EmployeeID:   employee.ID(),
+		Phone:        string(record.Person.Person.Phone),
+		Email:        string(record.Person.Person.Email),
+		Street:       record.Person.Person.Address,
+		ContractType: employee.ContractType(),
+		StartDate:    employee.StartDate(),
+		BaseSalary:   employee.Salary(),
+		AFP:          employee.AFP(),
+		CUSPP:        employee.CUSPP(),
+		EPS:          employee.EPS(),
+	}
+	if np := record.Person.NaturalPerson; np != nil {
+		data.DocumentType = string(np.DocumentType)
+		data.DocumentNumber = np.DocumentNumber
+		data.FirstName = np.FirstName
+		data.LastNamePaternal = np.LastNamePaternal
+		data.LastNameMaternal = np.LastNameMaternal
+		data.BirthDate = np.BirthDate
+		data.Gender = np.Gender
+		data.Nationality = np.Nationality
+	}
+	if address := record.Person.Address; address != nil {
+		data.Street = address.Street
+		data.Ubigeo = string(address.Ubigeo)
+	}
+	return data
+}
+
+// employeeFieldErrors prefixes each field with the employee it belongs to.
+func employeeFieldErrors(employeeID string, errs []domain.FieldError) []domain.FieldError {
+	prefixed := make([]domain.FieldError, len(errs))
+	for i, fieldErr := range errs {
+		fieldErr.Field = fmt.Sprintf("employees[%s].%s", employeeID, fieldErr.Field)
+		prefixed[i] = fieldErr
+	}
+	return prefixed
+}
@@ -0,0 +1,63 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	employeeRepositories "github.com/kevinsoras/employee-management/contexts/employee/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/sunatfiles"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	shared_vo "github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+func newExportTRegistro(source *employeeSource, ruc string) *usecases.ExportTRegistroUseCase {
+	return usecases.NewExportTRegistroUseCase(source, services.NewSunatValidator(), sunatfiles.NewGenerator(), ruc)
+}
+
+func TestExportTRegistro_WritesNaturalPersonsOnly(t *testing.T) {
+	source := &employeeSource{records: []employeeRepositories.EmployeeRecord{employeeRecord("emp-1", "AFP Prima", "19312345678012")}}
+
+	res, err := newExportTRegistro(source, "20123456786").Execute(context.Background(), usecases.ExportTRegistroQuery{
+		File:   "ide",
+		Filter: dto.TRegistroRequest{StartDateTo: "2024-12-31"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, shared_vo.Natural, source.filter.PersonType)
+	assert.Equal(t, "2024-12-31", source.filter.StartDateTo.Format("2006-01-02"))
+	assert.Equal(t, "RP_20123456786.ide", res.FileName)
+	assert.Equal(t, 1, res.Records)
+	assert.True(t, strings.HasPrefix(string(res.Content), "01|12345678||9589|01/03/1990|QUISPE|MAMANI|ANA|2|"))
+}
+
+func TestExportTRegistro_ReportsUnmappedCodesPerEmployee(t *testing.T) {
+	source := &employeeSource{records: []employeeRepositories.EmployeeRecord{
+		employeeRecord("emp-1", "AFP Prima", "19312345678012"),
+		employeeRecord("emp-2", "Horizonte", "19312345678012"),
+	}}
+
+	_, err := newExportTRegistro(source, "20123456786").Execute(context.Background(), usecases.ExportTRegistroQuery{File: "per"})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, domainErr.HTTPStatusCode)
+	assert.Equal(t, "sunat.validation_failed", domainErr.MessageKey)
+	require.Len(t, domainErr.Fields, 1)
+	assert.Equal(t, "employees[emp-2].afp", domainErr.Fields[0].Field)
+}
+
+func TestExportTRegistro_RequiresAValidEmployerRUC(t *testing.T) {
+	_, err := newExportTRegistro(&employeeSource{}, "20123456780").Execute(context.Background(), usecases.ExportTRegistroQuery{File: "tra"})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "sunat.employer_ruc_invalid", domainErr.MessageKey)
+}
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// GenerateBankFileQuery identifies the payroll run and the bank format ("bcp", "interbank", "bbva", "cci").
+type GenerateBankFileQuery struct {
+	RunID string
+	Bank  string
+}
+
+// GenerateBankFileUseCase builds the salary transfer file of a payroll run in a bank's format.
+type GenerateBankFileUseCase struct {
+	payrollRepo   repositories.PayrollRunRepository
+	generators    map[value_objects.Bank]services.BankFileGenerator
+	debitAccounts map[value_objects.Bank]string
+}
+
+// NewGenerateBankFileUseCase creates a new GenerateBankFileUseCase. debitAccounts holds the
+// company account charged in each bank; the generic CCI format does not need one.
+func NewGenerateBankFileUseCase(payrollRepo repositories.PayrollRunRepository, debitAccounts map[value_objects.Bank]string, generators ...services.BankFileGenerator) *GenerateBankFileUseCase {
+	byBank := make(map[value_objects.Bank]services.BankFileGenerator, len(generators))
+	for _, generator := range generators {
+		byBank[generator.Bank()] = generator
+	}
+	return &GenerateBankFileUseCase{payrollRepo: payrollRepo, generators: byBank, debitAccounts: debitAccounts}
+}
+
+// Execute validates every account before generating anything: the file is produced only when all
+// the employees with net pay can be paid, otherwise each rejected account is reported.
+func (uc *GenerateBankFileUseCase) Execute(ctx context.Context, query GenerateBankFileQuery) (dto.BankFileResponse, error) {
+	bank := value_objects.Bank(strings.ToUpper(query.Bank))
+	generator, ok := uc.generators[bank]
+	if !ok {
+		return dto.BankFileResponse{}, domain.NewInvalidInputError("validation.failed", nil).WithFieldErrors(
+			*domain.NewFieldError("bank", "oneof", "validation.oneof", domain.Params{"values": "bcp, interbank, bbva, cci"}))
+	}
+
+	run, err := uc.payrollRepo.GetPayrollRunByID(ctx, query.RunID)
+	if err != nil {
+		return dto.BankFileResponse{}, fmt.Errorf("error loading payroll run: %w", err)
+	}
+	if run == nil {
+		return dto.BankFileResponse{}, domain.NewNotFoundError("payroll.run_not_found", nil)
+	}
+
+	batch := services.PaymentBatch{
+		Bank:        bank,
+		PaymentDate: run.PaymentDate(),
+		Reference:   "HABERES " + run.Period().Compact(),
+	}
+	if bank != value_objects.BankGeneric {
+		debit, err := value_objects.ParseBankAccount(bank, uc.debitAccounts[bank])
+		if err != nil || debit.Kind == value_objects.AccountCCI {
+			return dto.BankFileResponse{}, domain.NewBusinessRuleError("payroll.debit_account_invalid", err).
+				WithParams(domain.Params{"bank": string(bank)})
+		}
+		batch.DebitAccount = debit.Number
+	}
+
+	var fieldErrs []domain.FieldError
+	for _, item := range run.Items() {
+		amount := int64(math.Round(item.NetPay() * 100))
+		if amount <= 0 {
+			continue
+		}
+		employee := item.Employee()
+		account, err := value_objects.ParseBankAccount(bank, employee.BankAccount)
+		if err != nil {
+			var fieldErr *domain.FieldError
+			if !errors.As(err, &fieldErr) {
+				return dto.BankFileResponse{}, err
+			}
+			rejected := *fieldErr
+			rejected.Field = fmt.Sprintf("employees[%s].bankAccount", employee.EmployeeID)
+			fieldErrs = append(fieldErrs, rejected)
+			continue
+		}
+		batch.Payments = append(batch.Payments, services.Payment{
+			EmployeeID:     employee.EmployeeID,
+			DocumentType:   employee.DocumentType,
+			DocumentNumber: employee.DocumentNumber,
+			Name:           employee.FullName,
+			Account:        account,
+			AmountCents:    amount,
+		})
+	}
+	if len(fieldErrs) > 0 {
+		return dto.BankFileResponse{}, domain.NewBusinessRuleError("payroll.bank_accounts_invalid", nil).
+			WithParams(domain.Params{"count": len(fieldErrs)}).
+			WithFieldErrors(fieldErrs...)
+	}
+	if len(batch.Payments) == 0 {
+		return dto.BankFileResponse{}, domain.NewBusinessRuleError("payroll.nothing_to_pay", nil)
+	}
+
+	content, err := generator.Generate(batch)
+	if err != nil {
+		return dto.BankFileResponse{}, fmt.Errorf("error generating %s file: %w", bank, err)
+	}
+	return dto.BankFileResponse{
+		FileName:    fmt.Sprintf("haberes-%s-%s.%s", run.Period().Compact(), strings.ToLower(string(bank)), generator.Extension()),
+		ContentType: "text/plain; charset=us-ascii",
+		Content:     content,
+		Payments:    len(batch.Payments),
+		TotalAmount: float64(batch.TotalCents()) / 100,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/bankfiles"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+)
+
+func payrollRun(accounts ...string) *entities.PayrollRun {
+	period, _ := value_objects.NewPeriod("2025-09")
+	run := entities.NewPayrollRun(period, period.End())
+	for i, account := range accounts {
+		run.AddItem(entities.NewPayrollItem(entities.EmployeeSnapshot{
+			EmployeeID: []string{"emp-1", "emp-2", "emp-3"}[i], DocumentType: "DNI", DocumentNumber: "12345678",
+			FullName: "Quispe Mamani Ana", BankAccount: account, PensionSystem: value_objects.PensionONP,
+		}, 30, []entities.Concept{
+			{Code: services.ConceptBasicPay, Kind: entities.ConceptIncome, Amount: 3000},
+			{Code: services.ConceptONP, Kind: entities.ConceptDeduction, Amount: 390},
+		}))
+	}
+	return run
+}
+
+func bankFileUseCase(repo *MockPayrollRunRepository) *usecases.GenerateBankFileUseCase {
+	return usecases.NewGenerateBankFileUseCase(repo,
+		map[value_objects.Bank]string{value_objects.BankBCP: "1931234567012"},
+		bankfiles.NewBCPGenerator(), bankfiles.NewCCIGenerator())
+}
+
+func TestGenerateBankFile_ReturnsFileWithBatchTotals(t *testing.T) {
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(payrollRun("19312345678012", "00310000456789123451"), nil)
+
+	file, err := bankFileUseCase(repo).Execute(context.Background(), usecases.GenerateBankFileQuery{RunID: "run-1", Bank: "bcp"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "haberes-202509-bcp.txt", file.FileName)
+	assert.Equal(t, 2, file.Payments)
+	assert.Equal(t, 5220.0, file.TotalAmount)
+	assert.Contains(t, string(file.Content), "HABERES 202509")
+}
+
+func TestGenerateBankFile_ReportsEveryInvalidAccount(t *testing.T) {
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(payrollRun("19312345678012", "", "00310000456789123450"), nil)
+
+	_, err := bankFileUseCase(repo).Execute(context.Background(), usecases.GenerateBankFileQuery{RunID: "run-1", Bank: "cci"})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "payroll.bank_accounts_invalid", domainErr.MessageKey)
+	require.Len(t, domainErr.Fields, 3)
+	assert.Equal(t, "employees[emp-1].bankAccount", domainErr.Fields[0].Field)
+	assert.Equal(t, "bank_account", domainErr.Fields[0].Rule, "own accounts are not accepted in the generic CCI file")
+	assert.Equal(t, "required", domainErr.Fields[1].Rule)
+	assert.Equal(t, "checksum", domainErr.Fields[2].Rule)
+}
+
+func TestGenerateBankFile_RequiresDebitAccountAndKnownBank(t *testing.T) {
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(payrollRun("19312345678012"), nil)
+	uc := usecases.NewGenerateBankFileUseCase(repo, nil, bankfiles.NewBCPGenerator())
+
+	_, err := uc.Execute(context.Background(), usecases.GenerateBankFileQuery{RunID: "run-1", Bank: "bcp"})
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "payroll.debit_account_invalid", domainErr.MessageKey)
+
+	_, err = uc.Execute(context.Background(), usecases.GenerateBankFileQuery{RunID: "run-1", Bank: "scotiabank"})
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusBadRequest, domainErr.HTTPStatusCode)
+}
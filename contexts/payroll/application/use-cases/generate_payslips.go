@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	sharedRepositories "github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// rucDocumentType is the document type under which juridical persons are registered.
+const rucDocumentType = "RUC"
+
+// GeneratePayslipsCommand identifies the payroll run whose payslips are issued.
+type GeneratePayslipsCommand struct {
+	RunID string
+}
+
+// GeneratePayslipsUseCase issues the payslip document of every employee of a payroll run. The
+// employer header comes from the juridical person registered with the employer RUC, and the
+// employee data from the snapshot taken when the payroll was calculated.
+type GeneratePayslipsUseCase struct {
+	payrollRepo repositories.PayrollRunRepository
+	payslipRepo repositories.PayslipRepository
+	personRepo  sharedRepositories.PersonRepository
+	renderer    services.PayslipRenderer
+	employerRUC string
+}
+
+// NewGeneratePayslipsUseCase creates a new GeneratePayslipsUseCase.
+func NewGeneratePayslipsUseCase(payrollRepo repositories.PayrollRunRepository, payslipRepo repositories.PayslipRepository, personRepo sharedRepositories.PersonRepository, renderer services.PayslipRenderer, employerRUC string) *GeneratePayslipsUseCase {
+	return &GeneratePayslipsUseCase{payrollRepo: payrollRepo, payslipRepo: payslipRepo, personRepo: personRepo, renderer: renderer, employerRUC: employerRUC}
+}
+
+// Execute only issues the payslips that are missing, so it can be repeated after new payslips are
+// added without replacing the documents employees may already have acknowledged.
+func (uc *GeneratePayslipsUseCase) Execute(ctx context.Context, cmd GeneratePayslipsCommand) (dto.PayslipListResponse, error) {
+	run, err := uc.payrollRepo.GetPayrollRunByID(ctx, cmd.RunID)
+	if err != nil {
+		return dto.PayslipListResponse{}, fmt.Errorf("error loading payroll run: %w", err)
+	}
+	if run == nil {
+		return dto.PayslipListResponse{}, domain.NewNotFoundError("payroll.run_not_found", nil)
+	}
+	employer, err := uc.employer(ctx)
+	if err != nil {
+		return dto.PayslipListResponse{}, err
+	}
+
+	payslips, err := uc.payslipRepo.ListPayslipsByRun(ctx, run.ID())
+	if err != nil {
+		return dto.PayslipListResponse{}, fmt.Errorf("error loading payslips: %w", err)
+	}
+	issued := make(map[string]bool, len(payslips))
+	for _, payslip := range payslips {
+		issued[payslip.ItemID()] = true
+	}
+
+	generated := 0
+	for _, item := range run.Items() {
+		if issued[item.ID()] {
+			continue
+		}
+		content, err := uc.renderer.Render(services.NewPayslipDocument(employer, run, item))
+		if err != nil {
+			return dto.PayslipListResponse{}, fmt.Errorf("error rendering payslip of employee %s: %w", item.Employee().EmployeeID, err)
+		}
+		payslip := entities.NewPayslip(run, item, content)
+		if err := uc.payslipRepo.SavePayslip(ctx, payslip, content); err != nil {
+			return dto.PayslipListResponse{}, fmt.Errorf("error saving payslip: %w", err)
+		}
+		payslips = append(payslips, payslip)
+		generated++
+	}
+	return dto.NewPayslipListResponse(payslips, generated), nil
+}
+
+// employer reads the header of the payslips from the juridical person of the employer RUC.
+func (uc *GeneratePayslipsUseCase) employer(ctx context.Context) (services.PayslipEmployer, error) {
+	person, err := uc.personRepo.GetPersonByDocument(ctx, rucDocumentType, uc.employerRUC)
+	if err != nil {
+		return services.PayslipEmployer{}, fmt.Errorf("error loading employer: %w", err)
+	}
+	if person == nil || person.JuridicalPerson == nil {
+		return services.PayslipEmployer{}, domain.NewBusinessRuleError("payroll.employer_not_registered", nil).
+			WithParams(domain.Params{"ruc": uc.employerRUC})
+	}
+	employer := services.PayslipEmployer{
+		RUC:          person.JuridicalPerson.DocumentNumber,
+		BusinessName: person.JuridicalPerson.BusinessName,
+		Address:      person.Person.Address,
+	}
+	if address := person.Address; address != nil && address.Street != "" {
+		employer.Address = address.Street
+	}
+	return employer, nil
+}
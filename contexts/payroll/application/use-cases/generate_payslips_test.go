@@ -0,0 +1,181 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/payslippdf"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	sharedEntities "github.com/kevinsoras/employee-management/shared/domain/entities"
+	sharedRepositories "github.com/kevinsoras/employee-management/shared/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// payslipStore keeps the issued payslips in memory
+type payslipStore struct {
+	payslips map[string]*entities.Payslip
+	contents map[string][]byte
+}
+
+func newPayslipStore() *payslipStore {
+	return &payslipStore{payslips: map[string]*entities.Payslip{}, contents: map[string][]byte{}}
+}
+
+func (s *payslipStore) SavePayslip(_ context.Context, payslip *entities.Payslip, content []byte) error {
+	s.payslips[payslip.ID()] = payslip
+	s.contents[payslip.ID()] = content
+	return nil
+}
+
+func (s *payslipStore) ListPayslipsByRun(_ context.Context, runID string) ([]*entities.Payslip, error) {
+	var payslips []*entities.Payslip
+	for _, payslip := range s.payslips {
+		if payslip.RunID() == runID {
+			payslips = append(payslips, payslip)
+		}
+	}
+	return payslips, nil
+}
+
+func (s *payslipStore) GetPayslipByID(_ context.Context, id string) (*entities.Payslip, error) {
+	return s.payslips[id], nil
+}
+
+func (s *payslipStore) GetPayslipContent(_ context.Context, id string) ([]byte, error) {
+	return s.contents[id], nil
+}
+
+func (s *payslipStore) SaveAcknowledgement(_ context.Context, payslip *entities.Payslip) error {
+	s.payslips[payslip.ID()] = payslip
+	return nil
+}
+
+// employerSource returns the employer; payslips only read persons by document
+type employerSource struct {
+	sharedRepositories.PersonRepository
+	employer *aggregates.PersonAggregate
+}
+
+func (s *employerSource) GetPersonByDocument(_ context.Context, documentType, documentNumber string) (*aggregates.PersonAggregate, error) {
+	if s.employer == nil || documentType != "RUC" || documentNumber != s.employer.JuridicalPerson.DocumentNumber {
+		return nil, nil
+	}
+	return s.employer, nil
+}
+
+func employerPerson() *aggregates.PersonAggregate {
+	person := &sharedEntities.Person{ID: "per-1", Address: "Av. Arequipa 123, Lima"}
+	return aggregates.NewPersonAggregate(person, nil, &sharedEntities.JuridicalPerson{
+		PersonID: "per-1", DocumentNumber: "20123456789", BusinessName: "Andes Servicios S.A.C.",
+	})
+}
+
+func payslipRun() *entities.PayrollRun {
+	period, _ := value_objects.NewPeriod("2025-09")
+	run := entities.NewPayrollRun(period, period.End())
+	run.AddItem(affiliate("emp-1", value_objects.PensionPrima, "580201AQMMA5", time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC), nil))
+	run.AddItem(affiliate("emp-2", value_objects.PensionPrima, "611030JPRZL0", time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), nil))
+	return run
+}
+
+func TestGeneratePayslips_IssuesOnlyTheMissingPayslips(t *testing.T) {
+	run := payslipRun()
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(run, nil)
+	store := newPayslipStore()
+	issued := entities.NewPayslip(run, run.Items()[0], []byte("already issued"))
+	_ = store.SavePayslip(context.Background(), issued, []byte("already issued"))
+	uc := usecases.NewGeneratePayslipsUseCase(repo, store, &employerSource{employer: employerPerson()}, payslippdf.NewRenderer(), "20123456789")
+
+	resp, err := uc.Execute(context.Background(), usecases.GeneratePayslipsCommand{RunID: "run-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Generated)
+	require.Len(t, resp.Payslips, 2)
+	assert.Equal(t, issued.ContentHash(), store.payslips[issued.ID()].ContentHash(), "the issued payslip is kept")
+	created := resp.Payslips[1]
+	assert.Equal(t, "emp-2", created.EmployeeID)
+	assert.Equal(t, "2025-09", created.Period)
+	assert.Equal(t, entities.ContentHash(store.contents[created.ID]), created.ContentHash)
+	assert.Contains(t, string(store.contents[created.ID]), "(Andes Servicios S.A.C.)")
+}
+
+func TestGeneratePayslips_RequiresTheEmployerAsJuridicalPerson(t *testing.T) {
+	repo := new(MockPayrollRunRepository)
+	repo.On("GetPayrollRunByID", mock.Anything, "run-1").Return(payslipRun(), nil)
+	uc := usecases.NewGeneratePayslipsUseCase(repo, newPayslipStore(), &employerSource{}, payslippdf.NewRenderer(), "20123456789")
+
+	_, err := uc.Execute(context.Background(), usecases.GeneratePayslipsCommand{RunID: "run-1"})
+
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, domainErr.HTTPStatusCode)
+	assert.Equal(t, "payroll.employer_not_registered", domainErr.MessageKey)
+}
+
+func TestDownloadPayslip_EmployeesOnlyDownloadTheirOwnPayslips(t *testing.T) {
+	run := payslipRun()
+	store := newPayslipStore()
+	content := []byte("%PDF-1.4 boleta")
+	payslip := entities.NewPayslip(run, run.Items()[0], content)
+	_ = store.SavePayslip(context.Background(), payslip, content)
+	uc := usecases.NewDownloadPayslipUseCase(store, payslippdf.NewRenderer())
+	query := usecases.DownloadPayslipQuery{PayslipID: payslip.ID()}
+
+	own := security.WithPrincipal(context.Background(), &security.Principal{UserID: "u-1", Roles: []security.Role{security.RoleEmployee}, EmployeeID: "emp-1"})
+	file, err := uc.Execute(own, query)
+	require.NoError(t, err)
+	assert.Equal(t, content, file.Content)
+	assert.Equal(t, payslip.ContentHash(), file.ContentHash)
+	assert.Equal(t, "boleta-202509-emp-1.pdf", file.FileName)
+
+	other := security.WithPrincipal(context.Background(), &security.Principal{UserID: "u-2", Roles: []security.Role{security.RoleEmployee}, EmployeeID: "emp-2"})
+	_, err = uc.Execute(other, query)
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, http.StatusNotFound, domainErr.HTTPStatusCode)
+
+	hr := security.WithPrincipal(context.Background(), &security.Principal{UserID: "u-3", Roles: []security.Role{security.RoleHRAnalyst}})
+	_, err = uc.Execute(hr, query)
+	require.NoError(t, err)
+
+	store.contents[payslip.ID()] = []byte("%PDF-1.4 tampered")
+	_, err = uc.Execute(hr, query)
+	require.Error(t, err, "a document that does not match its hash is not handed out")
+}
+
+func TestAcknowledgePayslip_RecordsTheFirstAcknowledgementOfTheIssuedDocument(t *testing.T) {
+	run := payslipRun()
+	store := newPayslipStore()
+	content := []byte("%PDF-1.4 boleta")
+	payslip := entities.NewPayslip(run, run.Items()[0], content)
+	_ = store.SavePayslip(context.Background(), payslip, content)
+	uc := usecases.NewAcknowledgePayslipUseCase(store)
+	ctx := security.WithPrincipal(context.Background(), &security.Principal{UserID: "u-1", Roles: []security.Role{security.RoleEmployee}, EmployeeID: "emp-1"})
+
+	_, err := uc.Execute(ctx, usecases.AcknowledgePayslipCommand{PayslipID: payslip.ID()})
+	var domainErr *sharedDomain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "payroll.payslip_hash_mismatch", domainErr.MessageKey)
+
+	cmd := usecases.AcknowledgePayslipCommand{PayslipID: payslip.ID()}
+	cmd.Data.ContentHash = payslip.ContentHash()
+	first, err := uc.Execute(ctx, cmd)
+	require.NoError(t, err)
+	require.NotNil(t, first.AcknowledgedAt)
+	assert.Equal(t, "u-1", first.AcknowledgedBy)
+
+	second, err := uc.Execute(ctx, cmd)
+	require.NoError(t, err)
+	assert.Equal(t, first.AcknowledgedAt, second.AcknowledgedAt)
+}
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// GetPayrollRunQuery identifies the payroll run to read.
+type GetPayrollRunQuery struct {
+	RunID string
+}
+
+// GetPayrollRunUseCase reads a payroll run with its payslips.
+type GetPayrollRunUseCase struct {
+	payrollRepo repositories.PayrollRunRepository
+}
+
+// NewGetPayrollRunUseCase creates a new GetPayrollRunUseCase.
+func NewGetPayrollRunUseCase(payrollRepo repositories.PayrollRunRepository) *GetPayrollRunUseCase {
+	return &GetPayrollRunUseCase{payrollRepo: payrollRepo}
+}
+
+// Execute loads the run and masks what the caller's roles cannot see.
+func (uc *GetPayrollRunUseCase) Execute(ctx context.Context, query GetPayrollRunQuery) (dto.PayrollRunResponse, error) {
+	run, err := uc.payrollRepo.GetPayrollRunByID(ctx, query.RunID)
+	if err != nil {
+		return dto.PayrollRunResponse{}, fmt.Errorf("error loading payroll run: %w", err)
+	}
+	if run == nil {
+		return dto.PayrollRunResponse{}, domain.NewNotFoundError("payroll.run_not_found", nil)
+	}
+	return dto.NewPayrollRunResponse(run, masking.ViewerFromContext(ctx)), nil
+}
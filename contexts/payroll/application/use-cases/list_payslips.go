@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// ListPayslipsQuery identifies the payroll run whose payslips are listed.
+type ListPayslipsQuery struct {
+	RunID string
+}
+
+// ListPayslipsUseCase lists the payslips issued for a payroll run and who has acknowledged them.
+type ListPayslipsUseCase struct {
+	payrollRepo repositories.PayrollRunRepository
+	payslipRepo repositories.PayslipRepository
+}
+
+// NewListPayslipsUseCase creates a new ListPayslipsUseCase.
+func NewListPayslipsUseCase(payrollRepo repositories.PayrollRunRepository, payslipRepo repositories.PayslipRepository) *ListPayslipsUseCase {
+	return &ListPayslipsUseCase{payrollRepo: payrollRepo, payslipRepo: payslipRepo}
+}
+
+// Execute fails with NOT_FOUND when the run does not exist, so an empty list always means that
+// no payslip was issued yet.
+func (uc *ListPayslipsUseCase) Execute(ctx context.Context, query ListPayslipsQuery) (dto.PayslipListResponse, error) {
+	run, err := uc.payrollRepo.GetPayrollRunByID(ctx, query.RunID)
+	if err != nil {
+		return dto.PayslipListResponse{}, fmt.Errorf("error loading payroll run: %w", err)
+	}
+	if run == nil {
+		return dto.PayslipListResponse{}, domain.NewNotFoundError("payroll.run_not_found", nil)
+	}
+	payslips, err := uc.payslipRepo.ListPayslipsByRun(ctx, run.ID())
+	if err != nil {
+		return dto.PayslipListResponse{}, fmt.Errorf("error loading payslips: %w", err)
+	}
+	return dto.NewPayslipListResponse(payslips, 0), nil
+}
@@ -0,0 +1,16 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+)
+
+// PayrollRunDataSource define el contrato para fuentes de datos de planillas
+// (solo interfaz, sin implementación)
+type PayrollRunDataSource interface {
+	// SavePayrollRun guarda el cálculo con sus boletas; falla con ALREADY_EXISTS si el periodo ya se calculó
+	SavePayrollRun(ctx context.Context, run *entities.PayrollRun) error
+	// GetPayrollRunByID devuelve el cálculo con sus boletas, o nil si no existe
+	GetPayrollRunByID(ctx context.Context, id string) (*entities.PayrollRun, error)
+}
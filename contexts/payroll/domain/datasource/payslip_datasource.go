@@ -0,0 +1,22 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+)
+
+// PayslipDataSource define el contrato para fuentes de datos de boletas emitidas
+// (solo interfaz, sin implementación)
+type PayslipDataSource interface {
+	// SavePayslip guarda la boleta con su documento; falla con ALREADY_EXISTS si ya se emitió
+	SavePayslip(ctx context.Context, payslip *entities.Payslip, content []byte) error
+	// ListPayslipsByRun devuelve las boletas emitidas de un cálculo, sin su documento
+	ListPayslipsByRun(ctx context.Context, runID string) ([]*entities.Payslip, error)
+	// GetPayslipByID devuelve la boleta sin su documento, o nil si no existe
+	GetPayslipByID(ctx context.Context, id string) (*entities.Payslip, error)
+	// GetPayslipContent devuelve el documento de la boleta, o nil si no existe
+	GetPayslipContent(ctx context.Context, id string) ([]byte, error)
+	// SaveAcknowledgement registra la constancia de recepción de la boleta
+	SaveAcknowledgement(ctx context.Context, payslip *entities.Payslip) error
+}
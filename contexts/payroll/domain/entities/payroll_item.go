@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+// ConceptKind - a qué columna de la boleta pertenece un concepto
+type ConceptKind string
+
+const (
+	ConceptIncome    ConceptKind = "INCOME"    // ingreso del trabajador
+	ConceptDeduction ConceptKind = "DEDUCTION" // descuento al trabajador
+	ConceptEmployer  ConceptKind = "EMPLOYER"  // aporte del empleador, no se descuenta
+)
+
+// Concept - línea de la boleta. Code es el código de la Tabla 22 de SUNAT (PLAME).
+type Concept struct {
+	Code        string      `json:"code"`
+	Description string      `json:"description"`
+	Kind        ConceptKind `json:"kind"`
+	Amount      float64     `json:"amount"`
+}
+
+// EmployeeSnapshot - datos del trabajador tal como estaban al calcular la planilla.
+// Los nombres también se guardan por separado porque AFPnet los declara así; quedan vacíos para
+// una persona jurídica.
+type EmployeeSnapshot struct {
+	EmployeeID       string
+	PersonID         string
+	DocumentType     string
+	DocumentNumber   string
+	FullName         string
+	FirstName        string
+	LastNamePaternal string
+	LastNameMaternal string
+	BankAccount      string
+	PensionSystem    value_objects.PensionSystem
+	CUSPP            string
+	ContractType     string
+	BaseSalary       float64
+	StartDate        time.Time
+	EndDate          *time.Time
+}
+
+// PayrollItem - boleta de un trabajador dentro de un cálculo de planilla
+type PayrollItem struct {
+	id         string
+	runID      string
+	employee   EmployeeSnapshot
+	daysWorked int
+	concepts   []Concept
+}
+
+// NewPayrollItem crea la boleta con los conceptos calculados.
+func NewPayrollItem(employee EmployeeSnapshot, daysWorked int, concepts []Concept) *PayrollItem {
+	return &PayrollItem{id: uuid.New().String(), employee: employee, daysWorked: daysWorked, concepts: concepts}
+}
+
+// RestorePayrollItem reconstruye una boleta guardada.
+func RestorePayrollItem(id, runID string, employee EmployeeSnapshot, daysWorked int, concepts []Concept) *PayrollItem {
+	return &PayrollItem{id: id, runID: runID, employee: employee, daysWorked: daysWorked, concepts: concepts}
+}
+
+func (i *PayrollItem) ID() string {
+	return i.id
+}
+
+func (i *PayrollItem) RunID() string {
+	return i.runID
+}
+
+func (i *PayrollItem) Employee() EmployeeSnapshot {
+	return i.employee
+}
+
+func (i *PayrollItem) DaysWorked() int {
+	return i.daysWorked
+}
+
+func (i *PayrollItem) Concepts() []Concept {
+	return i.concepts
+}
+
+// Amount devuelve el importe de un concepto por su código (0 si la boleta no lo tiene).
+func (i *PayrollItem) Amount(code string) float64 {
+	var total float64
+	for _, concept := range i.concepts {
+		if concept.Code == code {
+			total += concept.Amount
+		}
+	}
+	return RoundAmount(total)
+}
+
+// GrossPay es el total de ingresos.
+func (i *PayrollItem) GrossPay() float64 {
+	return i.total(ConceptIncome)
+}
+
+// TotalDeductions es el total de descuentos al trabajador.
+func (i *PayrollItem) TotalDeductions() float64 {
+	return i.total(ConceptDeduction)
+}
+
+// NetPay es el neto a pagar: ingresos menos descuentos.
+func (i *PayrollItem) NetPay() float64 {
+	return RoundAmount(i.GrossPay() - i.TotalDeductions())
+}
+
+// EmployerContributions es el total de aportes del empleador.
+func (i *PayrollItem) EmployerContributions() float64 {
+	return i.total(ConceptEmployer)
+}
+
+func (i *PayrollItem) total(kind ConceptKind) float64 {
+	var total float64
+	for _, concept := range i.concepts {
+		if concept.Kind == kind {
+			total += concept.Amount
+		}
+	}
+	return RoundAmount(total)
+}
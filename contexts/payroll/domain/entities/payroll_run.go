@@ -0,0 +1,107 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+// PayrollRunStatus - estado del cálculo de planilla
+type PayrollRunStatus string
+
+const (
+	PayrollRunCalculated PayrollRunStatus = "CALCULATED"
+)
+
+// PayrollRun - cálculo de planilla de un periodo: una boleta (PayrollItem) por trabajador.
+// Es la fuente de los archivos de pago y de las declaraciones; por eso guarda una foto de los
+// datos del trabajador al momento del cálculo en lugar de leerlos de employees.
+type PayrollRun struct {
+	id          string
+	period      value_objects.Period
+	paymentDate time.Time
+	status      PayrollRunStatus
+	items       []*PayrollItem
+	createdAt   time.Time
+}
+
+// NewPayrollRun crea un cálculo nuevo, sin boletas.
+func NewPayrollRun(period value_objects.Period, paymentDate time.Time) *PayrollRun {
+	return &PayrollRun{
+		id:          uuid.New().String(),
+		period:      period,
+		paymentDate: paymentDate,
+		status:      PayrollRunCalculated,
+		createdAt:   time.Now(),
+	}
+}
+
+// RestorePayrollRun reconstruye un cálculo guardado.
+func RestorePayrollRun(id string, period value_objects.Period, paymentDate time.Time, status PayrollRunStatus, items []*PayrollItem, createdAt time.Time) *PayrollRun {
+	return &PayrollRun{id: id, period: period, paymentDate: paymentDate, status: status, items: items, createdAt: createdAt}
+}
+
+func (r *PayrollRun) ID() string {
+	return r.id
+}
+
+func (r *PayrollRun) Period() value_objects.Period {
+	return r.period
+}
+
+func (r *PayrollRun) PaymentDate() time.Time {
+	return r.paymentDate
+}
+
+func (r *PayrollRun) Status() PayrollRunStatus {
+	return r.status
+}
+
+func (r *PayrollRun) Items() []*PayrollItem {
+	return r.items
+}
+
+func (r *PayrollRun) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// AddItem agrega la boleta de un trabajador al cálculo.
+func (r *PayrollRun) AddItem(item *PayrollItem) {
+	item.runID = r.id
+	r.items = append(r.items, item)
+}
+
+// TotalGross es la suma de los ingresos de todas las boletas.
+func (r *PayrollRun) TotalGross() float64 {
+	return r.sum(func(item *PayrollItem) float64 { return item.GrossPay() })
+}
+
+// TotalDeductions es la suma de los descuentos al trabajador.
+func (r *PayrollRun) TotalDeductions() float64 {
+	return r.sum(func(item *PayrollItem) float64 { return item.TotalDeductions() })
+}
+
+// TotalNet es lo que se transfiere a los trabajadores.
+func (r *PayrollRun) TotalNet() float64 {
+	return r.sum(func(item *PayrollItem) float64 { return item.NetPay() })
+}
+
+// TotalEmployerContributions son los aportes a cargo del empleador (EsSalud).
+func (r *PayrollRun) TotalEmployerContributions() float64 {
+	return r.sum(func(item *PayrollItem) float64 { return item.EmployerContributions() })
+}
+
+func (r *PayrollRun) sum(amount func(*PayrollItem) float64) float64 {
+	var total float64
+	for _, item := range r.items {
+		total += amount(item)
+	}
+	return RoundAmount(total)
+}
+
+// RoundAmount redondea un importe a céntimos.
+func RoundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// Payslip - boleta de pago emitida en PDF para una boleta calculada (PayrollItem).
+// El hash SHA-256 del documento se guarda al emitirlo: es lo que el trabajador firma al dar
+// su constancia de recepción y lo que se verifica en cada descarga.
+type Payslip struct {
+	id             string
+	runID          string
+	itemID         string
+	employeeID     string
+	period         value_objects.Period
+	contentHash    string
+	size           int
+	generatedAt    time.Time
+	acknowledgedAt *time.Time
+	acknowledgedBy string
+}
+
+// NewPayslip emite la boleta de un trabajador a partir del documento ya generado.
+func NewPayslip(run *PayrollRun, item *PayrollItem, content []byte) *Payslip {
+	return &Payslip{
+		id:          uuid.New().String(),
+		runID:       run.ID(),
+		itemID:      item.ID(),
+		employeeID:  item.Employee().EmployeeID,
+		period:      run.Period(),
+		contentHash: ContentHash(content),
+		size:        len(content),
+		generatedAt: time.Now(),
+	}
+}
+
+// RestorePayslip reconstruye una boleta emitida.
+func RestorePayslip(id, runID, itemID, employeeID string, period value_objects.Period, contentHash string, size int,
+	generatedAt time.Time, acknowledgedAt *time.Time, acknowledgedBy string) *Payslip {
+	return &Payslip{
+		id: id, runID: runID, itemID: itemID, employeeID: employeeID, period: period, contentHash: contentHash,
+		size: size, generatedAt: generatedAt, acknowledgedAt: acknowledgedAt, acknowledgedBy: acknowledgedBy,
+	}
+}
+
+func (p *Payslip) ID() string {
+	return p.id
+}
+
+func (p *Payslip) RunID() string {
+	return p.runID
+}
+
+func (p *Payslip) ItemID() string {
+	return p.itemID
+}
+
+func (p *Payslip) EmployeeID() string {
+	return p.employeeID
+}
+
+func (p *Payslip) Period() value_objects.Period {
+	return p.period
+}
+
+func (p *Payslip) ContentHash() string {
+	return p.contentHash
+}
+
+func (p *Payslip) Size() int {
+	return p.size
+}
+
+func (p *Payslip) GeneratedAt() time.Time {
+	return p.generatedAt
+}
+
+func (p *Payslip) AcknowledgedAt() *time.Time {
+	return p.acknowledgedAt
+}
+
+func (p *Payslip) AcknowledgedBy() string {
+	return p.acknowledgedBy
+}
+
+// IsAcknowledged indica si el trabajador ya dio su constancia de recepción.
+func (p *Payslip) IsAcknowledged() bool {
+	return p.acknowledgedAt != nil
+}
+
+// Matches indica si el contenido es el mismo documento que se emitió.
+func (p *Payslip) Matches(content []byte) bool {
+	return ContentHash(content) == p.contentHash
+}
+
+// Acknowledge registra la constancia de recepción del trabajador sobre el documento con el hash
+// indicado. Si ya se había registrado se conserva la primera constancia.
+func (p *Payslip) Acknowledge(contentHash, userID string, at time.Time) error {
+	if contentHash != p.contentHash {
+		return domain.NewBusinessRuleError("payroll.payslip_hash_mismatch", nil)
+	}
+	if p.IsAcknowledged() {
+		return nil
+	}
+	p.acknowledgedAt = &at
+	p.acknowledgedBy = userID
+	return nil
+}
+
+// ContentHash es el SHA-256 del documento en hexadecimal.
+func ContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+)
+
+// PayrollRunRepository define los métodos de persistencia para los cálculos de planilla
+// (solo contratos, sin implementación)
+type PayrollRunRepository interface {
+	// SavePayrollRun guarda el cálculo con sus boletas; falla con ALREADY_EXISTS si el periodo ya se calculó
+	SavePayrollRun(ctx context.Context, run *entities.PayrollRun) error
+	// GetPayrollRunByID devuelve el cálculo con sus boletas, o nil si no existe
+	GetPayrollRunByID(ctx context.Context, id string) (*entities.PayrollRun, error)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// nonPensionableConcepts - ingresos no afectos a aportes previsionales (Ley 30334)
+var nonPensionableConcepts = map[string]bool{
+	ConceptGratification:    true,
+	ConceptGratificationBon: true,
+}
+
+// AFPnetAffiliate - fila de la planilla de declaración de aportes de AFPnet
+type AFPnetAffiliate struct {
+	CUSPP              string
+	DocumentType       string // código de AFPnet
+	DocumentNumber     string
+	LastNamePaternal   string
+	LastNameMaternal   string
+	FirstName          string
+	EmploymentRelation string // S: tuvo relación laboral en el periodo
+	StartMovement      string // S: inició la relación laboral en el periodo
+	EndMovement        string // S: cesó en el periodo
+	InsurableWage      float64
+	WorkerCategory     string
+}
+
+// AFPnetFileGenerator - PUERTO de salida: escribe la planilla de una AFP en el formato de carga de AFPnet
+type AFPnetFileGenerator interface {
+	ContentType() string
+	Extension() string
+	Generate(affiliates []AFPnetAffiliate) ([]byte, error)
+}
+
+// NewAFPnetAffiliate arma la fila de una boleta. Los movimientos de inicio y cese se derivan de las
+// fechas de ingreso y cese del trabajador respecto del periodo.
+func NewAFPnetAffiliate(period value_objects.Period, item *entities.PayrollItem) (AFPnetAffiliate, []domain.FieldError) {
+	var errs []domain.FieldError
+	employee := item.Employee()
+
+	if employee.CUSPP == "" {
+		errs = append(errs, *domain.NewRequiredFieldError("cuspp"))
+	}
+	documentType, ok := value_objects.AFPnetDocumentType(employee.DocumentType)
+	if !ok {
+		errs = append(errs, *domain.NewFieldError("documentType", "afpnet_code", "afpnet.document_type_unsupported",
+			domain.Params{"value": employee.DocumentType}))
+	}
+	for _, required := range []struct{ field, value string }{
+		{"documentNumber", employee.DocumentNumber},
+		{"firstName", employee.FirstName},
+		{"lastNamePaternal", employee.LastNamePaternal},
+	} {
+		if required.value == "" {
+			errs = append(errs, *domain.NewRequiredFieldError(required.field))
+		}
+	}
+	if len(errs) > 0 {
+		return AFPnetAffiliate{}, errs
+	}
+
+	affiliate := AFPnetAffiliate{
+		CUSPP:              employee.CUSPP,
+		DocumentType:       documentType,
+		DocumentNumber:     employee.DocumentNumber,
+		LastNamePaternal:   employee.LastNamePaternal,
+		LastNameMaternal:   employee.LastNameMaternal,
+		FirstName:          employee.FirstName,
+		EmploymentRelation: value_objects.AFPnetYes,
+		StartMovement:      value_objects.AFPnetNo,
+		EndMovement:        value_objects.AFPnetNo,
+		InsurableWage:      PensionableIncome(item),
+		WorkerCategory:     value_objects.AFPnetRegularWorker,
+	}
+	if !employee.StartDate.Before(period.Start()) {
+		affiliate.StartMovement = value_objects.AFPnetYes
+	}
+	if employee.EndDate != nil && !employee.EndDate.After(period.End()) {
+		affiliate.EndMovement = value_objects.AFPnetYes
+	}
+	return affiliate, nil
+}
+
+// PensionableIncome suma los ingresos de la boleta afectos a aportes previsionales.
+func PensionableIncome(item *entities.PayrollItem) float64 {
+	var total float64
+	for _, concept := range item.Concepts() {
+		if concept.Kind == entities.ConceptIncome && !nonPensionableConcepts[concept.Code] {
+			total += concept.Amount
+		}
+	}
+	return entities.RoundAmount(total)
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+// Payment - abono a un trabajador dentro de un lote de pagos
+type Payment struct {
+	EmployeeID     string
+	DocumentType   string
+	DocumentNumber string
+	Name           string
+	Account        value_objects.BankAccount
+	AmountCents    int64
+}
+
+// PaymentBatch - lote de abonos de una planilla, listo para convertirse al formato de un banco
+type PaymentBatch struct {
+	Bank         value_objects.Bank
+	DebitAccount string // cuenta de cargo de la empresa; no aplica al formato genérico
+	PaymentDate  time.Time
+	Reference    string
+	Payments     []Payment
+}
+
+// TotalCents es la suma de los abonos del lote.
+func (b PaymentBatch) TotalCents() int64 {
+	var total int64
+	for _, payment := range b.Payments {
+		total += payment.AmountCents
+	}
+	return total
+}
+
+// BankFileGenerator - convierte un lote de pagos al archivo que el banco acepta para pago de haberes
+type BankFileGenerator interface {
+	Bank() value_objects.Bank
+	// Extension es la extensión del archivo sin punto (txt, csv...)
+	Extension() string
+	Generate(batch PaymentBatch) ([]byte, error)
+}
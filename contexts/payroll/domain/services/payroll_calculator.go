@@ -0,0 +1,203 @@
+package services
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+// Parámetros legales vigentes
+const (
+	MinimumWage               = 1130.0   // Remuneración Mínima Vital
+	UIT                       = 5350.0   // Unidad Impositiva Tributaria
+	MaxInsurableWage          = 12027.91 // Remuneración máxima asegurable para la prima de seguro AFP
+	ONPRate                   = 0.13
+	AFPContributionRate       = 0.10
+	AFPInsuranceRate          = 0.0137
+	EsSaludRate               = 0.09
+	EsSaludRateWithEPS        = 0.0675 // con EPS, el 2.25% restante va a la EPS
+	commercialMonthDays       = 30
+	semesterMonths            = 6
+	fifthCategoryDeductionUIT = 7 // deducción anual de 7 UIT de la renta de quinta categoría
+)
+
+// Códigos de la Tabla 22 de SUNAT (conceptos remunerativos, descuentos y aportes)
+const (
+	ConceptBasicPay         = "0121" // Remuneración o jornal básico
+	ConceptGratification    = "0406" // Gratificaciones de Fiestas Patrias y Navidad – Ley 29351
+	ConceptGratificationBon = "0313" // Bonificación extraordinaria temporal – Ley 29351 y 30334
+	ConceptAFPCommission    = "0601" // Comisión AFP porcentual
+	ConceptFifthCategoryTax = "0605" // Renta quinta categoría retenciones
+	ConceptAFPInsurance     = "0606" // Prima de seguro AFP
+	ConceptONP              = "0607" // Sistema Nacional de Pensiones – D.L. 19990
+	ConceptAFPContribution  = "0608" // SPP – aportación obligatoria
+	ConceptEsSalud          = "0804" // EsSalud seguro regular trabajador
+)
+
+// afpCommissions - comisión sobre el flujo de cada AFP
+var afpCommissions = map[value_objects.PensionSystem]float64{
+	value_objects.PensionHabitat:   0.0147,
+	value_objects.PensionIntegra:   0.0155,
+	value_objects.PensionPrima:     0.0160,
+	value_objects.PensionProfuturo: 0.0169,
+}
+
+// fifthCategoryBrackets - tramos anuales del impuesto a la renta de quinta categoría, en UIT
+var fifthCategoryBrackets = []struct {
+	upTo float64
+	rate float64
+}{
+	{5, 0.08},
+	{20, 0.14},
+	{35, 0.17},
+	{45, 0.20},
+	{0, 0.30}, // exceso
+}
+
+// PayrollInput - datos del trabajador que intervienen en el cálculo
+type PayrollInput struct {
+	Salary           float64
+	StartDate        time.Time
+	EndDate          *time.Time // fecha de cese, si cesó
+	HasGratification bool
+	HasEPS           bool
+	PensionSystem    value_objects.PensionSystem
+}
+
+// PayrollCalculator - DOMAIN SERVICE que calcula los conceptos de la boleta de un periodo
+type PayrollCalculator interface {
+	Calculate(period value_objects.Period, input PayrollInput) (daysWorked int, concepts []entities.Concept)
+}
+
+// PeruvianPayrollCalculator - régimen laboral general peruano (simplificado: sin horas extra,
+// faltas ni asignación familiar; la renta de quinta se proyecta con el sueldo del mes).
+type PeruvianPayrollCalculator struct{}
+
+func NewPeruvianPayrollCalculator() *PeruvianPayrollCalculator {
+	return &PeruvianPayrollCalculator{}
+}
+
+func (c *PeruvianPayrollCalculator) Calculate(period value_objects.Period, input PayrollInput) (int, []entities.Concept) {
+	days := daysWorked(period, input.StartDate, input.EndDate)
+	basicPay := entities.RoundAmount(input.Salary * float64(days) / commercialMonthDays)
+
+	concepts := []entities.Concept{
+		{Code: ConceptBasicPay, Description: "Remuneración básica", Kind: entities.ConceptIncome, Amount: basicPay},
+	}
+
+	// La gratificación y su bonificación no están afectas a aportes previsionales ni a EsSalud
+	gratification := 0.0
+	if input.HasGratification && (period.Month() == time.July || period.Month() == time.December) {
+		gratification = entities.RoundAmount(input.Salary * float64(gratificationMonths(period, input.StartDate)) / semesterMonths)
+	}
+	if gratification > 0 {
+		bonusRate := EsSaludRate
+		if input.HasEPS {
+			bonusRate = EsSaludRateWithEPS
+		}
+		concepts = append(concepts,
+			entities.Concept{Code: ConceptGratification, Description: "Gratificación", Kind: entities.ConceptIncome, Amount: gratification},
+			entities.Concept{Code: ConceptGratificationBon, Description: "Bonificación extraordinaria Ley 30334", Kind: entities.ConceptIncome, Amount: entities.RoundAmount(gratification * bonusRate)},
+		)
+	}
+
+	concepts = append(concepts, pensionConcepts(input.PensionSystem, basicPay)...)
+
+	if tax := fifthCategoryTax(input.Salary, input.HasGratification); tax > 0 {
+		concepts = append(concepts, entities.Concept{Code: ConceptFifthCategoryTax, Description: "Renta de quinta categoría", Kind: entities.ConceptDeduction, Amount: tax})
+	}
+
+	esSaludRate := EsSaludRate
+	if input.HasEPS {
+		esSaludRate = EsSaludRateWithEPS
+	}
+	esSaludBase := basicPay
+	if esSaludBase < MinimumWage {
+		esSaludBase = MinimumWage
+	}
+	concepts = append(concepts, entities.Concept{Code: ConceptEsSalud, Description: "EsSalud", Kind: entities.ConceptEmployer, Amount: entities.RoundAmount(esSaludBase * esSaludRate)})
+
+	return days, concepts
+}
+
+// pensionConcepts calcula los descuentos de ONP o de la AFP sobre la remuneración afecta.
+func pensionConcepts(system value_objects.PensionSystem, base float64) []entities.Concept {
+	if !system.IsAFP() {
+		return []entities.Concept{
+			{Code: ConceptONP, Description: "ONP", Kind: entities.ConceptDeduction, Amount: entities.RoundAmount(base * ONPRate)},
+		}
+	}
+	insurableBase := base
+	if insurableBase > MaxInsurableWage {
+		insurableBase = MaxInsurableWage
+	}
+	return []entities.Concept{
+		{Code: ConceptAFPContribution, Description: "AFP aporte obligatorio", Kind: entities.ConceptDeduction, Amount: entities.RoundAmount(base * AFPContributionRate)},
+		{Code: ConceptAFPInsurance, Description: "AFP prima de seguro", Kind: entities.ConceptDeduction, Amount: entities.RoundAmount(insurableBase * AFPInsuranceRate)},
+		{Code: ConceptAFPCommission, Description: "AFP comisión", Kind: entities.ConceptDeduction, Amount: entities.RoundAmount(base * afpCommissions[system])},
+	}
+}
+
+// fifthCategoryTax proyecta la renta anual (12 sueldos más 2 gratificaciones), resta 7 UIT,
+// aplica la escala progresiva y retiene la doceava parte.
+func fifthCategoryTax(salary float64, hasGratification bool) float64 {
+	annual := salary * 12
+	if hasGratification {
+		annual += salary * 2
+	}
+	taxable := annual - fifthCategoryDeductionUIT*UIT
+	if taxable <= 0 {
+		return 0
+	}
+
+	var tax, lower float64
+	for _, bracket := range fifthCategoryBrackets {
+		upper := bracket.upTo * UIT
+		if bracket.upTo == 0 || taxable < upper {
+			tax += (taxable - lower) * bracket.rate
+			break
+		}
+		tax += (upper - lower) * bracket.rate
+		lower = upper
+	}
+	return entities.RoundAmount(tax / 12)
+}
+
+// daysWorked usa el mes comercial de 30 días; quien ingresó dentro del periodo cobra desde su fecha de
+// ingreso y quien cesó, hasta su fecha de cese. Cesar el último día del mes equivale al día 30.
+func daysWorked(period value_objects.Period, startDate time.Time, endDate *time.Time) int {
+	if startDate.After(period.End()) || (endDate != nil && endDate.Before(period.Start())) {
+		return 0
+	}
+	first, last := 1, commercialMonthDays
+	if !startDate.Before(period.Start()) {
+		first = min(startDate.Day(), commercialMonthDays)
+	}
+	if endDate != nil && endDate.Before(period.End()) {
+		last = min(endDate.Day(), commercialMonthDays)
+	}
+	return max(last-first+1, 1)
+}
+
+// gratificationMonths cuenta los meses calendario completos del semestre (enero-junio o julio-diciembre)
+// trabajados hasta el periodo.
+func gratificationMonths(period value_objects.Period, startDate time.Time) int {
+	semesterStart := time.Date(period.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	if period.Month() == time.December {
+		semesterStart = time.Date(period.Year(), time.July, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if !startDate.After(semesterStart) {
+		return semesterMonths
+	}
+	// El mes de ingreso solo cuenta si se ingresó el día 1
+	firstFullMonth := time.Date(startDate.Year(), startDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if startDate.Day() != 1 {
+		firstFullMonth = firstFullMonth.AddDate(0, 1, 0)
+	}
+	months := semesterMonths - (int(firstFullMonth.Month()) - int(semesterStart.Month()))
+	if firstFullMonth.Year() != semesterStart.Year() || months < 0 {
+		return 0
+	}
+	return months
+}
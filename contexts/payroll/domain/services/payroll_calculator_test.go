@@ -0,0 +1,86 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+func calculate(t *testing.T, period string, input services.PayrollInput) (int, *entities.PayrollItem) {
+	t.Helper()
+	p, err := value_objects.NewPeriod(period)
+	require.NoError(t, err)
+	days, concepts := services.NewPeruvianPayrollCalculator().Calculate(p, input)
+	return days, entities.NewPayrollItem(entities.EmployeeSnapshot{}, days, concepts)
+}
+
+func TestPeruvianPayrollCalculator_AFPEmployee(t *testing.T) {
+	days, item := calculate(t, "2025-09", services.PayrollInput{
+		Salary:        5000,
+		StartDate:     time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		PensionSystem: value_objects.PensionIntegra,
+	})
+
+	assert.Equal(t, 30, days)
+	assert.Equal(t, 5000.0, item.Amount(services.ConceptBasicPay))
+	assert.Equal(t, 500.0, item.Amount(services.ConceptAFPContribution))
+	assert.Equal(t, 68.5, item.Amount(services.ConceptAFPInsurance))
+	assert.Equal(t, 77.5, item.Amount(services.ConceptAFPCommission))
+	assert.Zero(t, item.Amount(services.ConceptONP))
+	// (5000*12 - 7*5350) = 22550 -> 8% = 1804 al año
+	assert.Equal(t, 150.33, item.Amount(services.ConceptFifthCategoryTax))
+	assert.Equal(t, 450.0, item.EmployerContributions())
+	assert.Equal(t, 4203.67, item.NetPay())
+}
+
+func TestPeruvianPayrollCalculator_ProratesFirstMonthAndUsesMinimumEsSaludBase(t *testing.T) {
+	days, item := calculate(t, "2025-09", services.PayrollInput{
+		Salary:        1500,
+		StartDate:     time.Date(2025, 9, 16, 0, 0, 0, 0, time.UTC),
+		PensionSystem: value_objects.PensionONP,
+	})
+
+	assert.Equal(t, 15, days)
+	assert.Equal(t, 750.0, item.Amount(services.ConceptBasicPay))
+	assert.Equal(t, 97.5, item.Amount(services.ConceptONP))
+	assert.Zero(t, item.Amount(services.ConceptFifthCategoryTax))
+	assert.Equal(t, 101.7, item.Amount(services.ConceptEsSalud)) // 9% de la RMV
+}
+
+func TestPeruvianPayrollCalculator_ProratesUpToTheEndDate(t *testing.T) {
+	endDate := time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)
+	days, item := calculate(t, "2025-09", services.PayrollInput{
+		Salary:        3000,
+		StartDate:     time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:       &endDate,
+		PensionSystem: value_objects.PensionONP,
+	})
+	assert.Equal(t, 10, days)
+	assert.Equal(t, 1000.0, item.Amount(services.ConceptBasicPay))
+
+	// Cesar el último día de un mes de 31 días no paga un día 31
+	endDate = time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)
+	days, _ = calculate(t, "2025-08", services.PayrollInput{Salary: 3000, StartDate: time.Date(2025, 8, 11, 0, 0, 0, 0, time.UTC), EndDate: &endDate})
+	assert.Equal(t, 20, days)
+}
+
+func TestPeruvianPayrollCalculator_GratificationIsNotSubjectToPension(t *testing.T) {
+	_, item := calculate(t, "2025-12", services.PayrollInput{
+		Salary:           3000,
+		StartDate:        time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		HasGratification: true,
+		HasEPS:           true,
+		PensionSystem:    value_objects.PensionONP,
+	})
+
+	assert.Equal(t, 2000.0, item.Amount(services.ConceptGratification)) // septiembre a diciembre: 4/6
+	assert.Equal(t, 135.0, item.Amount(services.ConceptGratificationBon))
+	assert.Equal(t, 390.0, item.Amount(services.ConceptONP))
+	assert.Equal(t, 202.5, item.Amount(services.ConceptEsSalud))
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+// PayslipEmployer - datos del empleador que encabezan la boleta, tomados de su persona jurídica
+type PayslipEmployer struct {
+	RUC          string
+	BusinessName string
+	Address      string
+}
+
+// PayslipDocument - contenido de la boleta de pago de un trabajador, agrupado en las columnas
+// que exige el D.S. 001-98-TR: ingresos, descuentos y aportes del empleador.
+type PayslipDocument struct {
+	Employer                   PayslipEmployer
+	Period                     value_objects.Period
+	PaymentDate                time.Time
+	Employee                   entities.EmployeeSnapshot
+	DaysWorked                 int
+	Incomes                    []entities.Concept
+	Deductions                 []entities.Concept
+	EmployerContributions      []entities.Concept
+	GrossPay                   float64
+	TotalDeductions            float64
+	NetPay                     float64
+	TotalEmployerContributions float64
+}
+
+// PayslipRenderer - PUERTO de salida: convierte la boleta al documento que se entrega al trabajador
+type PayslipRenderer interface {
+	ContentType() string
+	Extension() string
+	Render(document PayslipDocument) ([]byte, error)
+}
+
+// NewPayslipDocument arma la boleta de un trabajador con los conceptos calculados en la planilla.
+func NewPayslipDocument(employer PayslipEmployer, run *entities.PayrollRun, item *entities.PayrollItem) PayslipDocument {
+	document := PayslipDocument{
+		Employer:                   employer,
+		Period:                     run.Period(),
+		PaymentDate:                run.PaymentDate(),
+		Employee:                   item.Employee(),
+		DaysWorked:                 item.DaysWorked(),
+		GrossPay:                   item.GrossPay(),
+		TotalDeductions:            item.TotalDeductions(),
+		NetPay:                     item.NetPay(),
+		TotalEmployerContributions: item.EmployerContributions(),
+	}
+	for _, concept := range item.Concepts() {
+		switch concept.Kind {
+		case entities.ConceptIncome:
+			document.Incomes = append(document.Incomes, concept)
+		case entities.ConceptDeduction:
+			document.Deductions = append(document.Deductions, concept)
+		case entities.ConceptEmployer:
+			document.EmployerContributions = append(document.EmployerContributions, concept)
+		}
+	}
+	return document
+}
@@ -0,0 +1,29 @@
+package services
+
+// SunatFile - archivo de importación de T-Registro (ide, tra, per) o de la PLAME (rem, jor, snl)
+type SunatFile string
+
+const (
+	FileIDE SunatFile = "ide" // T-Registro: datos personales
+	FileTRA SunatFile = "tra" // T-Registro: datos laborales
+	FilePER SunatFile = "per" // T-Registro: periodos (ingreso, tipo de trabajador, salud, pensiones)
+	FileREM SunatFile = "rem" // PLAME: remuneraciones por concepto
+	FileJOR SunatFile = "jor" // PLAME: jornada laboral
+	FileSNL SunatFile = "snl" // PLAME: días subsidiados y no laborados
+)
+
+// IsTRegistro indica si el archivo pertenece a T-Registro.
+func (f SunatFile) IsTRegistro() bool {
+	return f == FileIDE || f == FileTRA || f == FilePER
+}
+
+// IsPlame indica si el archivo pertenece a la PLAME.
+func (f SunatFile) IsPlame() bool {
+	return f == FileREM || f == FileJOR || f == FileSNL
+}
+
+// SunatFileGenerator - escribe los archivos de importación con la estructura de SUNAT
+type SunatFileGenerator interface {
+	TRegistro(file SunatFile, workers []SunatWorker) ([]byte, error)
+	Plame(file SunatFile, payslips []SunatPayslip) ([]byte, error)
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// Valores fijos de la empresa: régimen laboral privado general, empleados con pago mensual por depósito en cuenta
+const (
+	sunatWorkerType   = "21" // Tabla 8: empleado
+	ordinaryDayHours  = 8
+	sunatGenderMale   = "1"
+	sunatGenderFemale = "2"
+)
+
+// SunatWorkerData - datos del trabajador tal como están registrados en el sistema
+type SunatWorkerData struct {
+	EmployeeID       string
+	DocumentType     string
+	DocumentNumber   string
+	FirstName        string
+	LastNamePaternal string
+	LastNameMaternal string
+	BirthDate        time.Time
+	Gender           string // M, F, O
+	Nationality      string // ISO 3166-1 alfa-3
+	Phone            string
+	Email            string
+	Street           string
+	Ubigeo           string
+	ContractType     string
+	StartDate        time.Time
+	BaseSalary       float64
+	AFP              string
+	CUSPP            string
+	EPS              string
+}
+
+// SunatWorker - trabajador con sus datos expresados en los códigos de las tablas de SUNAT (T-Registro)
+type SunatWorker struct {
+	DocumentType     string // Tabla 3
+	DocumentNumber   string
+	Country          string // Tabla 26, solo para pasaporte
+	BirthDate        time.Time
+	LastNamePaternal string
+	LastNameMaternal string
+	FirstName        string
+	Gender           string // 1 masculino, 2 femenino
+	Nationality      string // Tabla 4
+	Phone            string
+	Email            string
+	Street           string
+	Ubigeo           string
+	WorkerType       string // Tabla 8
+	ContractType     string // Tabla 12
+	PensionRegime    string // Tabla 11
+	CUSPP            string // solo afiliados a una AFP
+	HealthRegime     string // Tabla 32
+	StartDate        time.Time
+	BaseSalary       float64
+}
+
+// SunatPayslip - boleta de un trabajador para la PLAME
+type SunatPayslip struct {
+	DocumentType   string // Tabla 3
+	DocumentNumber string
+	HoursWorked    int
+	Concepts       []entities.Concept // Code es el código de la Tabla 22
+}
+
+// SunatValidator - DOMAIN SERVICE que traduce los datos del sistema a los códigos de SUNAT y
+// reporta todo lo que no tiene código o falta, antes de generar cualquier archivo
+type SunatValidator struct{}
+
+func NewSunatValidator() *SunatValidator {
+	return &SunatValidator{}
+}
+
+// ValidateWorker devuelve el trabajador codificado, o los campos que SUNAT rechazaría.
+func (v *SunatValidator) ValidateWorker(data SunatWorkerData) (SunatWorker, []domain.FieldError) {
+	var errs []domain.FieldError
+	worker := SunatWorker{
+		DocumentNumber:   data.DocumentNumber,
+		BirthDate:        data.BirthDate,
+		LastNamePaternal: data.LastNamePaternal,
+		LastNameMaternal: data.LastNameMaternal,
+		FirstName:        data.FirstName,
+		Phone:            data.Phone,
+		Email:            data.Email,
+		Street:           data.Street,
+		Ubigeo:           data.Ubigeo,
+		WorkerType:       sunatWorkerType,
+		CUSPP:            data.CUSPP,
+		HealthRegime:     value_objects.SunatHealthRegime(value_objects.HasEPS(data.EPS)),
+		StartDate:        data.StartDate,
+		BaseSalary:       data.BaseSalary,
+	}
+
+	for _, required := range []struct{ field, value string }{
+		{"documentNumber", data.DocumentNumber},
+		{"firstName", data.FirstName},
+		{"lastNamePaternal", data.LastNamePaternal},
+	} {
+		if required.value == "" {
+			errs = append(errs, *domain.NewRequiredFieldError(required.field))
+		}
+	}
+	if data.BirthDate.IsZero() {
+		errs = append(errs, *domain.NewRequiredFieldError("birthDate"))
+	}
+
+	var ok bool
+	if worker.DocumentType, ok = value_objects.SunatDocumentType(data.DocumentType); !ok {
+		errs = append(errs, unmappedCode("documentType", value_objects.TableDocumentType, data.DocumentType))
+	}
+	nationality, country, ok := value_objects.SunatNationality(data.Nationality)
+	if !ok {
+		errs = append(errs, unmappedCode("nationality", value_objects.TableNationality, data.Nationality))
+	}
+	worker.Nationality = nationality
+	if data.DocumentType == "PASAPORTE" {
+		worker.Country = country
+	}
+	switch data.Gender {
+	case "M":
+		worker.Gender = sunatGenderMale
+	case "F":
+		worker.Gender = sunatGenderFemale
+	default:
+		errs = append(errs, *domain.NewFieldError("gender", "oneof", "sunat.gender_unsupported", domain.Params{"value": data.Gender}))
+	}
+	if worker.ContractType, ok = value_objects.SunatContractType(data.ContractType); !ok {
+		errs = append(errs, unmappedCode("contractType", value_objects.TableContractType, data.ContractType))
+	}
+	pensionSystem, err := value_objects.NewPensionSystem(data.AFP)
+	if err == nil {
+		worker.PensionRegime, ok = value_objects.SunatPensionRegime(pensionSystem)
+	}
+	if err != nil || !ok {
+		errs = append(errs, unmappedCode("afp", value_objects.TablePensionRegime, data.AFP))
+	}
+	if len(errs) > 0 {
+		return SunatWorker{}, errs
+	}
+
+	// Las equivalencias y las tablas deben coincidir; si no, el catálogo está mal y no se exporta
+	for _, coded := range []struct {
+		table value_objects.SunatTable
+		code  string
+	}{
+		{value_objects.TableDocumentType, worker.DocumentType},
+		{value_objects.TableNationality, worker.Nationality},
+		{value_objects.TableWorkerType, worker.WorkerType},
+		{value_objects.TableContractType, worker.ContractType},
+		{value_objects.TablePensionRegime, worker.PensionRegime},
+		{value_objects.TableHealthRegime, worker.HealthRegime},
+	} {
+		if !value_objects.ValidSunatCode(coded.table, coded.code) {
+			errs = append(errs, invalidCode(coded.table, coded.code))
+		}
+	}
+	if worker.Country != "" && !value_objects.ValidSunatCode(value_objects.TableCountry, worker.Country) {
+		errs = append(errs, invalidCode(value_objects.TableCountry, worker.Country))
+	}
+	if len(errs) > 0 {
+		return SunatWorker{}, errs
+	}
+	return worker, nil
+}
+
+// ValidatePayslip devuelve la boleta para la PLAME, o los conceptos y datos sin código válido.
+func (v *SunatValidator) ValidatePayslip(item *entities.PayrollItem) (SunatPayslip, []domain.FieldError) {
+	var errs []domain.FieldError
+	employee := item.Employee()
+
+	documentType, ok := value_objects.SunatDocumentType(employee.DocumentType)
+	if !ok {
+		errs = append(errs, unmappedCode("documentType", value_objects.TableDocumentType, employee.DocumentType))
+	}
+	if employee.DocumentNumber == "" {
+		errs = append(errs, *domain.NewRequiredFieldError("documentNumber"))
+	}
+	for _, concept := range item.Concepts() {
+		if !value_objects.ValidSunatCode(value_objects.TableConcept, concept.Code) {
+			errs = append(errs, *domain.NewFieldError(fmt.Sprintf("concepts[%s]", concept.Code), "sunat_code", "sunat.code_invalid",
+				domain.Params{"table": string(value_objects.TableConcept), "value": concept.Code}))
+		}
+	}
+	if len(errs) > 0 {
+		return SunatPayslip{}, errs
+	}
+	return SunatPayslip{
+		DocumentType:   documentType,
+		DocumentNumber: employee.DocumentNumber,
+		HoursWorked:    item.DaysWorked() * ordinaryDayHours,
+		Concepts:       item.Concepts(),
+	}, nil
+}
+
+func unmappedCode(field string, table value_objects.SunatTable, value string) domain.FieldError {
+	return *domain.NewFieldError(field, "sunat_code", "sunat.code_unmapped", domain.Params{"table": string(table), "value": value})
+}
+
+func invalidCode(table value_objects.SunatTable, code string) domain.FieldError {
+	return *domain.NewFieldError("table"+string(table), "sunat_code", "sunat.code_invalid", domain.Params{"table": string(table), "value": code})
+}
@@ -0,0 +1,72 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+)
+
+func workerData() services.SunatWorkerData {
+	return services.SunatWorkerData{
+		EmployeeID: "emp-1", DocumentType: "DNI", DocumentNumber: "12345678",
+		FirstName: "Ana", LastNamePaternal: "Quispe", LastNameMaternal: "Mamani",
+		BirthDate: time.Date(1990, 3, 1, 0, 0, 0, 0, time.UTC), Gender: "F", Nationality: "PER",
+		ContractType: "INDEFINIDO", StartDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		BaseSalary: 3000, AFP: "AFP Prima", EPS: "Rímac",
+	}
+}
+
+func TestSunatValidator_CodesWorker(t *testing.T) {
+	worker, errs := services.NewSunatValidator().ValidateWorker(workerData())
+
+	require.Empty(t, errs)
+	assert.Equal(t, "01", worker.DocumentType)
+	assert.Equal(t, "9589", worker.Nationality)
+	assert.Empty(t, worker.Country, "the issuing country is only declared for passports")
+	assert.Equal(t, "2", worker.Gender)
+	assert.Equal(t, "01", worker.ContractType)
+	assert.Equal(t, "24", worker.PensionRegime)
+	assert.Equal(t, "01", worker.HealthRegime)
+}
+
+func TestSunatValidator_ReportsEveryUnmappedValue(t *testing.T) {
+	data := workerData()
+	data.DocumentType = "PASAPORTE"
+	data.Nationality = "JPN"
+	data.ContractType = "PRACTICANTE"
+	data.AFP = "Horizonte"
+	data.Gender = "O"
+	data.BirthDate = time.Time{}
+
+	_, errs := services.NewSunatValidator().ValidateWorker(data)
+
+	fields := make([]string, len(errs))
+	for i, err := range errs {
+		fields[i] = err.Field
+	}
+	assert.Equal(t, []string{"birthDate", "nationality", "gender", "contractType", "afp"}, fields)
+	assert.Equal(t, "sunat.code_unmapped", errs[1].MessageKey)
+	assert.Equal(t, "4", errs[1].Params["table"])
+}
+
+func TestSunatValidator_RejectsConceptsOutsideTable22(t *testing.T) {
+	item := entities.NewPayrollItem(entities.EmployeeSnapshot{DocumentType: "CE", DocumentNumber: "001234567"}, 20, []entities.Concept{
+		{Code: services.ConceptBasicPay, Kind: entities.ConceptIncome, Amount: 2000},
+		{Code: "9999", Kind: entities.ConceptIncome, Amount: 10},
+	})
+
+	_, errs := services.NewSunatValidator().ValidatePayslip(item)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "concepts[9999]", errs[0].Field)
+
+	item = entities.NewPayrollItem(item.Employee(), 20, item.Concepts()[:1])
+	payslip, errs := services.NewSunatValidator().ValidatePayslip(item)
+	require.Empty(t, errs)
+	assert.Equal(t, "04", payslip.DocumentType)
+	assert.Equal(t, 160, payslip.HoursWorked)
+}
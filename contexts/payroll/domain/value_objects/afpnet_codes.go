@@ -0,0 +1,24 @@
+package value_objects
+
+// Indicadores S/N de la planilla de AFPnet
+const (
+	AFPnetYes = "S"
+	AFPnetNo  = "N"
+)
+
+// AFPnetRegularWorker - rubro del trabajador dependiente común (C construcción, M minería, P pesca)
+const AFPnetRegularWorker = "N"
+
+// afpnetDocumentTypes - tipos de documento de identidad que acepta AFPnet
+var afpnetDocumentTypes = map[string]string{
+	"DNI":       "0",
+	"CE":        "1",
+	"PASAPORTE": "4",
+}
+
+// AFPnetDocumentType traduce el tipo de documento al código de AFPnet.
+// PTP y CPP no tienen código: esos trabajadores se declaran con su carné de extranjería o pasaporte.
+func AFPnetDocumentType(documentType string) (string, bool) {
+	code, ok := afpnetDocumentTypes[documentType]
+	return code, ok
+}
@@ -0,0 +1,95 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// Bank - banco al que se envía el archivo de pagos; BankGeneric es el formato CCI aceptado por cualquier banco
+type Bank string
+
+const (
+	BankBCP       Bank = "BCP"
+	BankInterbank Bank = "INTERBANK"
+	BankBBVA      Bank = "BBVA"
+	BankGeneric   Bank = "CCI"
+)
+
+// AccountKind - cómo se abona: a una cuenta del mismo banco o por transferencia interbancaria (CCI)
+type AccountKind string
+
+const (
+	AccountSavings  AccountKind = "SAVINGS"  // cuenta de ahorros del banco del archivo
+	AccountChecking AccountKind = "CHECKING" // cuenta corriente del banco del archivo
+	AccountCCI      AccountKind = "CCI"      // Código de Cuenta Interbancario, de cualquier banco
+)
+
+// BankAccount - cuenta de abono validada para un banco
+type BankAccount struct {
+	Number string // solo dígitos
+	Kind   AccountKind
+}
+
+// ParseBankAccount valida la cuenta registrada del trabajador para un archivo del banco dado.
+// Un CCI (20 dígitos) siempre es válido si sus dígitos de control cuadran; si no, debe ser una cuenta
+// propia del banco: BCP 13 dígitos (corriente) o 14 (ahorros), Interbank 13 y BBVA 18 (empieza con 0011).
+// Para BankGeneric solo se acepta CCI.
+func ParseBankAccount(bank Bank, value string) (BankAccount, error) {
+	number := strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(value))
+	invalid := domain.NewFieldError("bankAccount", "bank_account", "payroll.bank_account_invalid", domain.Params{"bank": string(bank)})
+	if number == "" {
+		return BankAccount{}, domain.NewFieldError("bankAccount", "required", "payroll.bank_account_missing", nil)
+	}
+	if !isDigits(number) {
+		return BankAccount{}, invalid
+	}
+
+	if len(number) == 20 {
+		if !ValidCCI(number) {
+			return BankAccount{}, domain.NewFieldError("bankAccount", "checksum", "payroll.cci_checksum", nil)
+		}
+		return BankAccount{Number: number, Kind: AccountCCI}, nil
+	}
+
+	switch {
+	case bank == BankBCP && len(number) == 13:
+		return BankAccount{Number: number, Kind: AccountChecking}, nil
+	case bank == BankBCP && len(number) == 14:
+		return BankAccount{Number: number, Kind: AccountSavings}, nil
+	case bank == BankInterbank && len(number) == 13:
+		return BankAccount{Number: number, Kind: AccountSavings}, nil
+	case bank == BankBBVA && len(number) == 18 && strings.HasPrefix(number, "0011"):
+		return BankAccount{Number: number, Kind: AccountSavings}, nil
+	}
+	return BankAccount{}, invalid
+}
+
+// ValidCCI verifica los dos dígitos de control del CCI: el primero cubre entidad y oficina
+// (6 dígitos), el segundo el número de cuenta (12 dígitos). Cada dígito se multiplica
+// alternadamente por 1 y 2, se suman las cifras de cada producto y el control es lo que
+// falta para la siguiente decena.
+func ValidCCI(cci string) bool {
+	if len(cci) != 20 || !isDigits(cci) {
+		return false
+	}
+	return cciCheckDigit(cci[0:6]) == cci[18] && cciCheckDigit(cci[6:18]) == cci[19]
+}
+
+func cciCheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		product := int(digits[i]-'0') * (1 + i%2)
+		sum += product/10 + product%10
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
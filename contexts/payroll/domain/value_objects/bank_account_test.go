@@ -0,0 +1,81 @@
+package value_objects_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+func TestValidCCI(t *testing.T) {
+	tests := []struct {
+		name  string
+		cci   string
+		valid bool
+	}{
+		{"BCP", "00219300123456789013", true},
+		{"Interbank", "00310000456789123451", true},
+		{"BBVA", "01130000020012345612", true},
+		{"wrong office check digit", "00219300123456789003", false},
+		{"wrong account check digit", "00219300123456789010", false},
+		{"too short", "0021930012345678901", false},
+		{"letters", "0021930012345678901A", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, value_objects.ValidCCI(tt.cci))
+		})
+	}
+}
+
+func TestParseBankAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		bank     value_objects.Bank
+		value    string
+		wantKind value_objects.AccountKind
+		wantRule string
+	}{
+		{"BCP savings", value_objects.BankBCP, "193-12345678-0-12", value_objects.AccountSavings, ""},
+		{"BCP checking", value_objects.BankBCP, "1931234567012", value_objects.AccountChecking, ""},
+		{"Interbank", value_objects.BankInterbank, "1003001234567", value_objects.AccountSavings, ""},
+		{"BBVA", value_objects.BankBBVA, "0011-0200-0200123456", value_objects.AccountSavings, ""},
+		{"BBVA without prefix", value_objects.BankBBVA, "001202000200123456", "", "bank_account"},
+		{"CCI in a bank file", value_objects.BankBCP, "003-100-004567891234-51", value_objects.AccountCCI, ""},
+		{"CCI with bad check digits", value_objects.BankInterbank, "00310000456789123450", "", "checksum"},
+		{"own account in generic file", value_objects.BankGeneric, "19312345678012", "", "bank_account"},
+		{"missing", value_objects.BankBCP, "  ", "", "required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := value_objects.ParseBankAccount(tt.bank, tt.value)
+
+			if tt.wantRule != "" {
+				var fieldErr *domain.FieldError
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.wantRule, fieldErr.Rule)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKind, account.Kind)
+			assert.NotContains(t, account.Number, "-")
+		})
+	}
+}
+
+func TestNewPensionSystem_NormalizesEmployeeValues(t *testing.T) {
+	for input, want := range map[string]value_objects.PensionSystem{
+		"Integra":       value_objects.PensionIntegra,
+		"AFP Profuturo": value_objects.PensionProfuturo,
+		"snp":           value_objects.PensionONP,
+	} {
+		system, err := value_objects.NewPensionSystem(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, want, system, input)
+	}
+
+	_, err := value_objects.NewPensionSystem("Horizonte")
+	assert.Error(t, err)
+}
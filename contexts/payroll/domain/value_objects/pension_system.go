@@ -0,0 +1,45 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// PensionSystem - sistema de pensiones del trabajador: ONP o una de las AFP del SPP
+type PensionSystem string
+
+const (
+	PensionONP       PensionSystem = "ONP"
+	PensionHabitat   PensionSystem = "HABITAT"
+	PensionIntegra   PensionSystem = "INTEGRA"
+	PensionPrima     PensionSystem = "PRIMA"
+	PensionProfuturo PensionSystem = "PROFUTURO"
+)
+
+// NewPensionSystem normaliza el valor de Employee.AFP ("AFP Integra", "integra", "SNP"...).
+func NewPensionSystem(value string) (PensionSystem, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	normalized = strings.TrimSpace(strings.TrimPrefix(normalized, "AFP"))
+	switch normalized {
+	case "ONP", "SNP":
+		return PensionONP, nil
+	case string(PensionHabitat), string(PensionIntegra), string(PensionPrima), string(PensionProfuturo):
+		return PensionSystem(normalized), nil
+	}
+	return "", domain.NewFieldError("afp", "oneof", "payroll.pension_system_invalid", domain.Params{"value": value})
+}
+
+// IsAFP indica si el trabajador aporta al Sistema Privado de Pensiones.
+func (p PensionSystem) IsAFP() bool {
+	return p != PensionONP
+}
+
+// HasEPS - Employee.EPS guarda el nombre de la EPS, o un valor vacío o "NINGUNA" si no tiene.
+func HasEPS(eps string) bool {
+	switch strings.ToUpper(strings.TrimSpace(eps)) {
+	case "", "NO", "NINGUNA", "NINGUNO", "NONE":
+		return false
+	}
+	return true
+}
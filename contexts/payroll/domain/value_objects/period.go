@@ -0,0 +1,50 @@
+package value_objects
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// Period - mes de planilla (YYYY-MM)
+type Period struct {
+	year  int
+	month time.Month
+}
+
+// NewPeriod interpreta un periodo en formato 2006-01.
+func NewPeriod(value string) (Period, error) {
+	t, err := time.Parse("2006-01", value)
+	if err != nil {
+		return Period{}, domain.NewFieldError("period", "datetime", "validation.datetime", domain.Params{"format": "YYYY-MM"})
+	}
+	return Period{year: t.Year(), month: t.Month()}, nil
+}
+
+func (p Period) Year() int {
+	return p.year
+}
+
+func (p Period) Month() time.Month {
+	return p.month
+}
+
+// Start es el primer día del periodo.
+func (p Period) Start() time.Time {
+	return time.Date(p.year, p.month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// End es el último día del periodo.
+func (p Period) End() time.Time {
+	return p.Start().AddDate(0, 1, -1)
+}
+
+// String devuelve el periodo como 2006-01.
+func (p Period) String() string {
+	return p.Start().Format("2006-01")
+}
+
+// Compact devuelve el periodo como 200601, el formato de SUNAT y de las AFP.
+func (p Period) Compact() string {
+	return p.Start().Format("200601")
+}
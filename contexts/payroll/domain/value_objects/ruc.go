@@ -0,0 +1,26 @@
+package value_objects
+
+// rucWeights - pesos del dígito verificador del RUC (módulo 11)
+var rucWeights = [10]int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+// ValidRUC verifica que el RUC tenga 11 dígitos, un prefijo de contribuyente (10, 15, 17 o 20)
+// y el dígito verificador correcto.
+func ValidRUC(ruc string) bool {
+	if len(ruc) != 11 || !isDigits(ruc) {
+		return false
+	}
+	switch ruc[:2] {
+	case "10", "15", "17", "20":
+	default:
+		return false
+	}
+	sum := 0
+	for i, weight := range rucWeights {
+		sum += int(ruc[i]-'0') * weight
+	}
+	check := 11 - sum%11
+	if check >= 10 {
+		check -= 10
+	}
+	return byte('0'+check) == ruc[10]
+}
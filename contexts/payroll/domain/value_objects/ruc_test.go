@@ -0,0 +1,17 @@
+package value_objects_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+func TestValidRUC(t *testing.T) {
+	assert.True(t, value_objects.ValidRUC("20100070970"))
+	assert.True(t, value_objects.ValidRUC("10456789124"))
+	assert.False(t, value_objects.ValidRUC("20100070971"), "wrong check digit")
+	assert.False(t, value_objects.ValidRUC("30100070970"), "unknown taxpayer prefix")
+	assert.False(t, value_objects.ValidRUC("2010007097"))
+}
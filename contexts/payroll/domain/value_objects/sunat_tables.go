@@ -0,0 +1,128 @@
+package value_objects
+
+// SunatTable - tabla paramétrica de SUNAT usada en T-Registro y PLAME
+type SunatTable string
+
+const (
+	TableDocumentType  SunatTable = "3"  // Tipo de documento de identidad
+	TableNationality   SunatTable = "4"  // Nacionalidad
+	TableWorkerType    SunatTable = "8"  // Tipo de trabajador
+	TablePensionRegime SunatTable = "11" // Régimen pensionario
+	TableContractType  SunatTable = "12" // Tipo de contrato de trabajo
+	TableConcept       SunatTable = "22" // Ingresos, tributos y descuentos
+	TableCountry       SunatTable = "26" // País emisor del documento
+	TableHealthRegime  SunatTable = "32" // Régimen de aseguramiento de salud
+)
+
+// sunatTables - códigos admitidos de cada tabla; solo se incluyen los que el sistema puede generar
+var sunatTables = map[SunatTable]map[string]string{
+	TableDocumentType: {
+		"01": "DNI",
+		"04": "Carné de extranjería",
+		"07": "Pasaporte",
+		"23": "Permiso temporal de permanencia",
+		"24": "Carné de permiso temporal de permanencia",
+	},
+	TableNationality: {
+		"9589": "Perú",
+		"9850": "Venezuela",
+		"9169": "Colombia",
+		"9239": "Ecuador",
+		"9097": "Bolivia",
+		"9211": "Chile",
+	},
+	TableWorkerType: {
+		"21": "Empleado",
+	},
+	TablePensionRegime: {
+		"02": "Sistema Nacional de Pensiones - D.L. 19990",
+		"21": "SPP Integra",
+		"23": "SPP Profuturo",
+		"24": "SPP Prima",
+		"25": "SPP Habitat",
+	},
+	TableContractType: {
+		"01": "A plazo indeterminado",
+		"04": "Por necesidades del mercado",
+	},
+	TableConcept: {
+		"0121": "Remuneración o jornal básico",
+		"0313": "Bonificación extraordinaria temporal - Ley 29351 y 30334",
+		"0406": "Gratificaciones de Fiestas Patrias y Navidad - Ley 29351",
+		"0601": "Comisión AFP porcentual",
+		"0605": "Renta quinta categoría retenciones",
+		"0606": "Prima de seguro AFP",
+		"0607": "Sistema Nacional de Pensiones - D.L. 19990",
+		"0608": "SPP - aportación obligatoria",
+		"0804": "EsSalud seguro regular trabajador",
+	},
+	TableCountry: {
+		"604": "Perú",
+		"862": "Venezuela",
+		"170": "Colombia",
+		"218": "Ecuador",
+		"068": "Bolivia",
+		"152": "Chile",
+	},
+	TableHealthRegime: {
+		"00": "EsSalud regular (exclusivamente)",
+		"01": "EsSalud regular y EPS/servicios propios",
+	},
+}
+
+// Equivalencias entre los valores del sistema y los códigos de SUNAT
+var (
+	documentTypeCodes = map[string]string{"DNI": "01", "CE": "04", "PASAPORTE": "07", "PTP": "23", "CPP": "24"}
+	pensionCodes      = map[PensionSystem]string{
+		PensionONP: "02", PensionIntegra: "21", PensionProfuturo: "23", PensionPrima: "24", PensionHabitat: "25",
+	}
+	contractTypeCodes = map[string]string{"INDEFINIDO": "01", "FIJO": "04"}
+	// La nacionalidad se guarda en ISO 3166-1 alfa-3: Tabla 4 (nacionalidad) y Tabla 26 (país, ISO numérico)
+	nationalityCodes = map[string]struct{ nationality, country string }{
+		"PER": {"9589", "604"},
+		"VEN": {"9850", "862"},
+		"COL": {"9169", "170"},
+		"ECU": {"9239", "218"},
+		"BOL": {"9097", "068"},
+		"CHL": {"9211", "152"},
+	}
+)
+
+// ValidSunatCode indica si el código existe en la tabla.
+func ValidSunatCode(table SunatTable, code string) bool {
+	_, ok := sunatTables[table][code]
+	return ok
+}
+
+// SunatDocumentType devuelve el código de la Tabla 3 del tipo de documento.
+func SunatDocumentType(documentType string) (string, bool) {
+	code, ok := documentTypeCodes[documentType]
+	return code, ok
+}
+
+// SunatPensionRegime devuelve el código de la Tabla 11 del sistema de pensiones.
+func SunatPensionRegime(system PensionSystem) (string, bool) {
+	code, ok := pensionCodes[system]
+	return code, ok
+}
+
+// SunatContractType devuelve el código de la Tabla 12; las modalidades formativas (PRACTICANTE)
+// no son contratos de trabajo y no tienen código.
+func SunatContractType(contractType string) (string, bool) {
+	code, ok := contractTypeCodes[contractType]
+	return code, ok
+}
+
+// SunatNationality devuelve los códigos de la Tabla 4 (nacionalidad) y de la Tabla 26 (país).
+func SunatNationality(iso3 string) (nationality, country string, ok bool) {
+	codes, ok := nationalityCodes[iso3]
+	return codes.nationality, codes.country, ok
+}
+
+// SunatHealthRegime devuelve el código de la Tabla 32 según si el trabajador tiene EPS.
+func SunatHealthRegime(hasEPS bool) string {
+	if hasEPS {
+		return "01"
+	}
+	return "00"
+}
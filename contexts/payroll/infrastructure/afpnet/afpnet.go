@@ -0,0 +1,59 @@
+package afpnet
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+)
+
+// Generator escribe la planilla de carga masiva de AFPnet: un libro XLSX sin cabecera, una fila por
+// afiliado y las columnas en el orden de la plantilla. Los aportes voluntarios no se registran en el
+// sistema y se declaran en cero.
+type Generator struct{}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) ContentType() string {
+	return spreadsheet.XLSX.ContentType()
+}
+
+func (g *Generator) Extension() string {
+	return string(spreadsheet.XLSX)
+}
+
+func (g *Generator) Generate(affiliates []services.AFPnetAffiliate) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := spreadsheet.NewWriter(spreadsheet.XLSX, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.WriteHeader(nil); err != nil {
+		return nil, err
+	}
+	for i, a := range affiliates {
+		// Secuencia, CUSPP, tipo y número de documento, apellidos y nombres, relación laboral,
+		// inicio y cese, excepción de aportar, remuneración asegurable, aportes voluntarios
+		// (con fin previsional, sin fin previsional y del empleador) y rubro
+		err := w.WriteRow([]any{
+			i + 1, a.CUSPP, a.DocumentType, a.DocumentNumber,
+			upper(a.LastNamePaternal), upper(a.LastNameMaternal), upper(a.FirstName),
+			a.EmploymentRelation, a.StartMovement, a.EndMovement, nil,
+			a.InsurableWage, 0.0, 0.0, 0.0, a.WorkerCategory,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func upper(value string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(value), " "))
+}
@@ -0,0 +1,30 @@
+package afpnet_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/afpnet"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+)
+
+func TestGenerator_WritesOneRowPerAffiliateWithoutHeader(t *testing.T) {
+	content, err := afpnet.NewGenerator().Generate([]services.AFPnetAffiliate{
+		{CUSPP: "580201AQMMA5", DocumentType: "0", DocumentNumber: "12345678", LastNamePaternal: "Quispe",
+			LastNameMaternal: "Mamani", FirstName: "Ana  María", EmploymentRelation: "S", StartMovement: "N", EndMovement: "S",
+			InsurableWage: 2516.67, WorkerCategory: "N"},
+		{CUSPP: "611030JPRZL0", DocumentType: "1", DocumentNumber: "001234567", LastNamePaternal: "Pérez",
+			FirstName: "Juan", EmploymentRelation: "S", StartMovement: "S", EndMovement: "N", InsurableWage: 1500, WorkerCategory: "N"},
+	})
+	require.NoError(t, err)
+
+	rows, err := spreadsheet.ReadXLSX(content)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"1", "580201AQMMA5", "0", "12345678", "QUISPE", "MAMANI", "ANA MARÍA", "S", "N", "S", "", "2516.67", "0", "0", "0", "N"},
+		{"2", "611030JPRZL0", "1", "001234567", "PÉREZ", "", "JUAN", "S", "S", "N", "", "1500", "0", "0", "0", "N"},
+	}, rows)
+}
@@ -0,0 +1,94 @@
+package bankfiles_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/bankfiles"
+)
+
+func batch(debitAccount string) services.PaymentBatch {
+	return services.PaymentBatch{
+		DebitAccount: debitAccount,
+		PaymentDate:  time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC),
+		Reference:    "HABERES 202509",
+		Payments: []services.Payment{
+			{
+				DocumentType: "DNI", DocumentNumber: "12345678", Name: "Núñez Peña José",
+				Account:     value_objects.BankAccount{Number: "19312345678012", Kind: value_objects.AccountSavings},
+				AmountCents: 420367,
+			},
+			{
+				DocumentType: "CE", DocumentNumber: "001234567", Name: "Smith O'Brien Ann",
+				Account:     value_objects.BankAccount{Number: "00310000456789123451", Kind: value_objects.AccountCCI},
+				AmountCents: 150000,
+			},
+		},
+	}
+}
+
+func lines(content []byte) []string {
+	return strings.Split(strings.TrimSuffix(string(content), "\r\n"), "\r\n")
+}
+
+func TestBCPGenerator(t *testing.T) {
+	content, err := bankfiles.NewBCPGenerator().Generate(batch("1931234567012"))
+	require.NoError(t, err)
+
+	records := lines(content)
+	require.Len(t, records, 3)
+	header := records[0]
+	assert.Equal(t, "1000002"+"20250930"+"C0001", header[:20])
+	assert.Equal(t, "00000000000570367", header[40:57], "total with 2 implied decimals")
+	// 1234567012 (cargo) + 12345678012 (ahorros) + 004567891234 (CCI)
+	assert.Equal(t, "000018148136258", header[97:112], "checksum")
+
+	assert.Equal(t, "2A19312345678012      112345678    NUNEZ PENA JOSE", records[1][:50])
+	assert.True(t, strings.HasSuffix(records[1], "0001"+"00000000000420367"+"S"), records[1])
+	assert.Equal(t, "2B00310000456789123451"+"3", records[2][:23])
+	assert.Contains(t, records[2], "SMITH O BRIEN ANN")
+	for _, record := range records[1:] {
+		assert.Len(t, record, 1+1+20+1+12+75+40+4+17+1)
+	}
+}
+
+func TestInterbankAndBBVAGenerators_WriteBatchTotals(t *testing.T) {
+	interbank, err := bankfiles.NewInterbankGenerator().Generate(batch("1003001234567"))
+	require.NoError(t, err)
+	header := lines(interbank)[0]
+	assert.Equal(t, "01"+"20250930", header[:10])
+	assert.Equal(t, "01"+"000002"+"000000000570367", header[30:53])
+	assert.Equal(t, "009", lines(interbank)[2][79:82], "CCI credit")
+
+	bbva, err := bankfiles.NewBBVAGenerator().Generate(batch("001102000200123456"))
+	require.NoError(t, err)
+	header = lines(bbva)[0]
+	assert.Equal(t, "700"+"001102000200123456  "+"PEN"+"000000000570367", header[:41])
+	assert.True(t, strings.HasSuffix(header, "000002S"))
+	assert.Equal(t, "002E001234567   I", lines(bbva)[2][:17])
+}
+
+func TestCCIGenerator(t *testing.T) {
+	content, err := bankfiles.NewCCIGenerator().Generate(batch(""))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"H|20250930|2|570367|HABERES 202509",
+		"D|DNI|12345678|NUNEZ PENA JOSE|19312345678012|420367",
+		"D|CE|001234567|SMITH O BRIEN ANN|00310000456789123451|150000",
+	}, lines(content))
+}
+
+func TestGenerators_RejectAmountsThatDoNotFit(t *testing.T) {
+	b := batch("1931234567012")
+	b.Payments[0].AmountCents = 1_000_000_000_000_000
+
+	_, err := bankfiles.NewInterbankGenerator().Generate(b)
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package bankfiles
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+var bbvaDocumentTypes = map[string]string{"DNI": "L", "CE": "E", "PASAPORTE": "P", "RUC": "R", "PTP": "T", "CPP": "T"}
+
+// BBVAGenerator genera el archivo de pago de haberes de BBVA (net cash).
+//
+// Cabecera (tipo 700): cuenta de cargo, moneda, monto total, fecha, referencia y cantidad de abonos.
+// Detalle (tipo 002): documento, tipo de abono (P cuenta propia, I interbancario), cuenta, nombre,
+// monto y referencia. Montos con 2 decimales implícitos.
+type BBVAGenerator struct{}
+
+func NewBBVAGenerator() *BBVAGenerator {
+	return &BBVAGenerator{}
+}
+
+func (g *BBVAGenerator) Bank() value_objects.Bank {
+	return value_objects.BankBBVA
+}
+
+func (g *BBVAGenerator) Extension() string {
+	return "txt"
+}
+
+func (g *BBVAGenerator) Generate(batch services.PaymentBatch) ([]byte, error) {
+	var out strings.Builder
+
+	header, err := new(recordBuilder).
+		raw("700").
+		text(batch.DebitAccount, 20).
+		raw("PEN").
+		number(batch.TotalCents(), 15).
+		raw("A"). // proceso inmediato
+		raw(batch.PaymentDate.Format("20060102")).
+		text(batch.Reference, 25).
+		number(int64(len(batch.Payments)), 6).
+		raw("S"). // validar pertenencia de la cuenta
+		line()
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString(header)
+
+	for _, payment := range batch.Payments {
+		kind := "P"
+		if payment.Account.Kind == value_objects.AccountCCI {
+			kind = "I"
+		}
+		detail, err := new(recordBuilder).
+			raw("002").
+			raw(documentCode(bbvaDocumentTypes, payment.DocumentType, "L")).
+			text(payment.DocumentNumber, 12).
+			raw(kind).
+			text(payment.Account.Number, 20).
+			text(payment.Name, 40).
+			number(payment.AmountCents, 15).
+			text(batch.Reference, 40).
+			line()
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(detail)
+	}
+	return []byte(out.String()), nil
+}
@@ -0,0 +1,54 @@
+package bankfiles
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+// CCIGenerator genera un archivo genérico de transferencias interbancarias, delimitado por "|",
+// para bancos sin formato propio. Todas las cuentas deben ser CCI.
+//
+//	H|fecha|cantidad|monto total|referencia
+//	D|tipo documento|número documento|nombre|CCI|monto
+//
+// Montos con 2 decimales implícitos.
+type CCIGenerator struct{}
+
+func NewCCIGenerator() *CCIGenerator {
+	return &CCIGenerator{}
+}
+
+func (g *CCIGenerator) Bank() value_objects.Bank {
+	return value_objects.BankGeneric
+}
+
+func (g *CCIGenerator) Extension() string {
+	return "txt"
+}
+
+func (g *CCIGenerator) Generate(batch services.PaymentBatch) ([]byte, error) {
+	var out strings.Builder
+
+	out.WriteString(strings.Join([]string{
+		"H",
+		batch.PaymentDate.Format("20060102"),
+		strconv.Itoa(len(batch.Payments)),
+		strconv.FormatInt(batch.TotalCents(), 10),
+		bankText(batch.Reference),
+	}, "|") + lineBreak)
+
+	for _, payment := range batch.Payments {
+		out.WriteString(strings.Join([]string{
+			"D",
+			payment.DocumentType,
+			bankText(payment.DocumentNumber),
+			bankText(payment.Name),
+			payment.Account.Number,
+			strconv.FormatInt(payment.AmountCents, 10),
+		}, "|") + lineBreak)
+	}
+	return []byte(out.String()), nil
+}
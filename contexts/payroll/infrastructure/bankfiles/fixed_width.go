@@ -0,0 +1,76 @@
+package bankfiles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lineBreak - los bancos procesan los archivos en Windows
+const lineBreak = "\r\n"
+
+// transliteration reemplaza las letras que los bancos no aceptan; el resto de caracteres fuera de
+// A-Z, 0-9 se convierte en espacio.
+var transliteration = strings.NewReplacer(
+	"Á", "A", "É", "E", "Í", "I", "Ó", "O", "Ú", "U", "Ü", "U", "Ñ", "N",
+	"À", "A", "È", "E", "Ì", "I", "Ò", "O", "Ù", "U", "Ç", "C",
+)
+
+// bankText deja un texto en mayúsculas ASCII, sin tildes ni símbolos y con espacios simples.
+func bankText(value string) string {
+	value = transliteration.Replace(strings.ToUpper(value))
+	cleaned := strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return ' '
+	}, value)
+	return strings.Join(strings.Fields(cleaned), " ")
+}
+
+// alpha alinea un texto a la izquierda en un campo de ancho fijo, recortándolo si no entra.
+func alpha(value string, width int) string {
+	value = bankText(value)
+	if len(value) > width {
+		return value[:width]
+	}
+	return value + strings.Repeat(" ", width-len(value))
+}
+
+// numeric rellena con ceros a la izquierda; falla si el valor no entra en el campo.
+func numeric(value int64, width int) (string, error) {
+	formatted := fmt.Sprintf("%0*d", width, value)
+	if len(formatted) > width {
+		return "", fmt.Errorf("value %d does not fit in %d digits", value, width)
+	}
+	return formatted, nil
+}
+
+// recordBuilder arma un registro de ancho fijo y guarda el primer error de formato.
+type recordBuilder struct {
+	sb  strings.Builder
+	err error
+}
+
+func (b *recordBuilder) text(value string, width int) *recordBuilder {
+	b.sb.WriteString(alpha(value, width))
+	return b
+}
+
+// raw escribe un valor ya formateado (códigos fijos, fechas).
+func (b *recordBuilder) raw(value string) *recordBuilder {
+	b.sb.WriteString(value)
+	return b
+}
+
+func (b *recordBuilder) number(value int64, width int) *recordBuilder {
+	formatted, err := numeric(value, width)
+	if err != nil && b.err == nil {
+		b.err = err
+	}
+	b.sb.WriteString(formatted)
+	return b
+}
+
+func (b *recordBuilder) line() (string, error) {
+	return b.sb.String() + lineBreak, b.err
+}
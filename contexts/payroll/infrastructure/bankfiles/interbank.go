@@ -0,0 +1,71 @@
+package bankfiles
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+)
+
+var interbankDocumentTypes = map[string]string{"DNI": "01", "RUC": "02", "CE": "03", "PASAPORTE": "05", "PTP": "07", "CPP": "07"}
+
+var interbankAccountTypes = map[value_objects.AccountKind]string{
+	value_objects.AccountChecking: "001",
+	value_objects.AccountSavings:  "002",
+	value_objects.AccountCCI:      "009",
+}
+
+// InterbankGenerator genera el archivo de pago de remuneraciones de Interbank.
+//
+// Cabecera (tipo 01): fecha de pago, cuenta de cargo, moneda, cantidad de abonos, monto total y
+// referencia. Detalle (tipo 02): documento, nombre, tipo y número de cuenta, moneda y monto.
+// Montos con 2 decimales implícitos.
+type InterbankGenerator struct{}
+
+func NewInterbankGenerator() *InterbankGenerator {
+	return &InterbankGenerator{}
+}
+
+func (g *InterbankGenerator) Bank() value_objects.Bank {
+	return value_objects.BankInterbank
+}
+
+func (g *InterbankGenerator) Extension() string {
+	return "txt"
+}
+
+func (g *InterbankGenerator) Generate(batch services.PaymentBatch) ([]byte, error) {
+	var out strings.Builder
+
+	header, err := new(recordBuilder).
+		raw("01").
+		raw(batch.PaymentDate.Format("20060102")).
+		text(batch.DebitAccount, 20).
+		raw("01"). // soles
+		number(int64(len(batch.Payments)), 6).
+		number(batch.TotalCents(), 15).
+		text(batch.Reference, 20).
+		line()
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString(header)
+
+	for _, payment := range batch.Payments {
+		detail, err := new(recordBuilder).
+			raw("02").
+			raw(documentCode(interbankDocumentTypes, payment.DocumentType, "01")).
+			text(payment.DocumentNumber, 15).
+			text(payment.Name, 60).
+			raw(interbankAccountTypes[payment.Account.Kind]).
+			raw("01").
+			text(payment.Account.Number, 20).
+			number(payment.AmountCents, 15).
+			line()
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(detail)
+	}
+	return []byte(out.String()), nil
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/lib/pq"
+)
+
+const uniqueViolationCode = "23505"
+
+const insertPayrollRunQuery = `INSERT INTO payroll_runs (run_id, period, payment_date, status, created_at)
+VALUES ($1, $2, $3, $4, $5)`
+
+const insertPayrollItemQuery = `INSERT INTO payroll_items (
+	item_id, run_id, employee_id, person_id, document_type, document_number, full_name, bank_account,
+	pension_system, contract_type, base_salary, days_worked, gross_pay, total_deductions, net_pay,
+	employer_contributions, concepts, first_name, last_name_paternal, last_name_maternal, cuspp, start_date, end_date
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)`
+
+const selectPayrollRunQuery = `SELECT run_id, period, payment_date, status, created_at FROM payroll_runs WHERE run_id = $1`
+
+// Las boletas se ordenan por nombre para que los archivos generados sean estables
+const selectPayrollItemsQuery = `SELECT item_id, employee_id, person_id, document_type, document_number, full_name,
+	bank_account, pension_system, contract_type, base_salary, days_worked, concepts,
+	first_name, last_name_paternal, last_name_maternal, cuspp, start_date, end_date
+FROM payroll_items WHERE run_id = $1
+ORDER BY full_name, employee_id`
+
+// PayrollRunDataSourcePostgres implementa PayrollRunDataSource usando PostgreSQL
+type PayrollRunDataSourcePostgres struct {
+	db        *sql.DB
+	encrypter *crypto.FieldEncrypter // Documento y cuenta bancaria de cada boleta se guardan cifrados
+}
+
+func NewPayrollRunDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.PayrollRunDataSource {
+	return &PayrollRunDataSourcePostgres{db: db, encrypter: encrypter}
+}
+
+func (ds *PayrollRunDataSourcePostgres) SavePayrollRun(ctx context.Context, run *entities.PayrollRun) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	_, err := querier.ExecContext(ctx, insertPayrollRunQuery,
+		run.ID(), run.Period().String(), run.PaymentDate(), run.Status(), run.CreatedAt())
+	if err != nil {
+		return ds.handleError(err)
+	}
+
+	for _, item := range run.Items() {
+		employee := item.Employee()
+		documentNumber, err := ds.encrypter.Encrypt(employee.DocumentNumber, crypto.PurposePayrollDocumentNumber)
+		if err != nil {
+			return err
+		}
+		bankAccount, err := ds.encrypter.Encrypt(employee.BankAccount, crypto.PurposePayrollBankAccount)
+		if err != nil {
+			return err
+		}
+		concepts, err := json.Marshal(item.Concepts())
+		if err != nil {
+			return err
+		}
+		_, err = querier.ExecContext(ctx, insertPayrollItemQuery,
+			item.ID(), run.ID(), employee.EmployeeID, employee.PersonID, employee.DocumentType, documentNumber,
+			employee.FullName, bankAccount, employee.PensionSystem, employee.ContractType, employee.BaseSalary,
+			item.DaysWorked(), item.GrossPay(), item.TotalDeductions(), item.NetPay(), item.EmployerContributions(),
+			concepts, employee.FirstName, employee.LastNamePaternal, employee.LastNameMaternal, employee.CUSPP,
+			employee.StartDate, employee.EndDate,
+		)
+		if err != nil {
+			return ds.handleError(err)
+		}
+	}
+	return nil
+}
+
+// GetPayrollRunByID reconstruye el cálculo con sus boletas. Devuelve (nil, nil) si no existe.
+func (ds *PayrollRunDataSourcePostgres) GetPayrollRunByID(ctx context.Context, id string) (*entities.PayrollRun, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var (
+		runID, period, status  string
+		paymentDate, createdAt time.Time
+	)
+	err := querier.QueryRowContext(ctx, selectPayrollRunQuery, id).Scan(&runID, &period, &paymentDate, &status, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	runPeriod, err := value_objects.NewPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := querier.QueryContext(ctx, selectPayrollItemsQuery, runID)
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	defer rows.Close()
+
+	var items []*entities.PayrollItem
+	for rows.Next() {
+		item, err := ds.scanPayrollItem(rows, runID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ds.handleError(err)
+	}
+
+	return entities.RestorePayrollRun(runID, runPeriod, paymentDate, entities.PayrollRunStatus(status), items, createdAt), nil
+}
+
+func (ds *PayrollRunDataSourcePostgres) scanPayrollItem(rows *sql.Rows, runID string) (*entities.PayrollItem, error) {
+	var (
+		itemID, pensionSystem string
+		employee              entities.EmployeeSnapshot
+		daysWorked            int
+		concepts              []byte
+		startDate, endDate    sql.NullTime
+	)
+	err := rows.Scan(&itemID, &employee.EmployeeID, &employee.PersonID, &employee.DocumentType, &employee.DocumentNumber,
+		&employee.FullName, &employee.BankAccount, &pensionSystem, &employee.ContractType, &employee.BaseSalary,
+		&daysWorked, &concepts,
+		&employee.FirstName, &employee.LastNamePaternal, &employee.LastNameMaternal, &employee.CUSPP, &startDate, &endDate)
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	employee.PensionSystem = value_objects.PensionSystem(pensionSystem)
+	// Las boletas calculadas antes de guardar las fechas no las tienen
+	employee.StartDate = startDate.Time
+	if endDate.Valid {
+		employee.EndDate = &endDate.Time
+	}
+
+	if employee.DocumentNumber, err = ds.encrypter.Decrypt(employee.DocumentNumber, crypto.PurposePayrollDocumentNumber); err != nil {
+		return nil, err
+	}
+	if employee.BankAccount, err = ds.encrypter.Decrypt(employee.BankAccount, crypto.PurposePayrollBankAccount); err != nil {
+		return nil, err
+	}
+	var itemConcepts []entities.Concept
+	if err := json.Unmarshal(concepts, &itemConcepts); err != nil {
+		return nil, err
+	}
+	return entities.RestorePayrollItem(itemID, runID, employee, daysWorked, itemConcepts), nil
+}
+
+func (ds *PayrollRunDataSourcePostgres) handleError(err error) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if pqErr.Code == uniqueViolationCode {
+			return domain.NewAlreadyExistsError("payroll.period_already_calculated", err)
+		}
+		return infrastructure.NewDBError(fmt.Sprintf("Error de base de datos: %s", pqErr.Message), err)
+	}
+	return infrastructure.NewDBError("Error inesperado de infraestructura", err)
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/lib/pq"
+)
+
+const insertPayslipQuery = `INSERT INTO payslips (
+	payslip_id, run_id, item_id, employee_id, period, content, content_hash, size, generated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+const payslipColumns = `payslip_id, run_id, item_id, employee_id, period, content_hash, size, generated_at,
+	acknowledged_at, COALESCE(acknowledged_by, '')`
+
+const selectPayslipsByRunQuery = `SELECT ` + payslipColumns + ` FROM payslips WHERE run_id = $1 ORDER BY generated_at, payslip_id`
+
+const selectPayslipQuery = `SELECT ` + payslipColumns + ` FROM payslips WHERE payslip_id = $1`
+
+const selectPayslipContentQuery = `SELECT content FROM payslips WHERE payslip_id = $1`
+
+const updatePayslipAcknowledgementQuery = `UPDATE payslips SET acknowledged_at = $2, acknowledged_by = $3 WHERE payslip_id = $1`
+
+// PayslipDataSourcePostgres implementa PayslipDataSource usando PostgreSQL
+type PayslipDataSourcePostgres struct {
+	db        *sql.DB
+	encrypter *crypto.FieldEncrypter // El documento lleva el DNI y la remuneración: se guarda cifrado
+}
+
+func NewPayslipDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.PayslipDataSource {
+	return &PayslipDataSourcePostgres{db: db, encrypter: encrypter}
+}
+
+func (ds *PayslipDataSourcePostgres) SavePayslip(ctx context.Context, payslip *entities.Payslip, content []byte) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	sealedContent, err := ds.encrypter.Encrypt(string(content), crypto.PurposePayslipContent)
+	if err != nil {
+		return infrastructure.NewDBError("Error al cifrar la boleta", err)
+	}
+	_, err = querier.ExecContext(ctx, insertPayslipQuery,
+		payslip.ID(), payslip.RunID(), payslip.ItemID(), payslip.EmployeeID(), payslip.Period().String(),
+		sealedContent, payslip.ContentHash(), payslip.Size(), payslip.GeneratedAt(),
+	)
+	if err != nil {
+		return ds.handleError(err)
+	}
+	return nil
+}
+
+func (ds *PayslipDataSourcePostgres) ListPayslipsByRun(ctx context.Context, runID string) ([]*entities.Payslip, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, selectPayslipsByRunQuery, runID)
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	defer rows.Close()
+
+	var payslips []*entities.Payslip
+	for rows.Next() {
+		payslip, err := scanPayslip(rows)
+		if err != nil {
+			return nil, ds.handleError(err)
+		}
+		payslips = append(payslips, payslip)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ds.handleError(err)
+	}
+	return payslips, nil
+}
+
+// GetPayslipByID devuelve (nil, nil) si la boleta no existe.
+func (ds *PayslipDataSourcePostgres) GetPayslipByID(ctx context.Context, id string) (*entities.Payslip, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	payslip, err := scanPayslip(querier.QueryRowContext(ctx, selectPayslipQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	return payslip, nil
+}
+
+// GetPayslipContent devuelve (nil, nil) si la boleta no existe.
+func (ds *PayslipDataSourcePostgres) GetPayslipContent(ctx context.Context, id string) ([]byte, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var sealedContent string
+	err := querier.QueryRowContext(ctx, selectPayslipContentQuery, id).Scan(&sealedContent)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	content, err := ds.encrypter.Decrypt(sealedContent, crypto.PurposePayslipContent)
+	if err != nil {
+		return nil, infrastructure.NewDBError("Error al descifrar la boleta", err)
+	}
+	return []byte(content), nil
+}
+
+func (ds *PayslipDataSourcePostgres) SaveAcknowledgement(ctx context.Context, payslip *entities.Payslip) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	_, err := querier.ExecContext(ctx, updatePayslipAcknowledgementQuery,
+		payslip.ID(), payslip.AcknowledgedAt(), payslip.AcknowledgedBy())
+	if err != nil {
+		return ds.handleError(err)
+	}
+	return nil
+}
+
+// rowScanner es lo común a *sql.Row y *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPayslip(row rowScanner) (*entities.Payslip, error) {
+	var (
+		id, runID, itemID, employeeID, period, contentHash, acknowledgedBy string
+		size                                                               int
+		generatedAt                                                        time.Time
+		acknowledgedAt                                                     sql.NullTime
+	)
+	err := row.Scan(&id, &runID, &itemID, &employeeID, &period, &contentHash, &size, &generatedAt,
+		&acknowledgedAt, &acknowledgedBy)
+	if err != nil {
+		return nil, err
+	}
+	payslipPeriod, err := value_objects.NewPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	var acknowledged *time.Time
+	if acknowledgedAt.Valid {
+		acknowledged = &acknowledgedAt.Time
+	}
+	return entities.RestorePayslip(id, runID, itemID, employeeID, payslipPeriod, contentHash, size, generatedAt,
+		acknowledged, acknowledgedBy), nil
+}
+
+func (ds *PayslipDataSourcePostgres) handleError(err error) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if pqErr.Code == uniqueViolationCode {
+			return domain.NewAlreadyExistsError("payroll.payslip_already_generated", err)
+		}
+		return infrastructure.NewDBError(fmt.Sprintf("Error de base de datos: %s", pqErr.Message), err)
+	}
+	return infrastructure.NewDBError("Error inesperado de infraestructura", err)
+}
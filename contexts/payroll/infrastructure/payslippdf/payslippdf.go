@@ -0,0 +1,215 @@
+package payslippdf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/pdf"
+)
+
+const (
+	margin      = 40.0
+	right       = pdf.PageWidth - margin
+	rowHeight   = 14.0
+	labelSize   = 8.0
+	valueSize   = 9.0
+	conceptSize = 9.0
+)
+
+// Columnas de importes de la tabla de conceptos, alineadas a la derecha
+const (
+	codeX         = margin + 6
+	descriptionX  = margin + 46
+	incomeX       = 385.0
+	deductionX    = 470.0
+	contributionX = right - 6
+)
+
+var months = [...]string{"", "Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio", "Julio", "Agosto",
+	"Setiembre", "Octubre", "Noviembre", "Diciembre"}
+
+// Renderer dibuja la boleta de pago en una página A4 con el formato habitual: datos del empleador y
+// del trabajador, una tabla de conceptos con columnas de ingresos, descuentos y aportes del
+// empleador, el neto a pagar y los espacios de firma. Una boleta tiene a lo sumo una decena de
+// conceptos, por lo que siempre cabe en una página.
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+func (r *Renderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (r *Renderer) Extension() string {
+	return "pdf"
+}
+
+func (r *Renderer) Render(document services.PayslipDocument) ([]byte, error) {
+	employee := document.Employee
+	doc := pdf.New(fmt.Sprintf("Boleta de pago %s - %s", document.Period, employee.FullName))
+	page := doc.AddPage()
+
+	y := pdf.PageHeight - margin - 10
+	title := "BOLETA DE PAGO DE REMUNERACIONES"
+	page.Text((pdf.PageWidth-pdf.TextWidth(pdf.Bold, 14, title))/2, y, pdf.Bold, 14, title)
+	y -= 14
+	subtitle := "D.S. N° 001-98-TR - Periodo " + periodName(document)
+	page.Text((pdf.PageWidth-pdf.TextWidth(pdf.Regular, valueSize, subtitle))/2, y, pdf.Regular, valueSize, subtitle)
+
+	// Empleador
+	y -= 26
+	y = fields(page, y, "EMPLEADOR", [][]field{
+		{{"RUC", document.Employer.RUC}},
+		{{"Razón social", document.Employer.BusinessName}},
+		{{"Domicilio fiscal", document.Employer.Address}},
+	})
+
+	// Trabajador
+	y -= 12
+	y = fields(page, y, "TRABAJADOR", [][]field{
+		{{"Apellidos y nombres", employee.FullName}},
+		{{"Documento", strings.TrimSpace(employee.DocumentType + " " + employee.DocumentNumber)}, {"Tipo de contrato", employee.ContractType}},
+		{{"Fecha de ingreso", formatDate(employee.StartDate)}, {"Fecha de cese", formatDatePtr(employee.EndDate)}},
+		{{"Sistema de pensiones", pensionSystem(employee)}, {"Remuneración básica", "S/ " + formatAmount(employee.BaseSalary)}},
+		{{"Días laborados", strconv.Itoa(document.DaysWorked)}, {"Fecha de pago", formatDate(document.PaymentDate)}},
+	})
+
+	// Conceptos
+	y -= 12
+	page.FillRect(margin, y-5, right-margin, rowHeight+2, 0.85)
+	page.Text(codeX, y, pdf.Bold, labelSize, "Código")
+	page.Text(descriptionX, y, pdf.Bold, labelSize, "Concepto")
+	page.TextRight(incomeX, y, pdf.Bold, labelSize, "Ingresos")
+	page.TextRight(deductionX, y, pdf.Bold, labelSize, "Descuentos")
+	page.TextRight(contributionX, y, pdf.Bold, labelSize, "Aportes empleador")
+	tableTop := y + rowHeight - 3
+	y -= rowHeight + 2
+	for _, concepts := range [][]entities.Concept{document.Incomes, document.Deductions, document.EmployerContributions} {
+		for _, concept := range concepts {
+			page.Text(codeX, y, pdf.Regular, conceptSize, concept.Code)
+			page.Text(descriptionX, y, pdf.Regular, conceptSize, concept.Description)
+			page.TextRight(amountColumn(concept.Kind), y, pdf.Regular, conceptSize, formatAmount(concept.Amount))
+			y -= rowHeight
+		}
+	}
+	page.Line(margin, y+rowHeight-4, right, y+rowHeight-4, 0.5)
+	page.Text(descriptionX, y, pdf.Bold, conceptSize, "Totales")
+	page.TextRight(incomeX, y, pdf.Bold, conceptSize, formatAmount(document.GrossPay))
+	page.TextRight(deductionX, y, pdf.Bold, conceptSize, formatAmount(document.TotalDeductions))
+	page.TextRight(contributionX, y, pdf.Bold, conceptSize, formatAmount(document.TotalEmployerContributions))
+	page.StrokeRect(margin, y-5, right-margin, tableTop-(y-5), 0.5)
+
+	// Neto a pagar
+	y -= 30
+	page.FillRect(incomeX-90, y-6, right-(incomeX-90), rowHeight+8, 0.9)
+	page.Text(incomeX-82, y, pdf.Bold, 11, "NETO A PAGAR")
+	page.TextRight(contributionX, y, pdf.Bold, 11, "S/ "+formatAmount(document.NetPay))
+
+	// Firmas
+	y -= 90
+	signatureWidth := 180.0
+	page.Line(margin+20, y, margin+20+signatureWidth, y, 0.5)
+	page.Line(right-20-signatureWidth, y, right-20, y, 0.5)
+	page.Text(margin+20, y-12, pdf.Regular, labelSize, "Empleador")
+	page.Text(right-20-signatureWidth, y-12, pdf.Regular, labelSize, "Trabajador - Recibí conforme")
+	page.Text(right-20-signatureWidth, y-22, pdf.Regular, labelSize, employee.FullName)
+
+	return doc.Bytes(), nil
+}
+
+// field - etiqueta y valor de un recuadro de datos
+type field struct {
+	label, value string
+}
+
+// fields dibuja un recuadro con una fila por cada grupo de campos, que se reparten el ancho; devuelve
+// la altura de la fila siguiente.
+func fields(page *pdf.Page, y float64, title string, rows [][]field) float64 {
+	page.Text(margin, y, pdf.Bold, valueSize, title)
+	top := y - 5
+	y -= rowHeight + 4
+	for _, row := range rows {
+		columnWidth := (right - margin) / float64(len(row))
+		for i, f := range row {
+			x := margin + 6 + float64(i)*columnWidth
+			page.Text(x, y, pdf.Regular, labelSize, f.label+":")
+			page.Text(x+95, y, pdf.Regular, valueSize, fit(f.value, columnWidth-107))
+		}
+		y -= rowHeight
+	}
+	bottom := y + rowHeight - 5
+	page.StrokeRect(margin, bottom, right-margin, top-bottom, 0.5)
+	return y
+}
+
+// fit recorta el texto para que no invada la columna siguiente.
+func fit(text string, width float64) string {
+	if pdf.TextWidth(pdf.Regular, valueSize, text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.TextWidth(pdf.Regular, valueSize, string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+func amountColumn(kind entities.ConceptKind) float64 {
+	switch kind {
+	case entities.ConceptDeduction:
+		return deductionX
+	case entities.ConceptEmployer:
+		return contributionX
+	default:
+		return incomeX
+	}
+}
+
+func periodName(document services.PayslipDocument) string {
+	return fmt.Sprintf("%s %d", months[document.Period.Month()], document.Period.Year())
+}
+
+func pensionSystem(employee entities.EmployeeSnapshot) string {
+	if employee.CUSPP == "" {
+		return string(employee.PensionSystem)
+	}
+	return fmt.Sprintf("%s (CUSPP %s)", employee.PensionSystem, employee.CUSPP)
+}
+
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return "-"
+	}
+	return date.Format("02/01/2006")
+}
+
+func formatDatePtr(date *time.Time) string {
+	if date == nil {
+		return "-"
+	}
+	return formatDate(*date)
+}
+
+// formatAmount escribe el importe con separador de miles: 12,345.67
+func formatAmount(amount float64) string {
+	value := strconv.FormatFloat(amount, 'f', 2, 64)
+	sign := ""
+	if strings.HasPrefix(value, "-") {
+		sign, value = "-", value[1:]
+	}
+	integer, decimals := value[:len(value)-3], value[len(value)-3:]
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return sign + grouped.String() + decimals
+}
@@ -0,0 +1,55 @@
+package payslippdf_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/value_objects"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/payslippdf"
+)
+
+func payslipDocument() services.PayslipDocument {
+	period, _ := value_objects.NewPeriod("2025-09")
+	run := entities.NewPayrollRun(period, period.End())
+	item := entities.NewPayrollItem(entities.EmployeeSnapshot{
+		EmployeeID: "emp-1", DocumentType: "DNI", DocumentNumber: "12345678", FullName: "Núñez Quispe, Ana",
+		PensionSystem: value_objects.PensionPrima, CUSPP: "580201AQMMA5", ContractType: "INDEFINIDO",
+		BaseSalary: 12000, StartDate: time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC),
+	}, 30, []entities.Concept{
+		{Code: services.ConceptBasicPay, Description: "Remuneración básica", Kind: entities.ConceptIncome, Amount: 12000},
+		{Code: services.ConceptAFPContribution, Description: "AFP aporte obligatorio", Kind: entities.ConceptDeduction, Amount: 1200},
+		{Code: services.ConceptEsSalud, Description: "EsSalud", Kind: entities.ConceptEmployer, Amount: 1080},
+	})
+	run.AddItem(item)
+	employer := services.PayslipEmployer{RUC: "20123456789", BusinessName: "Andes Servicios S.A.C.", Address: "Av. Arequipa 123, Lima"}
+	return services.NewPayslipDocument(employer, run, item)
+}
+
+func TestRenderer_DrawsEmployerEmployeeAndEveryConcept(t *testing.T) {
+	content, err := payslippdf.NewRenderer().Render(payslipDocument())
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+	for _, text := range []string{
+		"(20123456789)", "(Andes Servicios S.A.C.)", "(DNI 12345678)", "(N\xfa\xf1ez Quispe, Ana)",
+		"Periodo Setiembre 2025)", "(12,000.00)", "(1,200.00)", "(1,080.00)", "(S/ 10,800.00)", "(PRIMA \\(CUSPP 580201AQMMA5\\))",
+	} {
+		assert.Contains(t, string(content), text)
+	}
+}
+
+func TestRenderer_SameDocumentProducesTheSameBytes(t *testing.T) {
+	renderer := payslippdf.NewRenderer()
+	first, err := renderer.Render(payslipDocument())
+	require.NoError(t, err)
+	second, err := renderer.Render(payslipDocument())
+	require.NoError(t, err)
+
+	assert.Equal(t, entities.ContentHash(first), entities.ContentHash(second))
+}
@@ -0,0 +1,33 @@
+-- Cálculo de planilla de un periodo (uno por mes)
+CREATE TABLE payroll_runs (
+    run_id UUID PRIMARY KEY,
+    period CHAR(7) NOT NULL UNIQUE, -- YYYY-MM
+    payment_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT now()
+);
+
+-- Boleta de cada trabajador: foto de sus datos al calcular, para que los archivos de pago y las
+-- declaraciones no cambien si luego se modifica el empleado
+CREATE TABLE payroll_items (
+    item_id UUID PRIMARY KEY,
+    run_id UUID NOT NULL REFERENCES payroll_runs(run_id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(employee_id),
+    person_id UUID NOT NULL,
+    document_type VARCHAR(20) NOT NULL,
+    document_number TEXT NOT NULL, -- cifrado
+    full_name VARCHAR(300) NOT NULL,
+    bank_account TEXT NOT NULL,    -- cifrado
+    pension_system VARCHAR(20) NOT NULL,
+    contract_type VARCHAR(20) NOT NULL,
+    base_salary NUMERIC(12,2) NOT NULL,
+    days_worked INT NOT NULL,
+    gross_pay NUMERIC(12,2) NOT NULL,
+    total_deductions NUMERIC(12,2) NOT NULL,
+    net_pay NUMERIC(12,2) NOT NULL,
+    employer_contributions NUMERIC(12,2) NOT NULL,
+    concepts JSONB NOT NULL, -- [{code, description, kind, amount}]
+    UNIQUE (run_id, employee_id)
+);
+
+CREATE INDEX idx_payroll_items_employee ON payroll_items(employee_id);
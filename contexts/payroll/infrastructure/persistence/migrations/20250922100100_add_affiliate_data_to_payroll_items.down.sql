@@ -0,0 +1,6 @@
+ALTER TABLE payroll_items DROP COLUMN IF EXISTS end_date;
+ALTER TABLE payroll_items DROP COLUMN IF EXISTS start_date;
+ALTER TABLE payroll_items DROP COLUMN IF EXISTS cuspp;
+ALTER TABLE payroll_items DROP COLUMN IF EXISTS last_name_maternal;
+ALTER TABLE payroll_items DROP COLUMN IF EXISTS last_name_paternal;
+ALTER TABLE payroll_items DROP COLUMN IF EXISTS first_name;
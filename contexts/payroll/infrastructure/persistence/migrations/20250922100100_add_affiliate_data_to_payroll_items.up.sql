@@ -0,0 +1,8 @@
+-- Datos del afiliado para la declaración en AFPnet: nombres por separado, CUSPP y fechas de ingreso
+-- y cese, con los que se derivan los movimientos de inicio y fin de la relación laboral
+ALTER TABLE payroll_items ADD COLUMN first_name VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE payroll_items ADD COLUMN last_name_paternal VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE payroll_items ADD COLUMN last_name_maternal VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE payroll_items ADD COLUMN cuspp VARCHAR(12) NOT NULL DEFAULT '';
+ALTER TABLE payroll_items ADD COLUMN start_date DATE;
+ALTER TABLE payroll_items ADD COLUMN end_date DATE;
@@ -0,0 +1,18 @@
+-- Boleta de pago emitida en PDF: una por boleta calculada. El documento se guarda cifrado y su
+-- hash SHA-256 es lo que el trabajador firma en la constancia de recepción
+CREATE TABLE payslips (
+    payslip_id UUID PRIMARY KEY,
+    run_id UUID NOT NULL REFERENCES payroll_runs(run_id) ON DELETE CASCADE,
+    item_id UUID NOT NULL UNIQUE REFERENCES payroll_items(item_id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(employee_id),
+    period CHAR(7) NOT NULL, -- YYYY-MM
+    content TEXT NOT NULL,   -- cifrado
+    content_hash CHAR(64) NOT NULL,
+    size INT NOT NULL,
+    generated_at TIMESTAMP NOT NULL DEFAULT now(),
+    acknowledged_at TIMESTAMP,
+    acknowledged_by VARCHAR(100)
+);
+
+CREATE INDEX idx_payslips_run ON payslips(run_id);
+CREATE INDEX idx_payslips_employee ON payslips(employee_id);
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+)
+
+// PayrollRunRepositoryImpl implementa PayrollRunRepository usando un DataSource
+type PayrollRunRepositoryImpl struct {
+	dataSource datasource.PayrollRunDataSource
+}
+
+func NewPayrollRunRepositoryImpl(dataSource datasource.PayrollRunDataSource) repositories.PayrollRunRepository {
+	return &PayrollRunRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *PayrollRunRepositoryImpl) SavePayrollRun(ctx context.Context, run *entities.PayrollRun) error {
+	return r.dataSource.SavePayrollRun(ctx, run)
+}
+
+func (r *PayrollRunRepositoryImpl) GetPayrollRunByID(ctx context.Context, id string) (*entities.PayrollRun, error) {
+	return r.dataSource.GetPayrollRunByID(ctx, id)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/datasource"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/repositories"
+)
+
+// PayslipRepositoryImpl implementa PayslipRepository usando un DataSource
+type PayslipRepositoryImpl struct {
+	dataSource datasource.PayslipDataSource
+}
+
+func NewPayslipRepositoryImpl(dataSource datasource.PayslipDataSource) repositories.PayslipRepository {
+	return &PayslipRepositoryImpl{dataSource: dataSource}
+}
+
+func (r *PayslipRepositoryImpl) SavePayslip(ctx context.Context, payslip *entities.Payslip, content []byte) error {
+	return r.dataSource.SavePayslip(ctx, payslip, content)
+}
+
+func (r *PayslipRepositoryImpl) ListPayslipsByRun(ctx context.Context, runID string) ([]*entities.Payslip, error) {
+	return r.dataSource.ListPayslipsByRun(ctx, runID)
+}
+
+func (r *PayslipRepositoryImpl) GetPayslipByID(ctx context.Context, id string) (*entities.Payslip, error) {
+	return r.dataSource.GetPayslipByID(ctx, id)
+}
+
+func (r *PayslipRepositoryImpl) GetPayslipContent(ctx context.Context, id string) ([]byte, error) {
+	return r.dataSource.GetPayslipContent(ctx, id)
+}
+
+func (r *PayslipRepositoryImpl) SaveAcknowledgement(ctx context.Context, payslip *entities.Payslip) error {
+	return r.dataSource.SaveAcknowledgement(ctx, payslip)
+}
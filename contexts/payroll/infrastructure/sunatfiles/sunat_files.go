@@ -0,0 +1,116 @@
+package sunatfiles
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+)
+
+// Códigos fijos de T-Registro para el régimen de la empresa
+const (
+	laborRegime      = "01" // Tabla 33: régimen privado general D.Leg. 728
+	monthlyPayment   = "1"  // Tabla 13: periodicidad mensual
+	activeSituation  = "11" // Tabla 15: activo o subsidiado
+	depositPayment   = "2"  // Tabla 16: depósito en cuenta
+	workerCategory   = "1"  // categoría: trabajador
+	recordPeriod     = "1"  // tipo de registro en .per: periodo laboral
+	recordWorkerType = "2"  // tipo de trabajador
+	recordHealth     = "3"  // régimen de aseguramiento de salud
+	recordPension    = "4"  // régimen pensionario
+	noFlag           = "0"
+)
+
+// Generator escribe los archivos de importación de T-Registro y de la PLAME: un registro por línea,
+// cada campo terminado en "|", fechas dd/mm/aaaa e importes con 2 decimales y punto decimal. Los
+// archivos se codifican en ISO-8859-1, como los espera el importador de SUNAT (Ñ y tildes incluidas).
+type Generator struct{}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) TRegistro(file services.SunatFile, workers []services.SunatWorker) ([]byte, error) {
+	if !file.IsTRegistro() {
+		return nil, fmt.Errorf("%s is not a T-Registro file", file)
+	}
+	var out strings.Builder
+	for _, w := range workers {
+		id := []string{w.DocumentType, w.DocumentNumber, w.Country}
+		switch file {
+		case services.FileIDE:
+			writeRecord(&out, id, w.Nationality, date(w.BirthDate), upper(w.LastNamePaternal), upper(w.LastNameMaternal),
+				upper(w.FirstName), w.Gender, w.Phone, w.Email, upper(w.Street), w.Ubigeo)
+		case services.FileTRA:
+			// Situación educativa, ocupación, SCTR y categoría ocupacional no se registran
+			// en el sistema: quedan vacíos para completarlos en T-Registro
+			writeRecord(&out, id, laborRegime, "", "", noFlag, w.CUSPP, "", w.ContractType, noFlag, noFlag, noFlag, noFlag,
+				monthlyPayment, amount(w.BaseSalary), activeSituation, noFlag, noFlag, depositPayment, "", noFlag)
+		case services.FilePER:
+			writeRecord(&out, id, workerCategory, recordPeriod, date(w.StartDate), "", "", "")
+			writeRecord(&out, id, workerCategory, recordWorkerType, date(w.StartDate), "", "", w.WorkerType)
+			writeRecord(&out, id, workerCategory, recordHealth, date(w.StartDate), "", "", w.HealthRegime)
+			writeRecord(&out, id, workerCategory, recordPension, date(w.StartDate), "", "", w.PensionRegime)
+		}
+	}
+	return latin1(out.String()), nil
+}
+
+func (g *Generator) Plame(file services.SunatFile, payslips []services.SunatPayslip) ([]byte, error) {
+	if !file.IsPlame() {
+		return nil, fmt.Errorf("%s is not a PLAME file", file)
+	}
+	var out strings.Builder
+	for _, p := range payslips {
+		id := []string{p.DocumentType, p.DocumentNumber}
+		switch file {
+		case services.FileREM:
+			// Monto devengado y monto pagado: la planilla se paga en el mismo periodo
+			for _, concept := range p.Concepts {
+				writeRecord(&out, id, concept.Code, amount(concept.Amount), amount(concept.Amount))
+			}
+		case services.FileJOR:
+			writeRecord(&out, id, strconv.Itoa(p.HoursWorked), "0", "0", "0")
+		case services.FileSNL:
+			// El sistema no registra suspensiones (descansos médicos, licencias): el archivo va vacío
+		}
+	}
+	return latin1(out.String()), nil
+}
+
+func writeRecord(out *strings.Builder, id []string, fields ...string) {
+	for _, field := range append(id, fields...) {
+		out.WriteString(strings.ReplaceAll(field, "|", " "))
+		out.WriteString("|")
+	}
+	out.WriteString("\r\n")
+}
+
+func date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("02/01/2006")
+}
+
+func amount(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+func upper(value string) string {
+	return strings.ToUpper(strings.TrimSpace(value))
+}
+
+// latin1 codifica el texto en ISO-8859-1; lo que no existe en ese juego de caracteres pasa a "?".
+func latin1(value string) []byte {
+	encoded := make([]byte, 0, len(value))
+	for _, r := range value {
+		if r > 0xFF {
+			r = '?'
+		}
+		encoded = append(encoded, byte(r))
+	}
+	return encoded
+}
@@ -0,0 +1,62 @@
+package sunatfiles_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/entities"
+	"github.com/kevinsoras/employee-management/contexts/payroll/domain/services"
+	"github.com/kevinsoras/employee-management/contexts/payroll/infrastructure/sunatfiles"
+)
+
+var worker = services.SunatWorker{
+	DocumentType: "01", DocumentNumber: "12345678",
+	BirthDate:        time.Date(1990, 3, 1, 0, 0, 0, 0, time.UTC),
+	LastNamePaternal: "Núñez", LastNameMaternal: "Peña", FirstName: "José",
+	Gender: "1", Nationality: "9589", Email: "jose@empresa.pe", Street: "Av. Arequipa 100", Ubigeo: "150101",
+	WorkerType: "21", ContractType: "01", PensionRegime: "02", HealthRegime: "00",
+	StartDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), BaseSalary: 3000,
+}
+
+func TestTRegistro_IDEIsPipeDelimitedLatin1(t *testing.T) {
+	content, err := sunatfiles.NewGenerator().TRegistro(services.FileIDE, []services.SunatWorker{worker})
+	require.NoError(t, err)
+
+	expected := "01|12345678||9589|01/03/1990|N\xda\xd1EZ|PE\xd1A|JOS\xc9|1||jose@empresa.pe|AV. AREQUIPA 100|150101|\r\n"
+	assert.Equal(t, expected, string(content))
+}
+
+func TestTRegistro_PERDeclaresEachRegime(t *testing.T) {
+	content, err := sunatfiles.NewGenerator().TRegistro(services.FilePER, []services.SunatWorker{worker})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"01|12345678||1|1|15/01/2024||||",
+		"01|12345678||1|2|15/01/2024|||21|",
+		"01|12345678||1|3|15/01/2024|||00|",
+		"01|12345678||1|4|15/01/2024|||02|",
+	}, strings.Split(strings.TrimSuffix(string(content), "\r\n"), "\r\n"))
+}
+
+func TestPlame_REMHasOneLinePerConcept(t *testing.T) {
+	payslip := services.SunatPayslip{DocumentType: "01", DocumentNumber: "12345678", HoursWorked: 240, Concepts: []entities.Concept{
+		{Code: "0121", Amount: 3000},
+		{Code: "0607", Amount: 390},
+	}}
+	generator := sunatfiles.NewGenerator()
+
+	rem, err := generator.Plame(services.FileREM, []services.SunatPayslip{payslip})
+	require.NoError(t, err)
+	assert.Equal(t, "01|12345678|0121|3000.00|3000.00|\r\n01|12345678|0607|390.00|390.00|\r\n", string(rem))
+
+	jor, err := generator.Plame(services.FileJOR, []services.SunatPayslip{payslip})
+	require.NoError(t, err)
+	assert.Equal(t, "01|12345678|240|0|0|0|\r\n", string(jor))
+
+	_, err = generator.Plame(services.FileIDE, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,155 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// PayrollController handles payroll runs and the files derived from them.
+type PayrollController struct {
+	logger                  *slog.Logger
+	createPayrollRunUseCase application.UseCase[usecases.CreatePayrollRunCommand, dto.PayrollRunResponse]
+	getPayrollRunUseCase    application.UseCase[usecases.GetPayrollRunQuery, dto.PayrollRunResponse]
+	generateBankFileUseCase application.UseCase[usecases.GenerateBankFileQuery, dto.BankFileResponse]
+	exportAFPnetUseCase     application.UseCase[usecases.ExportAFPnetQuery, dto.AFPnetFileResponse]
+}
+
+// NewPayrollController creates a new controller with dependencies wired up.
+func NewPayrollController(
+	logger *slog.Logger,
+	createPayrollRunUseCase application.UseCase[usecases.CreatePayrollRunCommand, dto.PayrollRunResponse],
+	getPayrollRunUseCase application.UseCase[usecases.GetPayrollRunQuery, dto.PayrollRunResponse],
+	generateBankFileUseCase application.UseCase[usecases.GenerateBankFileQuery, dto.BankFileResponse],
+	exportAFPnetUseCase application.UseCase[usecases.ExportAFPnetQuery, dto.AFPnetFileResponse],
+) *PayrollController {
+	return &PayrollController{
+		logger:                  logger,
+		createPayrollRunUseCase: createPayrollRunUseCase,
+		getPayrollRunUseCase:    getPayrollRunUseCase,
+		generateBankFileUseCase: generateBankFileUseCase,
+		exportAFPnetUseCase:     exportAFPnetUseCase,
+	}
+}
+
+// HandleCreate calculates the payroll of a period.
+// @Summary Calculate a payroll run
+// @Description Calculates the payslip of every employee hired by the end of the period and stores it.
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param run body dto.CreatePayrollRunRequest true "Period to calculate"
+// @Param Idempotency-Key header string false "Key that makes retries replay the original response"
+// @Success 201 {object} utils.APIResponse "Payroll run calculated"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 409 {object} utils.ProblemDetails "The period was already calculated"
+// @Failure 422 {object} utils.ProblemDetails "Employees that cannot be calculated"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs [post]
+func (c *PayrollController) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var createDTO dto.CreatePayrollRunRequest
+	if err := utils.ValidateAndBind(r, &createDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	resp, err := c.createPayrollRunUseCase.Execute(r.Context(), usecases.CreatePayrollRunCommand{Data: createDTO})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Successfully calculated payroll run", "runID", resp.ID, "period", resp.Period, "employees", resp.EmployeeCount)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "payroll.run_created", resp))
+}
+
+// HandleGet returns a payroll run with its payslips.
+// @Summary Get a payroll run
+// @Tags Payroll
+// @Produce json
+// @Param id path string true "Payroll run ID"
+// @Success 200 {object} utils.APIResponse "Payroll run found"
+// @Failure 404 {object} utils.ProblemDetails "Payroll run not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs/{id} [get]
+func (c *PayrollController) HandleGet(w http.ResponseWriter, r *http.Request) {
+	resp, err := c.getPayrollRunUseCase.Execute(r.Context(), usecases.GetPayrollRunQuery{RunID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "payroll.run_found", resp))
+}
+
+// HandleBankFile downloads the salary transfer file of a payroll run.
+// @Summary Download a bank payment file
+// @Description Generates the payment file in the bank's format. The batch totals are also returned in the X-Batch-Count and X-Batch-Total headers.
+// @Tags Payroll
+// @Produce plain
+// @Param id path string true "Payroll run ID"
+// @Param bank path string true "Format" Enums(bcp, interbank, bbva, cci)
+// @Success 200 {file} file "Payment file"
+// @Failure 400 {object} utils.ProblemDetails "Unknown bank"
+// @Failure 404 {object} utils.ProblemDetails "Payroll run not found"
+// @Failure 422 {object} utils.ProblemDetails "Invalid bank accounts"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs/{id}/bank-files/{bank} [get]
+func (c *PayrollController) HandleBankFile(w http.ResponseWriter, r *http.Request) {
+	query := usecases.GenerateBankFileQuery{RunID: r.PathValue("id"), Bank: r.PathValue("bank")}
+	file, err := c.generateBankFileUseCase.Execute(r.Context(), query)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Generated bank payment file", "runID", query.RunID, "bank", query.Bank, "payments", file.Payments)
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	w.Header().Set("X-Batch-Count", strconv.Itoa(file.Payments))
+	w.Header().Set("X-Batch-Total", strconv.FormatFloat(file.TotalAmount, 'f', 2, 64))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Content)
+}
+
+// HandleAFPnet downloads the contribution file of one AFP for a payroll run.
+// @Summary Download an AFPnet contribution file
+// @Description Generates the AFPnet upload spreadsheet with the affiliates of the AFP, their CUSPP, insurable wage and start/termination movements. The number of affiliates and the insurable total are also returned in the X-Record-Count and X-Insurable-Total headers.
+// @Tags Payroll
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param id path string true "Payroll run ID"
+// @Param afp path string true "AFP" Enums(habitat, integra, prima, profuturo)
+// @Success 200 {file} file "AFPnet file"
+// @Failure 400 {object} utils.ProblemDetails "Unknown AFP"
+// @Failure 404 {object} utils.ProblemDetails "Payroll run not found"
+// @Failure 422 {object} utils.ProblemDetails "Affiliates that cannot be declared"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs/{id}/afpnet/{afp} [get]
+func (c *PayrollController) HandleAFPnet(w http.ResponseWriter, r *http.Request) {
+	query := usecases.ExportAFPnetQuery{RunID: r.PathValue("id"), AFP: r.PathValue("afp")}
+	file, err := c.exportAFPnetUseCase.Execute(r.Context(), query)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Generated AFPnet file", "runID", query.RunID, "afp", query.AFP, "affiliates", file.Affiliates)
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	w.Header().Set("X-Record-Count", strconv.Itoa(file.Affiliates))
+	w.Header().Set("X-Insurable-Total", strconv.FormatFloat(file.TotalInsurable, 'f', 2, 64))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Content)
+}
@@ -0,0 +1,146 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// PayslipController handles the issued payslip documents and their acknowledgement.
+type PayslipController struct {
+	logger                    *slog.Logger
+	generatePayslipsUseCase   application.UseCase[usecases.GeneratePayslipsCommand, dto.PayslipListResponse]
+	listPayslipsUseCase       application.UseCase[usecases.ListPayslipsQuery, dto.PayslipListResponse]
+	downloadPayslipUseCase    application.UseCase[usecases.DownloadPayslipQuery, dto.PayslipFileResponse]
+	acknowledgePayslipUseCase application.UseCase[usecases.AcknowledgePayslipCommand, dto.PayslipResponse]
+}
+
+// NewPayslipController creates a new controller with dependencies wired up.
+func NewPayslipController(
+	logger *slog.Logger,
+	generatePayslipsUseCase application.UseCase[usecases.GeneratePayslipsCommand, dto.PayslipListResponse],
+	listPayslipsUseCase application.UseCase[usecases.ListPayslipsQuery, dto.PayslipListResponse],
+	downloadPayslipUseCase application.UseCase[usecases.DownloadPayslipQuery, dto.PayslipFileResponse],
+	acknowledgePayslipUseCase application.UseCase[usecases.AcknowledgePayslipCommand, dto.PayslipResponse],
+) *PayslipController {
+	return &PayslipController{
+		logger:                    logger,
+		generatePayslipsUseCase:   generatePayslipsUseCase,
+		listPayslipsUseCase:       listPayslipsUseCase,
+		downloadPayslipUseCase:    downloadPayslipUseCase,
+		acknowledgePayslipUseCase: acknowledgePayslipUseCase,
+	}
+}
+
+// HandleGenerate issues the payslips of a payroll run.
+// @Summary Generate payslips
+// @Description Renders the PDF payslip of every employee of the run that does not have one yet and stores it with its SHA-256 hash. Payslips already issued are kept as they are.
+// @Tags Payslips
+// @Produce json
+// @Param id path string true "Payroll run ID"
+// @Success 201 {object} utils.APIResponse "Payslips generated"
+// @Failure 404 {object} utils.ProblemDetails "Payroll run not found"
+// @Failure 422 {object} utils.ProblemDetails "The employer is not registered as a juridical person"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs/{id}/payslips [post]
+func (c *PayslipController) HandleGenerate(w http.ResponseWriter, r *http.Request) {
+	cmd := usecases.GeneratePayslipsCommand{RunID: r.PathValue("id")}
+	resp, err := c.generatePayslipsUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Generated payslips", "runID", cmd.RunID, "generated", resp.Generated, "total", len(resp.Payslips))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "payroll.payslips_generated", resp))
+}
+
+// HandleList lists the payslips of a payroll run with their acknowledgement status.
+// @Summary List payslips
+// @Tags Payslips
+// @Produce json
+// @Param id path string true "Payroll run ID"
+// @Success 200 {object} utils.APIResponse "Payslips found"
+// @Failure 404 {object} utils.ProblemDetails "Payroll run not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs/{id}/payslips [get]
+func (c *PayslipController) HandleList(w http.ResponseWriter, r *http.Request) {
+	resp, err := c.listPayslipsUseCase.Execute(r.Context(), usecases.ListPayslipsQuery{RunID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "payroll.payslips_found", resp))
+}
+
+// HandleDownload downloads a payslip document.
+// @Summary Download a payslip
+// @Description Returns the PDF as issued. Employees can only download their own payslips. The SHA-256 of the document, which is what the employee acknowledges, is returned in the X-Content-SHA256 header and as ETag.
+// @Tags Payslips
+// @Produce application/pdf
+// @Param id path string true "Payslip ID"
+// @Success 200 {file} file "Payslip PDF"
+// @Header 200 {string} X-Content-SHA256 "SHA-256 of the document"
+// @Failure 404 {object} utils.ProblemDetails "Payslip not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payslips/{id}/pdf [get]
+func (c *PayslipController) HandleDownload(w http.ResponseWriter, r *http.Request) {
+	file, err := c.downloadPayslipUseCase.Execute(r.Context(), usecases.DownloadPayslipQuery{PayslipID: r.PathValue("id")})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	w.Header().Set("ETag", `"`+file.ContentHash+`"`)
+	w.Header().Set("X-Content-SHA256", file.ContentHash)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Content)
+}
+
+// HandleAcknowledge records the employee's acknowledgement of receipt of a payslip.
+// @Summary Acknowledge a payslip
+// @Description The employee confirms the receipt of their payslip by sending the SHA-256 of the document they downloaded. Repeating it keeps the first acknowledgement.
+// @Tags Payslips
+// @Accept json
+// @Produce json
+// @Param id path string true "Payslip ID"
+// @Param acknowledgement body dto.AcknowledgePayslipRequest true "Hash of the received document"
+// @Success 200 {object} utils.APIResponse "Payslip acknowledged"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 404 {object} utils.ProblemDetails "Payslip not found"
+// @Failure 422 {object} utils.ProblemDetails "The hash does not match the issued document"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payslips/{id}/acknowledgement [post]
+func (c *PayslipController) HandleAcknowledge(w http.ResponseWriter, r *http.Request) {
+	var ackDTO dto.AcknowledgePayslipRequest
+	if err := utils.ValidateAndBind(r, &ackDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	cmd := usecases.AcknowledgePayslipCommand{PayslipID: r.PathValue("id"), Data: ackDTO}
+	resp, err := c.acknowledgePayslipUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Payslip acknowledged", "payslipID", resp.ID, "employeeID", resp.EmployeeID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "payroll.payslip_acknowledged", resp))
+}
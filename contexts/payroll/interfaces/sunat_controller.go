@@ -0,0 +1,97 @@
+package interfaces
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/kevinsoras/employee-management/contexts/payroll/application/dto"
+	usecases "github.com/kevinsoras/employee-management/contexts/payroll/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// SunatController handles the T-Registro and PLAME import files.
+type SunatController struct {
+	logger                 *slog.Logger
+	exportTRegistroUseCase application.UseCase[usecases.ExportTRegistroQuery, dto.SunatFileResponse]
+	exportPlameUseCase     application.UseCase[usecases.ExportPlameQuery, dto.SunatFileResponse]
+}
+
+// NewSunatController creates a new controller with dependencies wired up.
+func NewSunatController(
+	logger *slog.Logger,
+	exportTRegistroUseCase application.UseCase[usecases.ExportTRegistroQuery, dto.SunatFileResponse],
+	exportPlameUseCase application.UseCase[usecases.ExportPlameQuery, dto.SunatFileResponse],
+) *SunatController {
+	return &SunatController{
+		logger:                 logger,
+		exportTRegistroUseCase: exportTRegistroUseCase,
+		exportPlameUseCase:     exportPlameUseCase,
+	}
+}
+
+// HandleTRegistro downloads a T-Registro import file.
+// @Summary Download a T-Registro file
+// @Description Generates the .ide, .tra or .per file for the natural-person employees hired in the given range. Every worker is checked against the SUNAT tables first.
+// @Tags SUNAT
+// @Produce plain
+// @Param file path string true "File" Enums(ide, tra, per)
+// @Param startDateFrom query string false "Hired on or after (2006-01-02)"
+// @Param startDateTo query string false "Hired on or before (2006-01-02)"
+// @Success 200 {file} file "T-Registro file"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 422 {object} utils.ProblemDetails "Data without a SUNAT code"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /sunat/t-registro/{file} [get]
+func (c *SunatController) HandleTRegistro(w http.ResponseWriter, r *http.Request) {
+	filterDTO := dto.TRegistroRequest{
+		StartDateFrom: r.URL.Query().Get("startDateFrom"),
+		StartDateTo:   r.URL.Query().Get("startDateTo"),
+	}
+	if err := utils.ValidateStruct(&filterDTO); err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	file, err := c.exportTRegistroUseCase.Execute(r.Context(), usecases.ExportTRegistroQuery{File: r.PathValue("file"), Filter: filterDTO})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	c.logger.Info("Generated T-Registro file", "file", file.FileName, "workers", file.Records)
+	writeSunatFile(w, file)
+}
+
+// HandlePlame downloads a PLAME import file of a payroll run.
+// @Summary Download a PLAME file
+// @Description Generates the .rem, .jor or .snl file of the payroll run for the PDT 601.
+// @Tags SUNAT
+// @Produce plain
+// @Param id path string true "Payroll run ID"
+// @Param file path string true "File" Enums(rem, jor, snl)
+// @Success 200 {file} file "PLAME file"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 404 {object} utils.ProblemDetails "Payroll run not found"
+// @Failure 422 {object} utils.ProblemDetails "Data without a SUNAT code"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /payroll-runs/{id}/plame/{file} [get]
+func (c *SunatController) HandlePlame(w http.ResponseWriter, r *http.Request) {
+	query := usecases.ExportPlameQuery{RunID: r.PathValue("id"), File: r.PathValue("file")}
+	file, err := c.exportPlameUseCase.Execute(r.Context(), query)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+	c.logger.Info("Generated PLAME file", "runID", query.RunID, "file", file.FileName, "workers", file.Records)
+	writeSunatFile(w, file)
+}
+
+func writeSunatFile(w http.ResponseWriter, file dto.SunatFileResponse) {
+	w.Header().Set("Content-Type", "text/plain; charset=iso-8859-1")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	w.Header().Set("X-Record-Count", strconv.Itoa(file.Records))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Content)
+}
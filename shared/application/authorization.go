@@ -0,0 +1,39 @@
+package application
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// AuthorizationDecorator is a generic decorator that only runs the wrapped UseCase
+// when the principal in the context has one of the allowed roles.
+type AuthorizationDecorator[TRequest any, TResponse any] struct {
+	useCase      UseCase[TRequest, TResponse]
+	allowedRoles []security.Role
+}
+
+// NewAuthorizationDecorator creates a new authorization decorator.
+func NewAuthorizationDecorator[TRequest any, TResponse any](useCase UseCase[TRequest, TResponse], allowedRoles ...security.Role) UseCase[TRequest, TResponse] {
+	return &AuthorizationDecorator[TRequest, TResponse]{
+		useCase:      useCase,
+		allowedRoles: allowedRoles,
+	}
+}
+
+// Execute checks the principal before delegating; it must wrap any transactional decorator
+// so that unauthorized requests never open a transaction.
+func (d *AuthorizationDecorator[TRequest, TResponse]) Execute(ctx context.Context, req TRequest) (TResponse, error) {
+	var zero TResponse
+
+	principal, ok := security.PrincipalFromContext(ctx)
+	if !ok {
+		return zero, domain.NewUnauthorizedError("auth.required", nil)
+	}
+	if !principal.HasAnyRole(d.allowedRoles...) {
+		return zero, domain.NewForbiddenError("auth.forbidden", nil)
+	}
+
+	return d.useCase.Execute(ctx, req)
+}
@@ -0,0 +1,56 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+type echoUseCase struct{ calls int }
+
+func (uc *echoUseCase) Execute(_ context.Context, req string) (string, error) {
+	uc.calls++
+	return req, nil
+}
+
+func TestAuthorizationDecorator(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *security.Principal
+		wantCode  string
+	}{
+		{name: "anonymous", principal: nil, wantCode: "UNAUTHORIZED"},
+		{name: "missing role", principal: &security.Principal{UserID: "u1", Roles: []security.Role{security.RoleEmployee}}, wantCode: "FORBIDDEN"},
+		{name: "allowed role", principal: &security.Principal{UserID: "u1", Roles: []security.Role{security.RoleHRAnalyst}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &echoUseCase{}
+			decorated := application.NewAuthorizationDecorator[string, string](inner, security.RoleHRAdmin, security.RoleHRAnalyst)
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = security.WithPrincipal(ctx, tt.principal)
+			}
+
+			resp, err := decorated.Execute(ctx, "ok")
+
+			if tt.wantCode == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, "ok", resp)
+				assert.Equal(t, 1, inner.calls)
+				return
+			}
+			var domainErr *domain.DomainError
+			assert.True(t, errors.As(err, &domainErr))
+			assert.Equal(t, tt.wantCode, domainErr.Code)
+			assert.Equal(t, 0, inner.calls)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package dto
+
+import "github.com/kevinsoras/employee-management/shared/domain/aggregates"
+
+// ContactRequest - medio de contacto tipado
+type ContactRequest struct {
+	Type         string `json:"type" validate:"required,oneof=PERSONAL_EMAIL WORK_EMAIL MOBILE EMERGENCY"`
+	Value        string `json:"value" validate:"required"`
+	Name         string `json:"name" validate:"required_if=Type EMERGENCY"`
+	Relationship string `json:"relationship"`
+	IsPrimary    bool   `json:"isPrimary"`
+}
+
+// AddressRequest - domicilio estructurado con ubigeo INEI (departamento/provincia/distrito)
+type AddressRequest struct {
+	Street    string `json:"street" validate:"required"`
+	Reference string `json:"reference"`
+	Ubigeo    string `json:"ubigeo" validate:"required,len=6,numeric"`
+}
+
+type ContactResponse struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Value        string `json:"value"`
+	Name         string `json:"name,omitempty"`
+	Relationship string `json:"relationship,omitempty"`
+	IsPrimary    bool   `json:"isPrimary"`
+}
+
+type AddressResponse struct {
+	Street       string `json:"street"`
+	Reference    string `json:"reference,omitempty"`
+	Ubigeo       string `json:"ubigeo"`
+	Department   string `json:"department"`
+	ProvinceCode string `json:"provinceCode"`
+}
+
+func newContactResponses(agg *aggregates.PersonAggregate) []ContactResponse {
+	if len(agg.Contacts) == 0 {
+		return nil
+	}
+	contacts := make([]ContactResponse, 0, len(agg.Contacts))
+	for _, c := range agg.Contacts {
+		contacts = append(contacts, ContactResponse{
+			ID:           c.ID,
+			Type:         string(c.Type),
+			Value:        c.Value,
+			Name:         c.Name,
+			Relationship: c.Relationship,
+			IsPrimary:    c.IsPrimary,
+		})
+	}
+	return contacts
+}
+
+func newAddressResponse(agg *aggregates.PersonAggregate) *AddressResponse {
+	if agg.Address == nil {
+		return nil
+	}
+	return &AddressResponse{
+		Street:       agg.Address.Street,
+		Reference:    agg.Address.Reference,
+		Ubigeo:       string(agg.Address.Ubigeo),
+		Department:   agg.Address.Ubigeo.DepartmentName(),
+		ProvinceCode: agg.Address.Ubigeo.ProvinceCode(),
+	}
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// DuplicateCandidateResponse - persona sospechosa de ser duplicado, con su puntaje y motivos
+type DuplicateCandidateResponse struct {
+	Person  PersonResponse `json:"person"`
+	Score   float64        `json:"score"`
+	Reasons []string       `json:"reasons"`
+}
+
+// PersonMergeRequest - fusiona la persona duplicada en la que se conserva
+type PersonMergeRequest struct {
+	SurvivorPersonID  string `json:"survivorPersonId" validate:"required,uuid"`
+	DuplicatePersonID string `json:"duplicatePersonId" validate:"required,uuid,nefield=SurvivorPersonID"`
+	Reason            string `json:"reason" validate:"max=255"`
+}
+
+// PersonMergeResponse - resultado de la fusión y referencia al registro de auditoría
+type PersonMergeResponse struct {
+	MergeID          string         `json:"mergeId"`
+	SurvivorPersonID string         `json:"survivorPersonId"`
+	MergedPersonID   string         `json:"mergedPersonId"`
+	EmployeesMoved   int            `json:"employeesMoved"`
+	MergedAt         time.Time      `json:"mergedAt"`
+	Survivor         PersonResponse `json:"survivor"`
+}
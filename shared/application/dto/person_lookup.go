@@ -0,0 +1,23 @@
+package dto
+
+// PersonLookupRequest - parámetros de consulta para GET /persons/lookup
+type PersonLookupRequest struct {
+	Type   string `json:"type" validate:"required,oneof=DNI RUC"`
+	Number string `json:"number" validate:"required,numeric"`
+}
+
+// PersonLookupResponse - datos públicos usados para prellenar el registro de una persona
+type PersonLookupResponse struct {
+	DocumentType   string `json:"documentType"`
+	DocumentNumber string `json:"documentNumber"`
+	// DNI (RENIEC)
+	FirstName        string `json:"firstName,omitempty"`
+	LastNamePaternal string `json:"lastNamePaternal,omitempty"`
+	LastNameMaternal string `json:"lastNameMaternal,omitempty"`
+	// RUC (SUNAT)
+	BusinessName string `json:"businessName,omitempty"`
+	TradeName    string `json:"tradeName,omitempty"`
+	Address      string `json:"address,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Condition    string `json:"condition,omitempty"`
+}
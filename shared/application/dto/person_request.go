@@ -13,6 +13,8 @@ type PersonRequest struct {
 	Address        string `json:"address" validate:"required"`
 	Country        string `json:"country" validate:"required"`
 	DocumentNumber string `json:"documentNumber" validate:"required"`
+	// DocumentType es opcional por compatibilidad: si se omite se asume DNI
+	DocumentType string `json:"documentType" validate:"omitempty,oneof=DNI CE PASAPORTE PTP CPP"`
 
 	// Campos específicos para Natural
 	FirstName        string    `json:"firstName" validate:"required_if=Type NATURAL"`
@@ -20,10 +22,17 @@ type PersonRequest struct {
 	LastNameMaternal string    `json:"lastNameMaternal" validate:"required_if=Type NATURAL"`
 	BirthDate        time.Time `json:"birthDate" validate:"required_if=Type NATURAL"`
 	Gender           string    `json:"gender" validate:"required_if=Type NATURAL,omitempty,oneof=M F O"`
+	// Campos para trabajadores extranjeros (CE, PASAPORTE, PTP, CPP)
+	Nationality      string     `json:"nationality" validate:"omitempty,len=3,alpha"`
+	WorkPermitExpiry *time.Time `json:"workPermitExpiry"`
 	// Campos específicos para Juridical
 	BusinessName           string    `json:"businessName" validate:"required_if=Type JURIDICAL"`
 	TradeName              string    `json:"tradeName" validate:"required_if=Type JURIDICAL"`
 	ConstitutionDate       time.Time `json:"constitutionDate" validate:"required_if=Type JURIDICAL"`
 	RepresentativeName     string    `json:"representativeName" validate:"required_if=Type JURIDICAL"`
 	RepresentativeDocument string    `json:"representativeDocument" validate:"required_if=Type JURIDICAL"`
+
+	// Contactos tipados y domicilio estructurado (opcionales)
+	Contacts          []ContactRequest `json:"contacts" validate:"omitempty,dive"`
+	StructuredAddress *AddressRequest  `json:"structuredAddress" validate:"omitempty"`
 }
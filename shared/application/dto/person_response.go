@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"github.com/kevinsoras/employee-management/shared/application/masking"
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
 	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
 )
@@ -14,21 +15,28 @@ type PersonResponse struct {
 	Phone          string    `json:"phone"`
 	Address        string    `json:"address"`
 	Country        string    `json:"country"`
+	Version        int       `json:"version"` // También se expone como ETag
 	CreatedAt      time.Time `json:"createdAt"`
 	UpdatedAt      time.Time `json:"updatedAt"`
 	DocumentNumber string    `json:"documentNumber,omitempty"`
 	// NATURAL
-	FirstName        string    `json:"firstName,omitempty"`
-	LastNamePaternal string    `json:"lastNamePaternal,omitempty"`
-	LastNameMaternal string    `json:"lastNameMaternal,omitempty"`
-	BirthDate        time.Time `json:"birthDate,omitempty"`
-	Gender           string    `json:"gender,omitempty"`
+	DocumentType     string     `json:"documentType,omitempty"`
+	FirstName        string     `json:"firstName,omitempty"`
+	LastNamePaternal string     `json:"lastNamePaternal,omitempty"`
+	LastNameMaternal string     `json:"lastNameMaternal,omitempty"`
+	BirthDate        time.Time  `json:"birthDate,omitempty"`
+	Gender           string     `json:"gender,omitempty"`
+	Nationality      string     `json:"nationality,omitempty"`
+	WorkPermitExpiry *time.Time `json:"workPermitExpiry,omitempty"`
 	// JURIDICAL
 	BusinessName           string    `json:"businessName,omitempty"`
 	TradeName              string    `json:"tradeName,omitempty"`
 	ConstitutionDate       time.Time `json:"constitutionDate,omitempty"`
 	RepresentativeName     string    `json:"representativeName,omitempty"`
 	RepresentativeDocument string    `json:"representativeDocument,omitempty"`
+	// Contactos tipados y domicilio estructurado
+	Contacts          []ContactResponse `json:"contacts,omitempty"`
+	StructuredAddress *AddressResponse  `json:"structuredAddress,omitempty"`
 }
 
 func NewPersonResponse(agg *aggregates.PersonAggregate) PersonResponse {
@@ -39,8 +47,12 @@ func NewPersonResponse(agg *aggregates.PersonAggregate) PersonResponse {
 		Phone:     string(agg.Person.Phone),
 		Address:   agg.Person.Address,
 		Country:   agg.Person.Country,
+		Version:   agg.Person.Version,
 		CreatedAt: agg.Person.CreatedAt,
 		UpdatedAt: agg.Person.UpdatedAt,
+
+		Contacts:          newContactResponses(agg),
+		StructuredAddress: newAddressResponse(agg),
 	}
 	// Usar un factory para poblar los campos según el tipo
 	switch agg.Person.Type {
@@ -62,6 +74,12 @@ func fillNaturalPersonFields(pr *PersonResponse, agg *aggregates.PersonAggregate
 	pr.BirthDate = agg.NaturalPerson.BirthDate
 	pr.Gender = agg.NaturalPerson.Gender
 	pr.DocumentNumber = agg.NaturalPerson.DocumentNumber
+	pr.DocumentType = string(agg.NaturalPerson.DocumentType)
+	pr.Nationality = agg.NaturalPerson.Nationality
+	if !agg.NaturalPerson.WorkPermitExpiry.IsZero() {
+		expiry := agg.NaturalPerson.WorkPermitExpiry
+		pr.WorkPermitExpiry = &expiry
+	}
 }
 
 func fillJuridicalPersonFields(pr *PersonResponse, agg *aggregates.PersonAggregate) {
@@ -75,3 +93,24 @@ func fillJuridicalPersonFields(pr *PersonResponse, agg *aggregates.PersonAggrega
 	pr.RepresentativeDocument = agg.JuridicalPerson.RepresentativeDocument
 	pr.DocumentNumber = agg.JuridicalPerson.DocumentNumber
 }
+
+// Masked devuelve una copia con los datos sensibles enmascarados según lo que el viewer puede ver
+func (pr PersonResponse) Masked(viewer masking.Viewer) PersonResponse {
+	pr.DocumentNumber = viewer.String(masking.FieldDocumentNumber, pr.DocumentNumber)
+	pr.RepresentativeDocument = viewer.String(masking.FieldDocumentNumber, pr.RepresentativeDocument)
+	pr.Email = viewer.String(masking.FieldEmail, pr.Email)
+	pr.Phone = viewer.String(masking.FieldPhone, pr.Phone)
+	if pr.Contacts != nil {
+		contacts := make([]ContactResponse, len(pr.Contacts))
+		for i, c := range pr.Contacts {
+			field := masking.FieldPhone
+			if value_objects.ContactType(c.Type).IsEmail() {
+				field = masking.FieldEmail
+			}
+			c.Value = viewer.String(field, c.Value)
+			contacts[i] = c
+		}
+		pr.Contacts = contacts
+	}
+	return pr
+}
@@ -0,0 +1,11 @@
+package dto
+
+// PersonUpdateRequest - datos de contacto y domicilio modificables después del registro
+type PersonUpdateRequest struct {
+	Email             string           `json:"email" validate:"required,email"`
+	Phone             string           `json:"phone" validate:"required"`
+	Address           string           `json:"address" validate:"required"`
+	Country           string           `json:"country" validate:"required"`
+	Contacts          []ContactRequest `json:"contacts" validate:"omitempty,dive"`
+	StructuredAddress *AddressRequest  `json:"structuredAddress" validate:"omitempty"`
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+type eventBufferCtx struct{}
+
+// eventBuffer holds the events recorded inside a transaction until it commits.
+type eventBuffer struct {
+	mu     sync.Mutex
+	events []domain.DomainEvent
+}
+
+func (b *eventBuffer) add(events ...domain.DomainEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events = append(b.events, events...)
+}
+
+// RecordEvents queues the events pulled from an aggregate; they are dispatched once the enclosing
+// transaction commits and discarded if it rolls back. Outside a transaction there is nothing to
+// wait for and they are discarded too, so only use cases behind a TransactionalDecorator should
+// record events.
+func RecordEvents(ctx context.Context, events ...domain.DomainEvent) {
+	if buffer, ok := ctx.Value(eventBufferCtx{}).(*eventBuffer); ok && len(events) > 0 {
+		buffer.add(events...)
+	}
+}
+
+// EventHandler reacts to an event after its transaction committed.
+type EventHandler[E domain.DomainEvent] func(ctx context.Context, event E) error
+
+// EventDispatcher delivers the committed events, in process, to the handlers subscribed to their
+// type. A handler cannot undo the transaction: its error is logged and the remaining handlers run.
+type EventDispatcher struct {
+	logger   *slog.Logger
+	outbox   repositories.OutboxRepository
+	mu       sync.RWMutex
+	handlers map[reflect.Type][]func(context.Context, domain.DomainEvent) error
+}
+
+// NewEventDispatcher creates a dispatcher without subscribers.
+func NewEventDispatcher(logger *slog.Logger) *EventDispatcher {
+	return &EventDispatcher{logger: logger, handlers: make(map[reflect.Type][]func(context.Context, domain.DomainEvent) error)}
+}
+
+// UseOutbox makes the dispatcher also save every event in the outbox, inside the transaction that
+// recorded it, so the outbox relay publishes it to the external systems. A failed write rolls the
+// transaction back: the change is never committed without its event.
+func (d *EventDispatcher) UseOutbox(outbox repositories.OutboxRepository) {
+	d.outbox = outbox
+}
+
+// Subscribe registers a handler for the events of type E, e.g.
+//
+//	application.Subscribe(dispatcher, func(ctx context.Context, event events.SalaryChanged) error { ... })
+//
+// Handlers of the same type run in the order they were subscribed.
+func Subscribe[E domain.DomainEvent](d *EventDispatcher, handler EventHandler[E]) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	eventType := reflect.TypeFor[E]()
+	d.handlers[eventType] = append(d.handlers[eventType], func(ctx context.Context, event domain.DomainEvent) error {
+		return handler(ctx, event.(E))
+	})
+}
+
+// Dispatch delivers each event to its handlers, in the order the events were recorded. The
+// request may end while the handlers run, so they get a context that is never canceled.
+func (d *EventDispatcher) Dispatch(ctx context.Context, events ...domain.DomainEvent) {
+	ctx = context.WithoutCancel(ctx)
+	for _, event := range events {
+		d.mu.RLock()
+		handlers := d.handlers[reflect.TypeOf(event)]
+		d.mu.RUnlock()
+		for _, handler := range handlers {
+			if err := d.handle(ctx, handler, event); err != nil {
+				d.logger.Error("Event handler failed", "event", event.EventName(), "error", err)
+			}
+		}
+	}
+}
+
+func (d *EventDispatcher) handle(ctx context.Context, handler func(context.Context, domain.DomainEvent) error, event domain.DomainEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(ctx, event)
+}
+
+// ExecuteInTransaction runs fn in a transaction of the UnitOfWork and dispatches the events it
+// recorded only after the commit; with an outbox they are also saved before the commit, in the
+// same transaction. Nested inside another transaction (a savepoint), the events are handed to
+// the enclosing one instead, since its commit is the one that makes them real. A nil dispatcher
+// discards the events.
+func ExecuteInTransaction(ctx context.Context, uow domain.UnitOfWork, dispatcher *EventDispatcher, fn domain.UowCallback) error {
+	outer, nested := ctx.Value(eventBufferCtx{}).(*eventBuffer)
+	buffer := &eventBuffer{}
+	err := uow.Execute(context.WithValue(ctx, eventBufferCtx{}, buffer), func(txCtx context.Context) error {
+		if err := fn(txCtx); err != nil {
+			return err
+		}
+		if nested || dispatcher == nil {
+			return nil
+		}
+		return dispatcher.saveToOutbox(txCtx, buffer.events)
+	})
+	if err != nil {
+		return err
+	}
+	switch {
+	case nested:
+		outer.add(buffer.events...)
+	case dispatcher != nil:
+		dispatcher.Dispatch(ctx, buffer.events...)
+	}
+	return nil
+}
+
+func (d *EventDispatcher) saveToOutbox(ctx context.Context, events []domain.DomainEvent) error {
+	if d.outbox == nil || len(events) == 0 {
+		return nil
+	}
+	messages := make([]*entities.OutboxMessage, 0, len(events))
+	for _, event := range events {
+		message, err := entities.NewOutboxMessage(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+	}
+	return d.outbox.Append(ctx, messages...)
+}
@@ -0,0 +1,137 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+type employeeHired struct{ id string }
+
+func (e employeeHired) EventName() string     { return "test.hired" }
+func (e employeeHired) OccurredAt() time.Time { return time.Time{} }
+
+type employeeFired struct{ id string }
+
+func (e employeeFired) EventName() string     { return "test.fired" }
+func (e employeeFired) OccurredAt() time.Time { return time.Time{} }
+
+// nestingUoW runs the callback directly; calls inside another callback play the savepoint
+type nestingUoW struct {
+	depth   int
+	commits int
+}
+
+func (u *nestingUoW) Execute(ctx context.Context, fn domain.UowCallback) error {
+	u.depth++
+	defer func() { u.depth-- }()
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	if u.depth == 1 {
+		u.commits++
+	}
+	return nil
+}
+
+// recordingUseCase records an event per request, or fails after recording it
+type recordingUseCase struct {
+	fail bool
+}
+
+func (uc *recordingUseCase) Execute(ctx context.Context, id string) (string, error) {
+	application.RecordEvents(ctx, employeeHired{id: id})
+	if uc.fail {
+		return "", errors.New("constraint violated")
+	}
+	return id, nil
+}
+
+func newDispatcher(received *[]string) *application.EventDispatcher {
+	dispatcher := application.NewEventDispatcher(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	application.Subscribe(dispatcher, func(_ context.Context, event employeeHired) error {
+		*received = append(*received, "hired:"+event.id)
+		return nil
+	})
+	return dispatcher
+}
+
+func TestTransactionalDecorator_DispatchesEventsAfterCommit(t *testing.T) {
+	var received []string
+	uow := &nestingUoW{}
+	decorated := application.NewTransactionalDecorator[string, string](&recordingUseCase{}, uow, newDispatcher(&received))
+
+	_, err := decorated.Execute(context.Background(), "emp-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, uow.commits)
+	assert.Equal(t, []string{"hired:emp-1"}, received)
+}
+
+func TestTransactionalDecorator_DiscardsEventsOnRollback(t *testing.T) {
+	var received []string
+	decorated := application.NewTransactionalDecorator[string, string](&recordingUseCase{fail: true}, &nestingUoW{}, newDispatcher(&received))
+
+	_, err := decorated.Execute(context.Background(), "emp-1")
+
+	require.Error(t, err)
+	assert.Empty(t, received)
+}
+
+func TestExecuteInTransaction_NestedEventsWaitForTheOuterCommit(t *testing.T) {
+	var received []string
+	uow := &nestingUoW{}
+	dispatcher := newDispatcher(&received)
+	inner := application.NewTransactionalDecorator[string, string](&recordingUseCase{}, uow, dispatcher)
+	failing := application.NewTransactionalDecorator[string, string](&recordingUseCase{fail: true}, uow, dispatcher)
+
+	errRollback := errors.New("rollback")
+	err := application.ExecuteInTransaction(context.Background(), uow, dispatcher, func(txCtx context.Context) error {
+		_, _ = inner.Execute(txCtx, "emp-1")
+		_, _ = failing.Execute(txCtx, "emp-2")
+		assert.Empty(t, received, "nothing is dispatched before the outer commit")
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+	assert.Empty(t, received, "a rolled back outer transaction discards the events of its savepoints")
+
+	err = application.ExecuteInTransaction(context.Background(), uow, dispatcher, func(txCtx context.Context) error {
+		_, _ = inner.Execute(txCtx, "emp-3")
+		_, _ = failing.Execute(txCtx, "emp-4")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hired:emp-3"}, received, "only the events of released savepoints are dispatched")
+}
+
+func TestEventDispatcher_RoutesByTypeAndIsolatesHandlers(t *testing.T) {
+	var received []string
+	dispatcher := newDispatcher(&received)
+	application.Subscribe(dispatcher, func(_ context.Context, event employeeFired) error {
+		panic("broken handler")
+	})
+	application.Subscribe(dispatcher, func(_ context.Context, event employeeFired) error {
+		received = append(received, "fired:"+event.id)
+		return errors.New("also broken")
+	})
+	application.Subscribe(dispatcher, func(ctx context.Context, event employeeFired) error {
+		received = append(received, "fired-again:"+event.id)
+		assert.NoError(t, ctx.Err())
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dispatcher.Dispatch(ctx, employeeFired{id: "emp-1"}, employeeHired{id: "emp-2"})
+
+	assert.Equal(t, []string{"fired:emp-1", "fired-again:emp-1", "hired:emp-2"}, received)
+}
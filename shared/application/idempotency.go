@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+type idempotencyKeyCtx struct{}
+
+// idempotencyState carries the client's key down to the decorator and reports back whether
+// the response was replayed, so the HTTP layer can flag it.
+type idempotencyState struct {
+	key      string
+	replayed atomic.Bool
+}
+
+// WithIdempotencyKey stores the Idempotency-Key sent by the client in the context.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, &idempotencyState{key: key})
+}
+
+// IdempotencyKeyFromContext returns the key stored by WithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	state, ok := ctx.Value(idempotencyKeyCtx{}).(*idempotencyState)
+	if !ok {
+		return "", false
+	}
+	return state.key, true
+}
+
+// IsIdempotentReplay reports whether the response for this request was replayed from a previous one.
+func IsIdempotentReplay(ctx context.Context) bool {
+	state, ok := ctx.Value(idempotencyKeyCtx{}).(*idempotencyState)
+	return ok && state.replayed.Load()
+}
+
+// IdempotencyDecorator is a generic decorator that stores the response of the wrapped UseCase
+// under the client's Idempotency-Key and replays it when the request is retried.
+type IdempotencyDecorator[TRequest any, TResponse any] struct {
+	useCase UseCase[TRequest, TResponse]
+	repo    repositories.IdempotencyRepository
+	scope   string
+	ttl     time.Duration
+}
+
+// NewIdempotencyDecorator creates a new idempotency decorator. The scope names the operation,
+// so the same key can be used against different endpoints without colliding.
+func NewIdempotencyDecorator[TRequest any, TResponse any](useCase UseCase[TRequest, TResponse], repo repositories.IdempotencyRepository, scope string, ttl time.Duration) UseCase[TRequest, TResponse] {
+	return &IdempotencyDecorator[TRequest, TResponse]{
+		useCase: useCase,
+		repo:    repo,
+		scope:   scope,
+		ttl:     ttl,
+	}
+}
+
+// Execute must run inside the TransactionalDecorator: the key, the writes of the use case and the
+// stored response commit together, and a failed request leaves no key behind so it can be retried.
+// Requests without a key run unchanged.
+func (d *IdempotencyDecorator[TRequest, TResponse]) Execute(ctx context.Context, req TRequest) (TResponse, error) {
+	var zero TResponse
+
+	state, ok := ctx.Value(idempotencyKeyCtx{}).(*idempotencyState)
+	if !ok {
+		return d.useCase.Execute(ctx, req)
+	}
+
+	requestHash, err := hashRequest(req)
+	if err != nil {
+		return zero, err
+	}
+	var userID string
+	if principal, ok := security.PrincipalFromContext(ctx); ok {
+		userID = principal.UserID
+	}
+	record, err := entities.NewIdempotencyRecord(d.scope, state.key, userID, requestHash, d.ttl)
+	if err != nil {
+		return zero, domain.NewInvalidInputError("idempotency.key_invalid", err)
+	}
+
+	existing, err := d.repo.Reserve(ctx, record)
+	if err != nil {
+		return zero, fmt.Errorf("error reserving idempotency key: %w", err)
+	}
+	if existing != nil {
+		return d.replay(state, existing, requestHash)
+	}
+
+	response, err := d.useCase.Execute(ctx, req)
+	if err != nil {
+		return zero, err
+	}
+
+	record.Response, err = json.Marshal(response)
+	if err != nil {
+		return zero, fmt.Errorf("error serializing idempotent response: %w", err)
+	}
+	if err := d.repo.SaveResponse(ctx, record); err != nil {
+		return zero, fmt.Errorf("error saving idempotent response: %w", err)
+	}
+	return response, nil
+}
+
+func (d *IdempotencyDecorator[TRequest, TResponse]) replay(state *idempotencyState, existing *entities.IdempotencyRecord, requestHash string) (TResponse, error) {
+	var response TResponse
+	if !existing.Matches(requestHash) {
+		return response, domain.NewIdempotencyKeyReusedError("idempotency.key_reused", nil)
+	}
+	if !existing.Completed() {
+		return response, domain.NewIdempotencyKeyInProgressError("idempotency.key_in_progress", nil)
+	}
+	if err := json.Unmarshal(existing.Response, &response); err != nil {
+		return response, fmt.Errorf("error reading idempotent response: %w", err)
+	}
+	state.replayed.Store(true)
+	return response, nil
+}
+
+// hashRequest fingerprints the request as the SHA-256 of its JSON encoding.
+func hashRequest(req any) (string, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("error serializing request for idempotency: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,137 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// inMemoryIdempotencyRepository keeps the records as the table would after each commit.
+type inMemoryIdempotencyRepository struct {
+	records map[string]*entities.IdempotencyRecord
+	saved   int
+}
+
+func newInMemoryIdempotencyRepository() *inMemoryIdempotencyRepository {
+	return &inMemoryIdempotencyRepository{records: map[string]*entities.IdempotencyRecord{}}
+}
+
+func (r *inMemoryIdempotencyRepository) id(record *entities.IdempotencyRecord) string {
+	return record.Scope + "|" + record.UserID + "|" + record.Key
+}
+
+func (r *inMemoryIdempotencyRepository) Reserve(_ context.Context, record *entities.IdempotencyRecord) (*entities.IdempotencyRecord, error) {
+	if existing, ok := r.records[r.id(record)]; ok && existing.ExpiresAt.After(record.CreatedAt) {
+		return existing, nil
+	}
+	stored := *record
+	r.records[r.id(record)] = &stored
+	return nil, nil
+}
+
+func (r *inMemoryIdempotencyRepository) SaveResponse(_ context.Context, record *entities.IdempotencyRecord) error {
+	r.saved++
+	r.records[r.id(record)].Response = record.Response
+	return nil
+}
+
+type createRequest struct {
+	Name string `json:"name"`
+}
+
+type createResponse struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// counterUseCase returns a different ID on every execution, so replays are easy to spot.
+type counterUseCase struct {
+	calls int
+	err   error
+}
+
+func (uc *counterUseCase) Execute(_ context.Context, req createRequest) (createResponse, error) {
+	uc.calls++
+	if uc.err != nil {
+		return createResponse{}, uc.err
+	}
+	return createResponse{ID: uc.calls, Name: req.Name}, nil
+}
+
+func idempotentContext(key, userID string) context.Context {
+	ctx := security.WithPrincipal(context.Background(), &security.Principal{UserID: userID})
+	return application.WithIdempotencyKey(ctx, key)
+}
+
+func TestIdempotencyDecorator_ReplaysRetry(t *testing.T) {
+	repo := newInMemoryIdempotencyRepository()
+	inner := &counterUseCase{}
+	decorated := application.NewIdempotencyDecorator[createRequest, createResponse](inner, repo, "test.create", time.Hour)
+
+	first, err := decorated.Execute(idempotentContext("key-1", "u1"), createRequest{Name: "Ana"})
+	require.NoError(t, err)
+
+	retryCtx := idempotentContext("key-1", "u1")
+	retry, err := decorated.Execute(retryCtx, createRequest{Name: "Ana"})
+	require.NoError(t, err)
+
+	assert.Equal(t, first, retry)
+	assert.Equal(t, 1, inner.calls)
+	assert.True(t, application.IsIdempotentReplay(retryCtx))
+
+	// The key is scoped per user
+	_, err = decorated.Execute(idempotentContext("key-1", "u2"), createRequest{Name: "Ana"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.calls)
+}
+
+func TestIdempotencyDecorator_RejectsDifferentPayload(t *testing.T) {
+	repo := newInMemoryIdempotencyRepository()
+	inner := &counterUseCase{}
+	decorated := application.NewIdempotencyDecorator[createRequest, createResponse](inner, repo, "test.create", time.Hour)
+
+	_, err := decorated.Execute(idempotentContext("key-1", "u1"), createRequest{Name: "Ana"})
+	require.NoError(t, err)
+
+	_, err = decorated.Execute(idempotentContext("key-1", "u1"), createRequest{Name: "Luis"})
+
+	var domainErr *domain.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", domainErr.Code)
+	assert.Equal(t, 422, domainErr.HTTPStatusCode)
+	assert.Equal(t, 1, inner.calls)
+}
+
+func TestIdempotencyDecorator_DoesNotStoreFailures(t *testing.T) {
+	repo := newInMemoryIdempotencyRepository()
+	inner := &counterUseCase{err: domain.NewInvalidInputError("invalid", nil)}
+	decorated := application.NewIdempotencyDecorator[createRequest, createResponse](inner, repo, "test.create", time.Hour)
+
+	_, err := decorated.Execute(idempotentContext("key-1", "u1"), createRequest{Name: "Ana"})
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, repo.saved, "the transaction rolls the reservation back, no response is stored")
+}
+
+func TestIdempotencyDecorator_WithoutKey(t *testing.T) {
+	repo := newInMemoryIdempotencyRepository()
+	inner := &counterUseCase{}
+	decorated := application.NewIdempotencyDecorator[createRequest, createResponse](inner, repo, "test.create", time.Hour)
+
+	for range 2 {
+		_, err := decorated.Execute(context.Background(), createRequest{Name: "Ana"})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, inner.calls)
+	assert.Empty(t, repo.records)
+}
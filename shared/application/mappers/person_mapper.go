@@ -2,27 +2,27 @@
 package mappers
 
 import (
-	"log"
-
 	"github.com/kevinsoras/employee-management/shared/application/dto"
 	"github.com/kevinsoras/employee-management/shared/domain/factories"
 	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
 )
 
-func ToPersonFactoryParams(personRequest dto.PersonRequest) factories.PersonFactoryParams {
+// ToPersonFactoryParams convierte el DTO en los parámetros de la fábrica. Devuelve el error
+// de validación del value object que rechace el dato (tipo de persona, email, teléfono o documento).
+func ToPersonFactoryParams(personRequest dto.PersonRequest) (factories.PersonFactoryParams, error) {
 	personType, err := value_objects.NewPersonType(personRequest.Type)
 	if err != nil {
-		log.Printf("Error creating person type: %v", err)
+		return factories.PersonFactoryParams{}, err
 	}
 
 	email, err := value_objects.NewEmail(personRequest.Email)
 	if err != nil {
-		log.Printf("Error creating email: %v", err)
+		return factories.PersonFactoryParams{}, err
 	}
 
 	phone, err := value_objects.NewPhone(personRequest.Phone)
 	if err != nil {
-		log.Printf("Error creating phone: %v", err)
+		return factories.PersonFactoryParams{}, err
 	}
 
 	params := factories.PersonFactoryParams{
@@ -33,16 +33,59 @@ func ToPersonFactoryParams(personRequest dto.PersonRequest) factories.PersonFact
 		Country:        personRequest.Country,
 		DocumentNumber: personRequest.DocumentNumber,
 	}
+	if personType == value_objects.Natural {
+		params.DocumentType, err = toDocumentType(personRequest.DocumentType)
+		if err != nil {
+			return factories.PersonFactoryParams{}, err
+		}
+	}
 	params.FirstName = &personRequest.FirstName
 	params.LastNamePaternal = &personRequest.LastNamePaternal
 	params.LastNameMaternal = &personRequest.LastNameMaternal
 	params.BirthDate = &personRequest.BirthDate
 	params.Gender = &personRequest.Gender
+	params.Nationality = &personRequest.Nationality
+	params.WorkPermitExpiry = personRequest.WorkPermitExpiry
 	params.BusinessName = &personRequest.BusinessName
 	params.TradeName = &personRequest.TradeName
 	params.ConstitutionDate = &personRequest.ConstitutionDate
 	params.RepresentativeName = &personRequest.RepresentativeName
 	params.RepresentativeDocument = &personRequest.RepresentativeDocument
+	params.Contacts = ToContactParams(personRequest.Contacts)
+	params.StructuredAddress = ToAddressParams(personRequest.StructuredAddress)
+
+	return params, nil
+}
 
+func ToContactParams(contacts []dto.ContactRequest) []factories.ContactParams {
+	params := make([]factories.ContactParams, 0, len(contacts))
+	for _, c := range contacts {
+		params = append(params, factories.ContactParams{
+			Type:         c.Type,
+			Value:        c.Value,
+			Name:         c.Name,
+			Relationship: c.Relationship,
+			IsPrimary:    c.IsPrimary,
+		})
+	}
 	return params
 }
+
+func ToAddressParams(address *dto.AddressRequest) *factories.AddressParams {
+	if address == nil {
+		return nil
+	}
+	return &factories.AddressParams{
+		Street:    address.Street,
+		Reference: address.Reference,
+		Ubigeo:    address.Ubigeo,
+	}
+}
+
+// toDocumentType asume DNI cuando el cliente no especifica el tipo de documento
+func toDocumentType(input string) (value_objects.DocumentType, error) {
+	if input == "" {
+		return value_objects.DNI, nil
+	}
+	return value_objects.NewDocumentType(input)
+}
@@ -0,0 +1,31 @@
+// shared/application/mappers/person_update_mapper.go
+package mappers
+
+import (
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/factories"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// ApplyPersonUpdate aplica los datos de contacto del DTO sobre un agregado existente.
+// Devuelve un error INVALID_INPUT si algún dato no cumple las reglas del dominio.
+func ApplyPersonUpdate(agg *aggregates.PersonAggregate, update dto.PersonUpdateRequest) error {
+	email, err := value_objects.NewEmail(update.Email)
+	if err != nil {
+		return domain.NewInvalidInputError("validation.failed", err)
+	}
+	phone, err := value_objects.NewPhone(update.Phone)
+	if err != nil {
+		return domain.NewInvalidInputError("validation.failed", err)
+	}
+	agg.Person.UpdateContactInfo(email, phone, update.Address, update.Country)
+
+	contacts := ToContactParams(update.Contacts)
+	address := ToAddressParams(update.StructuredAddress)
+	if err := factories.AttachContactDetails(agg, contacts, address); err != nil {
+		return domain.NewInvalidInputError("validation.failed", err)
+	}
+	return nil
+}
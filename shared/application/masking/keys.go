@@ -0,0 +1,29 @@
+package masking
+
+import "strings"
+
+// sensitiveKeys maps normalized attribute names (lowercase, without "_" or "-") to policy fields.
+// It lets the log redaction recognise PII regardless of how the attribute was named.
+var sensitiveKeys = map[string]Field{
+	"bankaccount":            FieldBankAccount,
+	"salary":                 FieldSalary,
+	"cts":                    FieldBenefits,
+	"gratification":          FieldBenefits,
+	"benefits":               FieldBenefits,
+	"documentnumber":         FieldDocumentNumber,
+	"dni":                    FieldDocumentNumber,
+	"representativedocument": FieldDocumentNumber,
+	"email":                  FieldEmail,
+	"phone":                  FieldPhone,
+	"password":               FieldSecret,
+	"token":                  FieldSecret,
+	"authorization":          FieldSecret,
+	"secret":                 FieldSecret,
+}
+
+// FieldForKey returns the policy field for an attribute name, if it is sensitive.
+func FieldForKey(key string) (Field, bool) {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	field, ok := sensitiveKeys[normalized]
+	return field, ok
+}
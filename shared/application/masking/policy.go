@@ -0,0 +1,144 @@
+package masking
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// Field identifies a sensitive attribute governed by the policy.
+type Field string
+
+const (
+	FieldBankAccount    Field = "bankAccount"
+	FieldSalary         Field = "salary"
+	FieldBenefits       Field = "benefits"
+	FieldDocumentNumber Field = "documentNumber"
+	FieldEmail          Field = "email"
+	FieldPhone          Field = "phone"
+	FieldSecret         Field = "secret"
+)
+
+// Strategy is how a value is transformed for a viewer that cannot see it in clear.
+type Strategy int
+
+const (
+	// StrategyHide removes the value entirely.
+	StrategyHide Strategy = iota
+	// StrategyLast4 keeps only the last four characters: "****1234".
+	StrategyLast4
+	// StrategyPartial keeps the first two and the last two characters: "45****12".
+	StrategyPartial
+	// StrategyEmail keeps the first character of the local part and the domain: "j***@empresa.pe".
+	StrategyEmail
+)
+
+// Rule says how a field is masked and which roles see it unmasked.
+type Rule struct {
+	Strategy Strategy
+	Unmasked []security.Role
+}
+
+// Policy maps each sensitive field to its rule. Fields without a rule are not sensitive.
+type Policy struct {
+	rules map[Field]Rule
+}
+
+// NewPolicy creates a policy from explicit rules.
+func NewPolicy(rules map[Field]Rule) Policy {
+	return Policy{rules: rules}
+}
+
+var hrRoles = []security.Role{security.RoleHRAdmin, security.RoleHRAnalyst}
+
+// DefaultPolicy is the policy used by the API responses and the log redaction:
+// only HR_ADMIN sees full bank accounts, only HR sees compensation and full documents,
+// contact data is visible to any authenticated role, and secrets are never shown.
+func DefaultPolicy() Policy {
+	everyone := []security.Role{security.RoleHRAdmin, security.RoleHRAnalyst, security.RoleManager, security.RoleEmployee}
+	return NewPolicy(map[Field]Rule{
+		FieldBankAccount:    {Strategy: StrategyLast4, Unmasked: []security.Role{security.RoleHRAdmin}},
+		FieldSalary:         {Strategy: StrategyHide, Unmasked: hrRoles},
+		FieldBenefits:       {Strategy: StrategyHide, Unmasked: hrRoles},
+		FieldDocumentNumber: {Strategy: StrategyPartial, Unmasked: hrRoles},
+		FieldEmail:          {Strategy: StrategyEmail, Unmasked: everyone},
+		FieldPhone:          {Strategy: StrategyLast4, Unmasked: everyone},
+		FieldSecret:         {Strategy: StrategyHide},
+	})
+}
+
+// Rule returns the rule of a field and whether the field is sensitive.
+func (p Policy) Rule(field Field) (Rule, bool) {
+	rule, ok := p.rules[field]
+	return rule, ok
+}
+
+// Viewer applies a policy for a concrete set of roles. The zero-role viewer (logs, anonymous
+// callers) gets every sensitive field masked.
+type Viewer struct {
+	policy Policy
+	roles  []security.Role
+}
+
+// NewViewer creates a viewer for the given roles under the policy.
+func (p Policy) NewViewer(roles ...security.Role) Viewer {
+	return Viewer{policy: p, roles: roles}
+}
+
+// ViewerFromContext builds a viewer of the default policy for the principal in the context.
+func ViewerFromContext(ctx context.Context) Viewer {
+	if principal, ok := security.PrincipalFromContext(ctx); ok {
+		return DefaultPolicy().NewViewer(principal.Roles...)
+	}
+	return DefaultPolicy().NewViewer()
+}
+
+// CanSee reports whether the viewer sees the field unmasked.
+func (v Viewer) CanSee(field Field) bool {
+	rule, sensitive := v.policy.Rule(field)
+	if !sensitive {
+		return true
+	}
+	for _, role := range v.roles {
+		if slices.Contains(rule.Unmasked, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the value as this viewer may see it; hidden values become "".
+func (v Viewer) String(field Field, value string) string {
+	if v.CanSee(field) || value == "" {
+		return value
+	}
+	rule, _ := v.policy.Rule(field)
+	return Apply(rule.Strategy, value)
+}
+
+// Apply transforms a value with a masking strategy.
+func Apply(strategy Strategy, value string) string {
+	runes := []rune(value)
+	switch strategy {
+	case StrategyLast4:
+		if len(runes) <= 4 {
+			return strings.Repeat("*", len(runes))
+		}
+		return "****" + string(runes[len(runes)-4:])
+	case StrategyPartial:
+		if len(runes) <= 4 {
+			return strings.Repeat("*", len(runes))
+		}
+		return string(runes[:2]) + strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-2:])
+	case StrategyEmail:
+		local, domain, found := strings.Cut(value, "@")
+		if !found || local == "" {
+			return Apply(StrategyPartial, value)
+		}
+		return string([]rune(local)[:1]) + "***@" + domain
+	default:
+		return ""
+	}
+}
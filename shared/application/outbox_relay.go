@@ -0,0 +1,88 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// OutboxRelayConfig tunes how the relay handles the messages it claims.
+type OutboxRelayConfig struct {
+	// LeaseDuration hides the claimed messages from the other relays while they are published;
+	// it must outlast publishing a whole batch, or another relay publishes them again
+	LeaseDuration time.Duration
+	Retry         domain.RetryPolicy
+}
+
+// OutboxRelay publishes the messages saved in the outbox; a Poller runs RelayBatch. Each batch is
+// claimed with a lease that commits right away, so no transaction stays open while the publisher
+// is called. A message is marked published only after it was sent, hence delivery is at least
+// once: consumers deduplicate by message ID.
+type OutboxRelay struct {
+	outbox    repositories.OutboxRepository
+	publisher services.EventPublisher
+	config    OutboxRelayConfig
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewOutboxRelay creates a relay. Without a lease or retry policy it waits five minutes for a batch
+// and gives a message ten attempts, backing off from five seconds up to an hour.
+func NewOutboxRelay(outbox repositories.OutboxRepository, publisher services.EventPublisher,
+	config OutboxRelayConfig, logger *slog.Logger) *OutboxRelay {
+	if config.LeaseDuration <= 0 {
+		config.LeaseDuration = 5 * time.Minute
+	}
+	if config.Retry.MaxAttempts <= 0 {
+		config.Retry.MaxAttempts = 10
+	}
+	if config.Retry.BaseDelay <= 0 {
+		config.Retry.BaseDelay = 5 * time.Second
+	}
+	if config.Retry.MaxDelay <= 0 {
+		config.Retry.MaxDelay = time.Hour
+	}
+	return &OutboxRelay{outbox: outbox, publisher: publisher, config: config, logger: logger, now: time.Now}
+}
+
+// RelayBatch publishes up to limit due messages and returns how many it handled, whether they were
+// published or failed. A publishing failure is recorded on the message, not returned: only storage
+// errors are. Each attempt is saved on its own, so one failed write does not undo the others; a
+// message whose attempt was not saved is published again once its lease expires.
+func (r *OutboxRelay) RelayBatch(ctx context.Context, limit int) (int, error) {
+	now := r.now()
+	messages, err := r.outbox.ClaimPending(ctx, limit, now, now.Add(r.config.LeaseDuration))
+	if err != nil {
+		return 0, err
+	}
+	var saveErrs []error
+	for _, message := range messages {
+		r.publish(ctx, message)
+		if err := r.outbox.SaveAttempt(ctx, message); err != nil {
+			r.logger.ErrorContext(ctx, "Outbox attempt not saved", "messageID", message.ID, "error", err)
+			saveErrs = append(saveErrs, err)
+		}
+	}
+	return len(messages), errors.Join(saveErrs...)
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, message *entities.OutboxMessage) {
+	err := r.publisher.Publish(ctx, message)
+	if err == nil {
+		message.MarkPublished(r.now())
+		return
+	}
+	if message.MarkFailed(err, r.config.Retry, r.now()) {
+		r.logger.ErrorContext(ctx, "Outbox message dead-lettered", "messageID", message.ID, "event", message.EventName,
+			"attempts", message.Attempts, "error", err)
+		return
+	}
+	r.logger.WarnContext(ctx, "Outbox message publish failed, will retry", "messageID", message.ID, "event", message.EventName,
+		"attempts", message.Attempts, "nextAttemptAt", message.NextAttemptAt, "error", err)
+}
@@ -0,0 +1,218 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// memoryOutbox keeps the messages in memory; ClaimPending honors status and next attempt and
+// leases what it returns
+type memoryOutbox struct {
+	messages  []*entities.OutboxMessage
+	appendErr error
+	saveErr   error
+	saved     int
+}
+
+func (o *memoryOutbox) Append(ctx context.Context, messages ...*entities.OutboxMessage) error {
+	if o.appendErr != nil {
+		return o.appendErr
+	}
+	o.messages = append(o.messages, messages...)
+	return nil
+}
+
+func (o *memoryOutbox) ClaimPending(_ context.Context, limit int, now, leaseUntil time.Time) ([]*entities.OutboxMessage, error) {
+	var due []*entities.OutboxMessage
+	for _, m := range o.messages {
+		if m.Status == entities.OutboxPending && !m.NextAttemptAt.After(now) && len(due) < limit {
+			m.NextAttemptAt = leaseUntil
+			due = append(due, m)
+		}
+	}
+	return due, nil
+}
+
+func (o *memoryOutbox) SaveAttempt(context.Context, *entities.OutboxMessage) error {
+	if o.saveErr != nil {
+		return o.saveErr
+	}
+	o.saved++
+	return nil
+}
+
+func (o *memoryOutbox) RequeueDead(context.Context, time.Time) (int, error) { return 0, nil }
+
+// scriptedPublisher rejects the first `failures` messages and accepts the rest
+type scriptedPublisher struct {
+	failures  int
+	published []string
+}
+
+func (p *scriptedPublisher) Publish(_ context.Context, message *entities.OutboxMessage) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("503 Service Unavailable")
+	}
+	p.published = append(p.published, message.EventName)
+	return nil
+}
+
+func TestTransactionalDecorator_SavesEventsInTheOutboxBeforeCommit(t *testing.T) {
+	var received []string
+	outbox := &memoryOutbox{}
+	dispatcher := newDispatcher(&received)
+	dispatcher.UseOutbox(outbox)
+	uow := &nestingUoW{}
+	inner := application.NewTransactionalDecorator[string, string](&recordingUseCase{}, uow, dispatcher)
+
+	err := application.ExecuteInTransaction(context.Background(), uow, dispatcher, func(txCtx context.Context) error {
+		_, _ = inner.Execute(txCtx, "emp-1")
+		_, _ = inner.Execute(txCtx, "emp-2")
+		assert.Empty(t, outbox.messages, "savepoints leave the events to the outer transaction")
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, outbox.messages, 2)
+	assert.Equal(t, "test.hired", outbox.messages[0].EventName)
+	assert.Equal(t, entities.OutboxPending, outbox.messages[0].Status)
+	assert.NotEqual(t, outbox.messages[0].ID, outbox.messages[1].ID)
+	assert.Equal(t, []string{"hired:emp-1", "hired:emp-2"}, received)
+}
+
+func TestTransactionalDecorator_OutboxFailureRollsBack(t *testing.T) {
+	var received []string
+	dispatcher := newDispatcher(&received)
+	dispatcher.UseOutbox(&memoryOutbox{appendErr: errors.New("outbox unavailable")})
+	uow := &nestingUoW{}
+	decorated := application.NewTransactionalDecorator[string, string](&recordingUseCase{}, uow, dispatcher)
+
+	_, err := decorated.Execute(context.Background(), "emp-1")
+
+	require.Error(t, err)
+	assert.Zero(t, uow.commits)
+	assert.Empty(t, received, "a change without its event is never committed")
+}
+
+func newRelay(outbox *memoryOutbox, publisher services.EventPublisher, maxAttempts int) *application.OutboxRelay {
+	return application.NewOutboxRelay(outbox, publisher, application.OutboxRelayConfig{
+		Retry: domain.RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: time.Nanosecond, MaxDelay: time.Nanosecond},
+	}, discardLogger)
+}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func appendMessage(t *testing.T, outbox *memoryOutbox) *entities.OutboxMessage {
+	message, err := entities.NewOutboxMessage(employeeHired{id: "emp-1"})
+	require.NoError(t, err)
+	require.NoError(t, outbox.Append(context.Background(), message))
+	return message
+}
+
+func TestOutboxRelay_RetriesUntilPublished(t *testing.T) {
+	outbox := &memoryOutbox{}
+	message := appendMessage(t, outbox)
+	publisher := &scriptedPublisher{failures: 2}
+	relay := newRelay(outbox, publisher, 5)
+
+	for range 3 {
+		time.Sleep(time.Millisecond) // let the backoff elapse
+		handled, err := relay.RelayBatch(context.Background(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, handled)
+	}
+
+	assert.Equal(t, entities.OutboxPublished, message.Status)
+	assert.Equal(t, 2, message.Attempts)
+	assert.NotNil(t, message.PublishedAt)
+	assert.Empty(t, message.LastError)
+	assert.Equal(t, []string{"test.hired"}, publisher.published)
+
+	handled, err := relay.RelayBatch(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Zero(t, handled, "published messages are not relayed again")
+}
+
+func TestOutboxRelay_DeadLettersAfterMaxAttempts(t *testing.T) {
+	outbox := &memoryOutbox{}
+	message := appendMessage(t, outbox)
+	relay := newRelay(outbox, &scriptedPublisher{failures: 100}, 3)
+	poller := application.NewPoller("outbox", relay.RelayBatch, application.PollerConfig{BatchSize: 10}, discardLogger)
+
+	for range 5 {
+		time.Sleep(time.Millisecond)
+		_, err := poller.Drain(context.Background())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, entities.OutboxDead, message.Status)
+	assert.Equal(t, 3, message.Attempts)
+	assert.Equal(t, "503 Service Unavailable", message.LastError)
+}
+
+func TestOutboxRelay_LeasesTheClaimedMessages(t *testing.T) {
+	outbox := &memoryOutbox{}
+	message := appendMessage(t, outbox)
+	publisher := &scriptedPublisher{}
+	// The publisher runs while the message is leased: a second relay must not see it
+	relay := newRelay(outbox, &leaseCheckingPublisher{next: publisher, outbox: outbox, t: t}, 5)
+
+	handled, err := relay.RelayBatch(context.Background(), 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, handled)
+	assert.Equal(t, entities.OutboxPublished, message.Status)
+	assert.Equal(t, 1, outbox.saved)
+}
+
+func TestOutboxRelay_SaveFailureKeepsTheOtherAttempts(t *testing.T) {
+	outbox := &memoryOutbox{}
+	appendMessage(t, outbox)
+	appendMessage(t, outbox)
+	publisher := &scriptedPublisher{}
+	relay := newRelay(outbox, publisher, 5)
+	outbox.saveErr = errors.New("connection reset")
+
+	handled, err := relay.RelayBatch(context.Background(), 10)
+
+	require.Error(t, err)
+	assert.Equal(t, 2, handled)
+	assert.Len(t, publisher.published, 2, "every claimed message is still published")
+}
+
+// leaseCheckingPublisher asserts that the message being published cannot be claimed again
+type leaseCheckingPublisher struct {
+	next   *scriptedPublisher
+	outbox *memoryOutbox
+	t      *testing.T
+}
+
+func (p *leaseCheckingPublisher) Publish(ctx context.Context, message *entities.OutboxMessage) error {
+	claimed, err := p.outbox.ClaimPending(ctx, 10, time.Now(), time.Now().Add(time.Minute))
+	require.NoError(p.t, err)
+	assert.Empty(p.t, claimed)
+	return p.next.Publish(ctx, message)
+}
+
+func TestRetryPolicy_BacksOffExponentiallyUpToTheCap(t *testing.T) {
+	policy := domain.RetryPolicy{MaxAttempts: 10, BaseDelay: 5 * time.Second, MaxDelay: time.Minute}
+
+	assert.Equal(t, 5*time.Second, policy.Delay(1))
+	assert.Equal(t, 10*time.Second, policy.Delay(2))
+	assert.Equal(t, 40*time.Second, policy.Delay(4))
+	assert.Equal(t, time.Minute, policy.Delay(5))
+	assert.Equal(t, time.Minute, policy.Delay(30))
+}
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// PollerConfig tunes how a Poller asks for work.
+type PollerConfig struct {
+	BatchSize    int           // Items handed to each batch
+	PollInterval time.Duration // Wait between polls once nothing is due
+}
+
+// BatchJob handles up to limit due items and returns how many it handled. Only storage errors are
+// returned: a failure of an item is recorded on the item and retried later.
+type BatchJob func(ctx context.Context, limit int) (int, error)
+
+// Poller drives a BatchJob over a table used as a queue, such as the outbox relay or the webhook
+// deliveries. The job claims its items with a lease, so any number of pollers can run the same job
+// side by side.
+type Poller struct {
+	name   string
+	job    BatchJob
+	config PollerConfig
+	logger *slog.Logger
+}
+
+// NewPoller creates a poller; a zero BatchSize or PollInterval falls back to 100 items and one second.
+func NewPoller(name string, job BatchJob, config PollerConfig, logger *slog.Logger) *Poller {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Second
+	}
+	return &Poller{name: name, job: job, config: config, logger: logger}
+}
+
+// Name identifies the job in the logs.
+func (p *Poller) Name() string {
+	return p.name
+}
+
+// Drain runs batches until one comes back short and returns how many items it handled. The failed
+// ones are rescheduled for later, so they do not keep it running.
+func (p *Poller) Drain(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		handled, err := p.job(ctx, p.config.BatchSize)
+		total += handled
+		if err != nil || handled < p.config.BatchSize {
+			return total, err
+		}
+	}
+}
+
+// Run polls until ctx is canceled. A full batch is followed right away by the next one; otherwise
+// it waits PollInterval. Errors are logged and retried on the next poll.
+func (p *Poller) Run(ctx context.Context) {
+	for {
+		handled, err := p.job(ctx, p.config.BatchSize)
+		if err != nil && ctx.Err() == nil {
+			p.logger.ErrorContext(ctx, "Batch failed", "job", p.name, "error", err)
+		}
+		if err == nil && handled == p.config.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.config.PollInterval):
+		}
+	}
+}
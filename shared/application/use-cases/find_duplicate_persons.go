@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// FindDuplicatePersonsQuery identifies the person whose duplicates are searched.
+type FindDuplicatePersonsQuery struct {
+	PersonID string
+}
+
+// FindDuplicatePersonsUseCase lists the persons that are likely duplicates of a given one.
+type FindDuplicatePersonsUseCase struct {
+	personRepo repositories.PersonRepository
+	detector   *services.DuplicatePersonDetector
+}
+
+// NewFindDuplicatePersonsUseCase creates a new FindDuplicatePersonsUseCase.
+func NewFindDuplicatePersonsUseCase(personRepo repositories.PersonRepository, detector *services.DuplicatePersonDetector) *FindDuplicatePersonsUseCase {
+	return &FindDuplicatePersonsUseCase{personRepo: personRepo, detector: detector}
+}
+
+// Execute loads the person, preselects candidates in the repository and scores them with the detector.
+// Candidates are returned from the most to the least likely duplicate.
+func (uc *FindDuplicatePersonsUseCase) Execute(ctx context.Context, query FindDuplicatePersonsQuery) ([]dto.DuplicateCandidateResponse, error) {
+	person, err := uc.personRepo.GetPersonByID(ctx, query.PersonID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading person: %w", err)
+	}
+	if person == nil {
+		return nil, domain.NewNotFoundError("person.not_found", nil)
+	}
+
+	candidates, err := uc.personRepo.FindDuplicateCandidates(ctx, person)
+	if err != nil {
+		return nil, fmt.Errorf("error finding duplicate candidates: %w", err)
+	}
+
+	viewer := masking.ViewerFromContext(ctx)
+	result := make([]dto.DuplicateCandidateResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		match, isDuplicate := uc.detector.Match(person, candidate)
+		if !isDuplicate {
+			continue
+		}
+		reasons := make([]string, len(match.Reasons))
+		for i, reason := range match.Reasons {
+			reasons[i] = string(reason)
+		}
+		result = append(result, dto.DuplicateCandidateResponse{
+			Person:  dto.NewPersonResponse(candidate).Masked(viewer),
+			Score:   match.Score,
+			Reasons: reasons,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Score > result[j].Score })
+
+	return result, nil
+}
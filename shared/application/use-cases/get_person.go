@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// GetPersonQuery identifies the person to read.
+type GetPersonQuery struct {
+	PersonID string
+}
+
+// GetPersonUseCase reads a person with its current version.
+type GetPersonUseCase struct {
+	personRepo repositories.PersonRepository
+}
+
+// NewGetPersonUseCase creates a new GetPersonUseCase.
+func NewGetPersonUseCase(personRepo repositories.PersonRepository) *GetPersonUseCase {
+	return &GetPersonUseCase{personRepo: personRepo}
+}
+
+// Execute loads the person and masks what the caller's roles cannot see.
+func (uc *GetPersonUseCase) Execute(ctx context.Context, query GetPersonQuery) (dto.PersonResponse, error) {
+	agg, err := uc.personRepo.GetPersonByID(ctx, query.PersonID)
+	if err != nil {
+		return dto.PersonResponse{}, fmt.Errorf("error loading person: %w", err)
+	}
+	if agg == nil {
+		return dto.PersonResponse{}, domain.NewNotFoundError("person.not_found", nil)
+	}
+	return dto.NewPersonResponse(agg).Masked(masking.ViewerFromContext(ctx)), nil
+}
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// documentLengths holds the expected length of each document type that can be looked up.
+var documentLengths = map[services.LookupDocumentType]int{
+	services.LookupDNI: 8,
+	services.LookupRUC: 11,
+}
+
+// LookupPersonQuery encapsulates the document to look up in the public registries.
+type LookupPersonQuery struct {
+	Data dto.PersonLookupRequest
+}
+
+// LookupPersonUseCase prefills person data from RENIEC (DNI) or SUNAT (RUC).
+type LookupPersonUseCase struct {
+	lookupService services.PersonLookupService
+}
+
+// NewLookupPersonUseCase creates a new LookupPersonUseCase.
+func NewLookupPersonUseCase(lookupService services.PersonLookupService) *LookupPersonUseCase {
+	return &LookupPersonUseCase{lookupService: lookupService}
+}
+
+// Execute validates the document and queries the lookup provider.
+func (uc *LookupPersonUseCase) Execute(ctx context.Context, query LookupPersonQuery) (dto.PersonLookupResponse, error) {
+	documentType := services.LookupDocumentType(query.Data.Type)
+	expectedLength, ok := documentLengths[documentType]
+	if !ok {
+		return dto.PersonLookupResponse{}, domain.NewInvalidInputError("person.lookup_unsupported_document", nil).WithParams(domain.Params{"documentType": query.Data.Type})
+	}
+	if len(query.Data.Number) != expectedLength {
+		return dto.PersonLookupResponse{}, domain.NewInvalidInputError("person.document_length", nil).WithParams(domain.Params{"documentType": documentType, "length": expectedLength})
+	}
+
+	result, err := uc.lookupService.Lookup(ctx, documentType, query.Data.Number)
+	if err != nil {
+		return dto.PersonLookupResponse{}, err
+	}
+
+	return dto.PersonLookupResponse{
+		DocumentType:     string(result.DocumentType),
+		DocumentNumber:   result.DocumentNumber,
+		FirstName:        result.FirstName,
+		LastNamePaternal: result.LastNamePaternal,
+		LastNameMaternal: result.LastNameMaternal,
+		BusinessName:     result.BusinessName,
+		TradeName:        result.TradeName,
+		Address:          result.Address,
+		Status:           result.Status,
+		Condition:        result.Condition,
+	}, nil
+}
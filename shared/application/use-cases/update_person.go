@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	"github.com/kevinsoras/employee-management/shared/application/mappers"
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+// UpdatePersonCommand encapsulates the contact data to change on an existing person.
+type UpdatePersonCommand struct {
+	PersonID string
+	// ExpectedVersion is the version the client read (If-Match); the update fails if it changed since.
+	ExpectedVersion int
+	Data            dto.PersonUpdateRequest
+}
+
+// UpdatePersonUseCase updates the contact information, typed contacts and structured address of a person.
+type UpdatePersonUseCase struct {
+	personRepo repositories.PersonRepository
+}
+
+// NewUpdatePersonUseCase creates a new UpdatePersonUseCase.
+func NewUpdatePersonUseCase(personRepo repositories.PersonRepository) *UpdatePersonUseCase {
+	return &UpdatePersonUseCase{personRepo: personRepo}
+}
+
+// Execute loads the person, applies the changes through the aggregate and persists them.
+func (uc *UpdatePersonUseCase) Execute(ctx context.Context, cmd UpdatePersonCommand) (dto.PersonResponse, error) {
+	// 1. Load the aggregate
+	agg, err := uc.personRepo.GetPersonByID(ctx, cmd.PersonID)
+	if err != nil {
+		return dto.PersonResponse{}, fmt.Errorf("error loading person: %w", err)
+	}
+	if agg == nil {
+		return dto.PersonResponse{}, domain.NewNotFoundError("person.not_found", nil)
+	}
+	if agg.Person.Version != cmd.ExpectedVersion {
+		return dto.PersonResponse{}, domain.NewVersionConflictError("person.version_conflict", nil)
+	}
+
+	// 2. Apply contact information, typed contacts and structured address
+	if err := mappers.ApplyPersonUpdate(agg, cmd.Data); err != nil {
+		return dto.PersonResponse{}, err
+	}
+
+	// 3. Persist (the repository re-checks the version to catch concurrent updates)
+	if err := uc.personRepo.UpdatePerson(ctx, agg); err != nil {
+		return dto.PersonResponse{}, fmt.Errorf("error updating person: %w", err)
+	}
+
+	return dto.NewPersonResponse(agg).Masked(masking.ViewerFromContext(ctx)), nil
+}
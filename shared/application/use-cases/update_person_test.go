@@ -0,0 +1,209 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	usecases "github.com/kevinsoras/employee-management/shared/application/use-cases"
+	sharedDomain "github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// MockPersonRepository is a mock implementation of PersonRepository
+type MockPersonRepository struct {
+	mock.Mock
+}
+
+func (m *MockPersonRepository) SavePerson(ctx context.Context, person *aggregates.PersonAggregate) error {
+	args := m.Called(ctx, person)
+	return args.Error(0)
+}
+
+func (m *MockPersonRepository) UpdatePerson(ctx context.Context, person *aggregates.PersonAggregate) error {
+	args := m.Called(ctx, person)
+	return args.Error(0)
+}
+
+func (m *MockPersonRepository) GetPersonByID(ctx context.Context, id string) (*aggregates.PersonAggregate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*aggregates.PersonAggregate), args.Error(1)
+}
+
+func (m *MockPersonRepository) GetPersonByDocument(ctx context.Context, documentType, documentNumber string) (*aggregates.PersonAggregate, error) {
+	args := m.Called(ctx, documentType, documentNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*aggregates.PersonAggregate), args.Error(1)
+}
+
+func (m *MockPersonRepository) FindDuplicateCandidates(ctx context.Context, person *aggregates.PersonAggregate) ([]*aggregates.PersonAggregate, error) {
+	args := m.Called(ctx, person)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*aggregates.PersonAggregate), args.Error(1)
+}
+
+func (m *MockPersonRepository) DeletePerson(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPersonRepository) SavePersonMerge(ctx context.Context, merge *entities.PersonMerge) error {
+	args := m.Called(ctx, merge)
+	return args.Error(0)
+}
+
+func newExistingPerson() *aggregates.PersonAggregate {
+	person := entities.NewPerson(value_objects.Natural, "old@example.com", "987654321", "Av. Antigua 1", "Peru")
+	birthDate := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	firstName, lastPat, gender := "John", "Doe", "M"
+	natural, _ := entities.NewNaturalPerson(person.ID, value_objects.DNI, "12345678", &firstName, &lastPat, nil, &gender, &birthDate, nil, nil)
+	return aggregates.NewPersonAggregate(person, natural, nil)
+}
+
+func validUpdateRequest() dto.PersonUpdateRequest {
+	return dto.PersonUpdateRequest{
+		Email:   "new@example.com",
+		Phone:   "912345678",
+		Address: "Av. Nueva 123",
+		Country: "Peru",
+		Contacts: []dto.ContactRequest{
+			{Type: "WORK_EMAIL", Value: "john.doe@empresa.com", IsPrimary: true},
+			{Type: "EMERGENCY", Value: "998877665", Name: "Jane Doe", Relationship: "Madre"},
+		},
+		StructuredAddress: &dto.AddressRequest{Street: "Av. Nueva 123", Ubigeo: "150101"},
+	}
+}
+
+func TestUpdatePersonUseCase_Execute_Success(t *testing.T) {
+	// Given
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewUpdatePersonUseCase(mockPersonRepo)
+	existing := newExistingPerson()
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+	mockPersonRepo.On("UpdatePerson", mock.Anything, existing).Return(nil)
+
+	// When
+	ctx := security.WithPrincipal(context.Background(), &security.Principal{UserID: "u-1", Roles: []security.Role{security.RoleHRAnalyst}})
+	resp, err := useCase.Execute(ctx, usecases.UpdatePersonCommand{PersonID: existing.Person.ID, ExpectedVersion: 1, Data: validUpdateRequest()})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "new@example.com", resp.Email)
+	assert.Equal(t, "12345678", resp.DocumentNumber)
+	assert.Len(t, resp.Contacts, 2)
+	assert.Equal(t, "EMERGENCY", resp.Contacts[1].Type)
+	assert.Equal(t, "MADRE", resp.Contacts[1].Relationship)
+	if assert.NotNil(t, resp.StructuredAddress) {
+		assert.Equal(t, "LIMA", resp.StructuredAddress.Department)
+	}
+	mockPersonRepo.AssertExpectations(t)
+}
+
+func TestUpdatePersonUseCase_Execute_MasksTheResponseForTheViewer(t *testing.T) {
+	// Given
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewUpdatePersonUseCase(mockPersonRepo)
+	existing := newExistingPerson()
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+	mockPersonRepo.On("UpdatePerson", mock.Anything, existing).Return(nil)
+
+	// When
+	resp, err := useCase.Execute(context.Background(), usecases.UpdatePersonCommand{PersonID: existing.Person.ID, ExpectedVersion: 1, Data: validUpdateRequest()})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "12****78", resp.DocumentNumber)
+	assert.Equal(t, "n***@example.com", resp.Email)
+}
+
+func TestUpdatePersonUseCase_Execute_NotFound(t *testing.T) {
+	// Given
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewUpdatePersonUseCase(mockPersonRepo)
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, "missing").Return(nil, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdatePersonCommand{PersonID: "missing", Data: validUpdateRequest()})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "NOT_FOUND", domainErr.Code)
+	mockPersonRepo.AssertNotCalled(t, "UpdatePerson", mock.Anything, mock.Anything)
+}
+
+func TestUpdatePersonUseCase_Execute_VersionConflict(t *testing.T) {
+	// Given
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewUpdatePersonUseCase(mockPersonRepo)
+	existing := newExistingPerson()
+	existing.Person.Version = 2 // Otro analista guardó cambios después de la lectura
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdatePersonCommand{PersonID: existing.Person.ID, ExpectedVersion: 1, Data: validUpdateRequest()})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "VERSION_CONFLICT", domainErr.Code)
+	assert.Equal(t, 412, domainErr.HTTPStatusCode)
+	mockPersonRepo.AssertNotCalled(t, "UpdatePerson", mock.Anything, mock.Anything)
+}
+
+func TestUpdatePersonUseCase_Execute_InvalidUbigeo(t *testing.T) {
+	// Given
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewUpdatePersonUseCase(mockPersonRepo)
+	existing := newExistingPerson()
+	req := validUpdateRequest()
+	req.StructuredAddress.Ubigeo = "269901" // Departamento 26 no existe
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdatePersonCommand{PersonID: existing.Person.ID, ExpectedVersion: 1, Data: req})
+
+	// Then
+	var domainErr *sharedDomain.DomainError
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "INVALID_INPUT", domainErr.Code)
+	mockPersonRepo.AssertNotCalled(t, "UpdatePerson", mock.Anything, mock.Anything)
+}
+
+func TestUpdatePersonUseCase_Execute_DuplicatePrimaryContact(t *testing.T) {
+	// Given
+	mockPersonRepo := new(MockPersonRepository)
+	useCase := usecases.NewUpdatePersonUseCase(mockPersonRepo)
+	existing := newExistingPerson()
+	req := validUpdateRequest()
+	req.Contacts = append(req.Contacts, dto.ContactRequest{Type: "WORK_EMAIL", Value: "otro@empresa.com", IsPrimary: true})
+
+	mockPersonRepo.On("GetPersonByID", mock.Anything, existing.Person.ID).Return(existing, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), usecases.UpdatePersonCommand{PersonID: existing.Person.ID, ExpectedVersion: 1, Data: req})
+
+	// Then
+	assert.Error(t, err)
+	mockPersonRepo.AssertNotCalled(t, "UpdatePerson", mock.Anything, mock.Anything)
+}
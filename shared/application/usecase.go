@@ -13,16 +13,19 @@ type UseCase[TRequest any, TResponse any] interface {
 }
 
 // TransactionalDecorator is a generic decorator that wraps a UseCase to run it within a transaction.
+// The domain events recorded by the use case are dispatched after the transaction commits.
 type TransactionalDecorator[TRequest any, TResponse any] struct {
-	useCase UseCase[TRequest, TResponse]
-	uow     domain.UnitOfWork
+	useCase    UseCase[TRequest, TResponse]
+	uow        domain.UnitOfWork
+	dispatcher *EventDispatcher
 }
 
 // NewTransactionalDecorator creates a new transactional decorator.
-func NewTransactionalDecorator[TRequest any, TResponse any](useCase UseCase[TRequest, TResponse], uow domain.UnitOfWork) UseCase[TRequest, TResponse] {
+func NewTransactionalDecorator[TRequest any, TResponse any](useCase UseCase[TRequest, TResponse], uow domain.UnitOfWork, dispatcher *EventDispatcher) UseCase[TRequest, TResponse] {
 	return &TransactionalDecorator[TRequest, TResponse]{
-		useCase: useCase,
-		uow:     uow,
+		useCase:    useCase,
+		uow:        uow,
+		dispatcher: dispatcher,
 	}
 }
 
@@ -31,7 +34,7 @@ func (d *TransactionalDecorator[TRequest, TResponse]) Execute(ctx context.Contex
 	var response TResponse
 	var err error
 
-	err = d.uow.Execute(ctx, func(txCtx context.Context) error {
+	err = ExecuteInTransaction(ctx, d.uow, d.dispatcher, func(txCtx context.Context) error {
 		response, err = d.useCase.Execute(txCtx, req)
 		return err
 	})
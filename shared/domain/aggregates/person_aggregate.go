@@ -1,11 +1,24 @@
 package aggregates
 
-import "github.com/kevinsoras/employee-management/shared/domain/entities"
+import (
+	"errors"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/events"
+)
+
+// maxContacts limita la cantidad de medios de contacto por persona
+const maxContacts = 10
 
 type PersonAggregate struct {
 	Person          *entities.Person
 	NaturalPerson   *entities.NaturalPerson
 	JuridicalPerson *entities.JuridicalPerson
+	Contacts        []*entities.Contact
+	Address         *entities.Address // Domicilio estructurado (opcional)
+	events          domain.EventRecorder
 }
 
 func NewPersonAggregate(person *entities.Person, np *entities.NaturalPerson, jp *entities.JuridicalPerson) *PersonAggregate {
@@ -15,3 +28,120 @@ func NewPersonAggregate(person *entities.Person, np *entities.NaturalPerson, jp
 		JuridicalPerson: jp,
 	}
 }
+
+// Validate vuelve a validar la estructura de los datos específicos de la persona (documento,
+// nombres, fechas), p. ej. al reutilizar una persona registrada con reglas anteriores. La vigencia
+// del permiso de trabajo no se revisa aquí: NaturalPerson.EnsureWorkPermitValidOn la exige al contratar.
+func (a *PersonAggregate) Validate() error {
+	if a.NaturalPerson != nil {
+		if err := a.NaturalPerson.Validate(); err != nil {
+			return err
+		}
+	}
+	if a.JuridicalPerson != nil {
+		if err := a.JuridicalPerson.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarkRegistered registra el evento PersonRegistered de una persona recién creada.
+func (a *PersonAggregate) MarkRegistered() {
+	a.events.Record(events.PersonRegistered{PersonID: a.Person.ID, PersonType: a.Person.Type, At: time.Now()})
+}
+
+// PullEvents devuelve los eventos pendientes del agregado y los descarta.
+func (a *PersonAggregate) PullEvents() []domain.DomainEvent {
+	return a.events.PullEvents()
+}
+
+// ReplaceContacts reemplaza todos los contactos validando que no se repitan
+// y que exista a lo sumo un contacto principal por tipo.
+func (a *PersonAggregate) ReplaceContacts(contacts []*entities.Contact) error {
+	if len(contacts) > maxContacts {
+		return domain.NewFieldError("contacts", "max", "contact.too_many", domain.Params{"max": maxContacts})
+	}
+	seen := make(map[string]struct{}, len(contacts))
+	primaries := make(map[string]struct{})
+	for _, c := range contacts {
+		if c.PersonID != a.Person.ID {
+			return errors.New("el contacto no pertenece a la persona")
+		}
+		key := string(c.Type) + ":" + c.Value
+		if _, dup := seen[key]; dup {
+			return domain.NewFieldError("contacts", "unique", "contact.duplicated", domain.Params{"type": c.Type, "value": c.Value})
+		}
+		seen[key] = struct{}{}
+		if c.IsPrimary {
+			if _, dup := primaries[string(c.Type)]; dup {
+				return domain.NewFieldError("contacts.isPrimary", "unique", "contact.primary_duplicated", domain.Params{"type": c.Type})
+			}
+			primaries[string(c.Type)] = struct{}{}
+		}
+	}
+	a.Contacts = contacts
+	return nil
+}
+
+// SetAddress asigna (o elimina, si es nil) el domicilio estructurado
+func (a *PersonAggregate) SetAddress(address *entities.Address) error {
+	if address != nil && address.PersonID != a.Person.ID {
+		return errors.New("la dirección no pertenece a la persona")
+	}
+	a.Address = address
+	return nil
+}
+
+// AbsorbContactsAndAddress copia a esta persona los contactos y el domicilio de un duplicado que
+// se va a eliminar. Los contactos repetidos se omiten y los copiados solo quedan como principales
+// si esta persona no tiene uno de ese tipo; el domicilio se adopta si esta persona no tiene uno y,
+// si ambas tienen domicilios distintos, la fusión se rechaza. Devuelve true si algo cambió.
+func (a *PersonAggregate) AbsorbContactsAndAddress(duplicate *PersonAggregate) (bool, error) {
+	address := a.Address
+	if other := duplicate.Address; other != nil {
+		if address == nil {
+			address = &entities.Address{PersonID: a.Person.ID, Street: other.Street, Reference: other.Reference, Ubigeo: other.Ubigeo}
+		} else if address.Street != other.Street || address.Reference != other.Reference || address.Ubigeo != other.Ubigeo {
+			return false, domain.NewBusinessRuleError("person.merge_address_conflict", nil)
+		}
+	}
+
+	contacts := append([]*entities.Contact(nil), a.Contacts...)
+	seen := make(map[string]struct{}, len(contacts))
+	primaries := make(map[string]struct{})
+	for _, c := range contacts {
+		seen[string(c.Type)+":"+c.Value] = struct{}{}
+		if c.IsPrimary {
+			primaries[string(c.Type)] = struct{}{}
+		}
+	}
+	for _, c := range duplicate.Contacts {
+		if _, dup := seen[string(c.Type)+":"+c.Value]; dup {
+			continue
+		}
+		_, hasPrimary := primaries[string(c.Type)]
+		moved, err := entities.NewContact(a.Person.ID, string(c.Type), c.Value, c.Name, c.Relationship, c.IsPrimary && !hasPrimary)
+		if err != nil {
+			return false, err
+		}
+		contacts = append(contacts, moved)
+		seen[string(c.Type)+":"+c.Value] = struct{}{}
+		if moved.IsPrimary {
+			primaries[string(c.Type)] = struct{}{}
+		}
+	}
+
+	changed := len(contacts) != len(a.Contacts) || address != a.Address
+	if !changed {
+		return false, nil
+	}
+	if err := a.ReplaceContacts(contacts); err != nil {
+		return false, err
+	}
+	if err := a.SetAddress(address); err != nil {
+		return false, err
+	}
+	a.Person.UpdatedAt = time.Now()
+	return true, nil
+}
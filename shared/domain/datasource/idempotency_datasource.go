@@ -0,0 +1,13 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+type IdempotencyDataSource interface {
+	// Reserve registra la clave; si ya existe una vigente la devuelve sin modificarla
+	Reserve(ctx context.Context, record *entities.IdempotencyRecord) (*entities.IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, record *entities.IdempotencyRecord) error
+}
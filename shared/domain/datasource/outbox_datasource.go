@@ -0,0 +1,15 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+type OutboxDataSource interface {
+	Append(ctx context.Context, messages ...*entities.OutboxMessage) error
+	ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.OutboxMessage, error)
+	SaveAttempt(ctx context.Context, message *entities.OutboxMessage) error
+	RequeueDead(ctx context.Context, now time.Time) (int, error)
+}
@@ -4,9 +4,19 @@ import (
 	"context"
 
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
 )
 
 type PersonDataSource interface {
 	SavePerson(ctx context.Context, person *aggregates.PersonAggregate) error
+	UpdatePerson(ctx context.Context, person *aggregates.PersonAggregate) error
 	GetPersonByID(ctx context.Context, id string) (*aggregates.PersonAggregate, error)
+	// GetPersonByDocument busca por tipo y número de documento (RUC para personas jurídicas)
+	GetPersonByDocument(ctx context.Context, documentType, documentNumber string) (*aggregates.PersonAggregate, error)
+	// FindDuplicateCandidates preselecciona personas del mismo tipo con teléfono o fecha de nacimiento
+	// coincidentes o nombre parecido, de la más a la menos cercana; la evaluación fina la hace
+	// DuplicatePersonDetector
+	FindDuplicateCandidates(ctx context.Context, person *aggregates.PersonAggregate) ([]*aggregates.PersonAggregate, error)
+	DeletePerson(ctx context.Context, id string) error
+	SavePersonMerge(ctx context.Context, merge *entities.PersonMerge) error
 }
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// Address - domicilio estructurado de una persona, ubicado por su ubigeo INEI
+type Address struct {
+	PersonID  string
+	Street    string // Vía, número, interior, urbanización
+	Reference string
+	Ubigeo    value_objects.Ubigeo
+}
+
+// Constructor con validación interna
+func NewAddress(personID, street, reference, ubigeo string) (*Address, error) {
+	u, err := value_objects.NewUbigeo(strings.TrimSpace(ubigeo))
+	if err != nil {
+		return nil, domain.NewFieldError("structuredAddress.ubigeo", "ubigeo", "address.ubigeo_invalid", domain.Params{"value": ubigeo})
+	}
+
+	a := &Address{
+		PersonID:  personID,
+		Street:    strings.TrimSpace(street),
+		Reference: strings.TrimSpace(reference),
+		Ubigeo:    u,
+	}
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Validate - valida campos requeridos y reglas de negocio
+func (a *Address) Validate() error {
+	if a.PersonID == "" {
+		return domain.NewRequiredFieldError("personId")
+	}
+	if a.Street == "" {
+		return domain.NewRequiredFieldError("structuredAddress.street")
+	}
+	if len(a.Street) > 200 {
+		return domain.NewMaxLengthFieldError("structuredAddress.street", 200)
+	}
+	if len(a.Reference) > 200 {
+		return domain.NewMaxLengthFieldError("structuredAddress.reference", 200)
+	}
+	if a.Ubigeo == "" {
+		return domain.NewRequiredFieldError("structuredAddress.ubigeo")
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// Contact - medio de contacto tipado de una persona (correo personal/laboral, celular, emergencia)
+type Contact struct {
+	ID           string
+	PersonID     string
+	Type         value_objects.ContactType
+	Value        string
+	Name         string // Solo para contactos de emergencia
+	Relationship string // Solo para contactos de emergencia (ej: MADRE, CÓNYUGE)
+	IsPrimary    bool
+}
+
+// Constructor con validación interna
+func NewContact(personID, contactType, value, name, relationship string, isPrimary bool) (*Contact, error) {
+	ct, err := value_objects.NewContactType(contactType)
+	if err != nil {
+		return nil, domain.NewFieldError("contacts.type", "oneof", "contact.type_invalid", domain.Params{"value": contactType})
+	}
+	u7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Contact{
+		ID:           u7.String(),
+		PersonID:     personID,
+		Type:         ct,
+		Value:        strings.TrimSpace(value),
+		Name:         strings.TrimSpace(name),
+		Relationship: strings.ToUpper(strings.TrimSpace(relationship)),
+		IsPrimary:    isPrimary,
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate - valida campos requeridos y reglas de negocio
+func (c *Contact) Validate() error {
+	if c.PersonID == "" {
+		return domain.NewRequiredFieldError("personId")
+	}
+	if c.Value == "" {
+		return domain.NewRequiredFieldError("contacts.value")
+	}
+	if c.Type.IsEmail() {
+		if _, err := value_objects.NewEmail(c.Value); err != nil {
+			return domain.NewFieldError("contacts.value", "email", "validation.email", nil)
+		}
+	} else if _, err := value_objects.NewPhone(c.Value); err != nil {
+		return domain.NewFieldError("contacts.value", "phone", "validation.phone", nil)
+	}
+	if c.Type == value_objects.EmergencyContact {
+		if c.Name == "" {
+			return domain.NewFieldError("contacts.name", "required_if", "contact.emergency_name_required", nil)
+		}
+		if len(c.Name) > 100 {
+			return domain.NewMaxLengthFieldError("contacts.name", 100)
+		}
+		if len(c.Relationship) > 30 {
+			return domain.NewMaxLengthFieldError("contacts.relationship", 30)
+		}
+	}
+	return nil
+}
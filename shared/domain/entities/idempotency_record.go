@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// IdempotencyRecord - respuesta guardada de una operación ejecutada con un Idempotency-Key
+type IdempotencyRecord struct {
+	Scope       string // Operación protegida (ej: employee.register)
+	Key         string // Valor del header Idempotency-Key
+	UserID      string // Las claves son por usuario: dos usuarios pueden usar el mismo valor
+	RequestHash string // SHA-256 del payload; la misma clave con otro payload se rechaza
+	Response    []byte // Respuesta serializada (JSON); vacía mientras la operación no termina
+	CreatedAt   time.Time
+	ExpiresAt   time.Time // Pasada esta fecha la clave puede reutilizarse
+}
+
+// Constructor con validación interna
+func NewIdempotencyRecord(scope, key, userID, requestHash string, ttl time.Duration) (*IdempotencyRecord, error) {
+	now := time.Now()
+	r := &IdempotencyRecord{
+		Scope:       scope,
+		Key:         strings.TrimSpace(key),
+		UserID:      userID,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Validate - valida campos requeridos
+func (r *IdempotencyRecord) Validate() error {
+	if r.Scope == "" || r.RequestHash == "" {
+		return errors.New("la operación y el hash de la solicitud son obligatorios")
+	}
+	if r.Key == "" || len(r.Key) > 255 {
+		return errors.New("el Idempotency-Key debe tener entre 1 y 255 caracteres")
+	}
+	if !r.ExpiresAt.After(r.CreatedAt) {
+		return errors.New("la vigencia del Idempotency-Key debe ser positiva")
+	}
+	return nil
+}
+
+// Matches indica si el payload recibido es el mismo con el que se registró la clave
+func (r *IdempotencyRecord) Matches(requestHash string) bool {
+	return r.RequestHash == requestHash
+}
+
+// Completed indica si la operación original terminó y su respuesta puede repetirse
+func (r *IdempotencyRecord) Completed() bool {
+	return len(r.Response) > 0
+}
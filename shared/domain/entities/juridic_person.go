@@ -1,8 +1,9 @@
 package entities
 
 import (
-	"errors"
 	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
 )
 
 type JuridicalPerson struct {
@@ -50,53 +51,53 @@ func NewJuridicalPerson(personID string, docNum string, businessName *string,
 // Validate - valida campos requeridos y reglas de negocio
 func (n *JuridicalPerson) Validate() error {
 	if n.PersonID == "" {
-		return errors.New("person ID is required")
+		return domain.NewRequiredFieldError("personId")
 	}
 	if n.BusinessName == "" {
-		return errors.New("business name is required")
+		return domain.NewRequiredFieldError("businessName")
 	}
 	if len(n.BusinessName) > 100 {
-		return errors.New("business name too long")
+		return domain.NewMaxLengthFieldError("businessName", 100)
 	}
 	if n.DocumentNumber == "" {
-		return errors.New("document number is required")
+		return domain.NewRequiredFieldError("documentNumber")
 	}
 	// Validación de RUC peruano: 11 dígitos numéricos
 	if len(n.DocumentNumber) != 11 {
-		return errors.New("el RUC debe tener 11 dígitos")
+		return domain.NewFieldError("documentNumber", "len", "person.document_length", domain.Params{"documentType": "RUC", "length": 11})
 	}
 	for _, c := range n.DocumentNumber {
 		if c < '0' || c > '9' {
-			return errors.New("el RUC solo puede contener números")
+			return domain.NewFieldError("documentNumber", "numeric", "validation.numeric", nil)
 		}
 	}
 	if n.RepresentativeName == "" {
-		return errors.New("representative name is required")
+		return domain.NewRequiredFieldError("representativeName")
 	}
 	if len(n.RepresentativeName) > 100 {
-		return errors.New("representative name too long")
+		return domain.NewMaxLengthFieldError("representativeName", 100)
 	}
 	if n.RepresentativeDocument == "" {
-		return errors.New("representative document is required")
+		return domain.NewRequiredFieldError("representativeDocument")
 	}
 	if len(n.RepresentativeDocument) > 20 {
-		return errors.New("representative document too long")
+		return domain.NewMaxLengthFieldError("representativeDocument", 20)
 	}
 	if n.TradeName == "" {
-		return errors.New("trade name is required")
+		return domain.NewRequiredFieldError("tradeName")
 	}
 	if len(n.TradeName) > 100 {
-		return errors.New("trade name too long")
+		return domain.NewMaxLengthFieldError("tradeName", 100)
 	}
 	if n.ConstitutionDate.IsZero() {
-		return errors.New("constitution date is required")
+		return domain.NewRequiredFieldError("constitutionDate")
 	}
 	// Fecha de constitución no puede ser futura ni antes de 1900
 	if n.ConstitutionDate.After(time.Now()) {
-		return errors.New("constitution date cannot be in the future")
+		return domain.NewFieldError("constitutionDate", "max", "validation.date_not_future", nil)
 	}
 	if n.ConstitutionDate.Before(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)) {
-		return errors.New("constitution date cannot be before 1900")
+		return domain.NewFieldError("constitutionDate", "min", "validation.date_min", domain.Params{"min": 1900})
 	}
 	return nil
 }
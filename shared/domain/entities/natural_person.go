@@ -1,34 +1,47 @@
 package entities
 
 import (
-	"errors"
+	"strings"
 	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
 )
 
+// defaultNationality es la nacionalidad asumida para titulares de DNI (ISO 3166-1 alfa-3)
+const defaultNationality = "PER"
+
 type NaturalPerson struct {
 	PersonID         string
+	DocumentType     value_objects.DocumentType
 	DocumentNumber   string
 	FirstName        string
 	LastNamePaternal string
 	LastNameMaternal string
 	BirthDate        time.Time
 	Gender           string // M, F, O
+	Nationality      string // ISO 3166-1 alfa-3
+	WorkPermitExpiry time.Time
 }
 
 // Constructor con validación interna
 func NewNaturalPerson(
 	personID string,
+	documentType value_objects.DocumentType,
 	documentNumber string,
 	firstName *string,
 	lastPat *string,
 	lastMat *string,
 	gender *string,
 	birthDate *time.Time,
+	nationality *string,
+	workPermitExpiry *time.Time,
 ) (*NaturalPerson, error) {
 
 	n := &NaturalPerson{
 		PersonID:       personID,
-		DocumentNumber: documentNumber,
+		DocumentType:   documentType,
+		DocumentNumber: strings.ToUpper(strings.TrimSpace(documentNumber)),
 	}
 
 	if firstName != nil {
@@ -46,6 +59,15 @@ func NewNaturalPerson(
 	if birthDate != nil {
 		n.BirthDate = *birthDate
 	}
+	if nationality != nil {
+		n.Nationality = strings.ToUpper(strings.TrimSpace(*nationality))
+	}
+	if n.Nationality == "" && !documentType.IsForeign() {
+		n.Nationality = defaultNationality
+	}
+	if workPermitExpiry != nil {
+		n.WorkPermitExpiry = *workPermitExpiry
+	}
 
 	// Validación
 	if err := n.Validate(); err != nil {
@@ -58,47 +80,83 @@ func NewNaturalPerson(
 // Validate - valida campos requeridos y reglas de negocio
 func (n *NaturalPerson) Validate() error {
 	if n.PersonID == "" {
-		return errors.New("personID es obligatorio")
+		return domain.NewRequiredFieldError("personId")
+	}
+	if n.DocumentType == "" {
+		return domain.NewRequiredFieldError("documentType")
 	}
 	if n.DocumentNumber == "" {
-		return errors.New("documentNumber es obligatorio")
+		return domain.NewRequiredFieldError("documentNumber")
 	}
-	// Validación de DNI peruano: 8 dígitos numéricos
-	if len(n.DocumentNumber) != 8 {
-		return errors.New("el DNI debe tener 8 dígitos")
+	// Cada tipo de documento (DNI, CE, PASAPORTE, PTP, CPP) tiene su propio formato
+	if err := n.DocumentType.ValidateNumber(n.DocumentNumber); err != nil {
+		return err
 	}
-	for _, c := range n.DocumentNumber {
-		if c < '0' || c > '9' {
-			return errors.New("el DNI solo puede contener números")
-		}
+	if err := n.validateForeignWorker(); err != nil {
+		return err
 	}
 	if n.FirstName == "" {
-		return errors.New("firstName es obligatorio")
+		return domain.NewRequiredFieldError("firstName")
 	}
 	if len(n.FirstName) > 50 {
-		return errors.New("firstName demasiado largo")
+		return domain.NewMaxLengthFieldError("firstName", 50)
 	}
 	if n.LastNamePaternal == "" {
-		return errors.New("lastNamePaternal es obligatorio")
+		return domain.NewRequiredFieldError("lastNamePaternal")
 	}
 	if len(n.LastNamePaternal) > 50 {
-		return errors.New("lastNamePaternal demasiado largo")
+		return domain.NewMaxLengthFieldError("lastNamePaternal", 50)
 	}
 	if n.LastNameMaternal != "" && len(n.LastNameMaternal) > 50 {
-		return errors.New("lastNameMaternal demasiado largo")
+		return domain.NewMaxLengthFieldError("lastNameMaternal", 50)
 	}
 	if n.Gender != "M" && n.Gender != "F" && n.Gender != "O" {
-		return errors.New("gender debe ser M, F o O")
+		return domain.NewFieldError("gender", "oneof", "validation.oneof", domain.Params{"values": "M, F, O"})
 	}
 	if n.BirthDate.IsZero() {
-		return errors.New("birthDate es obligatorio")
+		return domain.NewRequiredFieldError("birthDate")
 	}
 	// Fecha de nacimiento no puede ser futura ni ridículamente antigua
 	if n.BirthDate.After(time.Now()) {
-		return errors.New("birthDate no puede ser en el futuro")
+		return domain.NewFieldError("birthDate", "max", "validation.date_not_future", nil)
 	}
 	if n.BirthDate.Before(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)) {
-		return errors.New("birthDate no puede ser antes de 1900")
+		return domain.NewFieldError("birthDate", "min", "validation.date_min", domain.Params{"min": 1900})
+	}
+	return nil
+}
+
+// validateForeignWorker - reglas adicionales para trabajadores extranjeros
+func (n *NaturalPerson) validateForeignWorker() error {
+	if n.Nationality != "" && len(n.Nationality) != 3 {
+		return domain.NewFieldError("nationality", "len", "person.nationality_format", nil)
+	}
+	if !n.DocumentType.IsForeign() {
+		return nil
+	}
+	if n.Nationality == "" {
+		return domain.NewFieldError("nationality", "required", "person.foreign_document_required", nil)
+	}
+	if n.WorkPermitExpiry.IsZero() {
+		return domain.NewFieldError("workPermitExpiry", "required", "person.foreign_document_required", nil)
+	}
+	return nil
+}
+
+// EnsureWorkPermitValidOn - verifica que el permiso de trabajo de un extranjero siga vigente en la
+// fecha indicada. Solo se exige al contratar: Validate no lo revisa, así un permiso vencido no
+// impide actualizar otros datos de la persona ni fusionarla
+func (n *NaturalPerson) EnsureWorkPermitValidOn(date time.Time) error {
+	if !n.DocumentType.IsForeign() {
+		return nil
+	}
+	if n.WorkPermitExpiry.Before(date) {
+		return domain.NewFieldError("workPermitExpiry", "future", "person.work_permit_expired", nil)
 	}
 	return nil
 }
+
+// IsForeign indica si la persona se identifica con un documento de extranjería
+func (n *NaturalPerson) IsForeign() bool {
+	return n.DocumentType.IsForeign()
+}
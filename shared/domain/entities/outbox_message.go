@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// OutboxStatus - estado de publicación de un mensaje del outbox
+type OutboxStatus string
+
+const (
+	OutboxPending   OutboxStatus = "PENDING"   // Esperando ser publicado (o reintentado)
+	OutboxPublished OutboxStatus = "PUBLISHED" // El publicador lo aceptó
+	OutboxDead      OutboxStatus = "DEAD"      // Agotó los reintentos; requiere revisión manual
+)
+
+// maxOutboxErrorLength limita el error guardado, que puede incluir la respuesta del destino
+const maxOutboxErrorLength = 1000
+
+// OutboxMessage - evento de dominio guardado en la misma transacción que lo originó, a la
+// espera de que el relay lo publique a los sistemas externos. Su ID viaja con el mensaje para
+// que el consumidor descarte los duplicados: la entrega es "al menos una vez".
+type OutboxMessage struct {
+	ID            string
+	EventName     string
+	Payload       []byte // Evento serializado en JSON
+	OccurredAt    time.Time
+	Status        OutboxStatus
+	Attempts      int // Publicaciones fallidas
+	NextAttemptAt time.Time
+	LastError     string
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+}
+
+// NewOutboxMessage serializa el evento; el mensaje queda pendiente para publicarse de inmediato.
+func NewOutboxMessage(event domain.DomainEvent) (*OutboxMessage, error) {
+	if event == nil || event.EventName() == "" {
+		return nil, errors.New("el evento del outbox debe tener nombre")
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo serializar el evento %s: %w", event.EventName(), err)
+	}
+	now := time.Now()
+	return &OutboxMessage{
+		ID:            uuid.NewString(),
+		EventName:     event.EventName(),
+		Payload:       payload,
+		OccurredAt:    event.OccurredAt(),
+		Status:        OutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// MarkPublished registra que el publicador aceptó el mensaje.
+func (m *OutboxMessage) MarkPublished(at time.Time) {
+	m.Status = OutboxPublished
+	m.PublishedAt = &at
+	m.LastError = ""
+}
+
+// MarkFailed registra un intento fallido y programa el siguiente según la política; al agotar
+// los intentos el mensaje pasa a DEAD. Devuelve true si el mensaje quedó en DEAD.
+func (m *OutboxMessage) MarkFailed(cause error, policy domain.RetryPolicy, at time.Time) bool {
+	m.Attempts++
+	m.LastError = cause.Error()
+	if len(m.LastError) > maxOutboxErrorLength {
+		m.LastError = m.LastError[:maxOutboxErrorLength]
+	}
+	if policy.Exhausted(m.Attempts) {
+		m.Status = OutboxDead
+		return true
+	}
+	m.NextAttemptAt = at.Add(policy.Delay(m.Attempts))
+	return false
+}
@@ -15,6 +15,7 @@ type Person struct {
 	Phone     value_objects.Phone
 	Address   string
 	Country   string
+	Version   int // Control de concurrencia optimista: aumenta en cada actualización
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -31,7 +32,17 @@ func NewPerson(pt value_objects.PersonType, email value_objects.Email, phone val
 		Phone:     phone,
 		Address:   address,
 		Country:   country,
+		Version:   1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 }
+
+// UpdateContactInfo actualiza los datos de contacto básicos de la persona
+func (p *Person) UpdateContactInfo(email value_objects.Email, phone value_objects.Phone, address, country string) {
+	p.Email = email
+	p.Phone = phone
+	p.Address = address
+	p.Country = country
+	p.UpdatedAt = time.Now()
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// PersonMerge - registro de auditoría de la fusión de una persona duplicada en otra
+type PersonMerge struct {
+	ID               string
+	SurvivorPersonID string
+	MergedPersonID   string
+	Reason           string
+	EmployeesMoved   int
+	MergedSnapshot   []byte // Datos de la persona eliminada (JSON) al momento de la fusión
+	MergedAt         time.Time
+}
+
+// Constructor con validación interna
+func NewPersonMerge(survivorPersonID, mergedPersonID, reason string, employeesMoved int, mergedSnapshot []byte) (*PersonMerge, error) {
+	u7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PersonMerge{
+		ID:               u7.String(),
+		SurvivorPersonID: survivorPersonID,
+		MergedPersonID:   mergedPersonID,
+		Reason:           strings.TrimSpace(reason),
+		EmployeesMoved:   employeesMoved,
+		MergedSnapshot:   mergedSnapshot,
+		MergedAt:         time.Now(),
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate - valida campos requeridos y reglas de negocio
+func (m *PersonMerge) Validate() error {
+	if m.SurvivorPersonID == "" || m.MergedPersonID == "" {
+		return domain.NewFieldError("duplicatePersonId", "required", "person.merge_required", nil)
+	}
+	if m.SurvivorPersonID == m.MergedPersonID {
+		return domain.NewFieldError("duplicatePersonId", "different", "person.merge_self", nil)
+	}
+	if len(m.Reason) > 255 {
+		return domain.NewMaxLengthFieldError("reason", 255)
+	}
+	return nil
+}
@@ -5,18 +5,29 @@ import (
 	"net/http"
 )
 
+// Params holds the values interpolated into a localized message (e.g. {"max": 50}).
+type Params map[string]any
+
 // DomainError represents a standard error structure for the business domain.
 // It contains enough information for layers above to handle it appropriately.
+// The user-facing text is not built here: MessageKey and Params are rendered by the
+// HTTP layer in the language requested by the client.
 type DomainError struct {
 	HTTPStatusCode int    // The HTTP status code that corresponds to this error.
 	Code           string // An internal, stable error code (e.g., "ALREADY_EXISTS").
-	Message        string // A user-friendly message.
-	cause          error  // The original underlying error, for internal logging.
+	MessageKey     string // Key of the message in the i18n catalog (e.g., "person.not_found").
+	Params         Params // Values interpolated into the message.
+	// Fields lists the input fields that caused the error, so clients can highlight them.
+	Fields []FieldError
+	cause  error // The original underlying error, for internal logging.
 }
 
 // Error makes DomainError satisfy the standard error interface.
 func (e *DomainError) Error() string {
-	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	if len(e.Params) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.MessageKey)
+	}
+	return fmt.Sprintf("[%s] %s %v", e.Code, e.MessageKey, map[string]any(e.Params))
 }
 
 // Unwrap provides compatibility with errors.Is and errors.As.
@@ -24,34 +35,166 @@ func (e *DomainError) Unwrap() error {
 	return e.cause
 }
 
+// WithParams sets the values interpolated into the message.
+func (e *DomainError) WithParams(params Params) *DomainError {
+	e.Params = params
+	return e
+}
+
+// WithFieldErrors attaches the rejected input fields to the error.
+func (e *DomainError) WithFieldErrors(fields ...FieldError) *DomainError {
+	e.Fields = append(e.Fields, fields...)
+	return e
+}
+
+// FieldError describes why a single input field was rejected. Entities return it from Validate
+// so that the HTTP layer can point at the field instead of returning a single message.
+type FieldError struct {
+	Field      string // JSON name of the field as sent by the client (e.g. "salary", "contacts.value")
+	Rule       string // Rule that failed (e.g. "required", "max", "format")
+	MessageKey string // Key of the message in the i18n catalog (e.g. "validation.required")
+	Params     Params // Values interpolated into the message; "field" is always available.
+}
+
+// NewFieldError creates a new error for a rejected input field.
+func NewFieldError(field, rule, messageKey string, params Params) *FieldError {
+	return &FieldError{Field: field, Rule: rule, MessageKey: messageKey, Params: params}
+}
+
+// NewRequiredFieldError creates the error for a missing mandatory field.
+func NewRequiredFieldError(field string) *FieldError {
+	return NewFieldError(field, "required", "validation.required", nil)
+}
+
+// NewMaxLengthFieldError creates the error for a text field longer than allowed.
+func NewMaxLengthFieldError(field string, maxLength int) *FieldError {
+	return NewFieldError(field, "max", "validation.max_length", Params{"max": maxLength})
+}
+
+// Error makes FieldError satisfy the standard error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.MessageKey)
+}
+
 // --- Error Constructors ---
 
 // NewAlreadyExistsError creates a new domain error for a resource that already exists.
-func NewAlreadyExistsError(message string, cause error) *DomainError {
+func NewAlreadyExistsError(messageKey string, cause error) *DomainError {
 	return &DomainError{
 		HTTPStatusCode: http.StatusConflict, // 409
 		Code:           "ALREADY_EXISTS",
-		Message:        message,
+		MessageKey:     messageKey,
 		cause:          cause,
 	}
 }
 
 // NewInvalidInputError creates a new domain error for invalid user input.
-func NewInvalidInputError(message string, cause error) *DomainError {
+func NewInvalidInputError(messageKey string, cause error) *DomainError {
 	return &DomainError{
 		HTTPStatusCode: http.StatusBadRequest, // 400
 		Code:           "INVALID_INPUT",
-		Message:        message,
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewBusinessRuleError creates a new domain error for well-formed data that breaks a business rule
+// (e.g. a salary below the minimum wage), as opposed to invalid input.
+func NewBusinessRuleError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusUnprocessableEntity, // 422
+		Code:           "BUSINESS_RULE_VIOLATION",
+		MessageKey:     messageKey,
 		cause:          cause,
 	}
 }
 
 // NewNotFoundError creates a new domain error for a resource that cannot be found.
-func NewNotFoundError(message string, cause error) *DomainError {
+func NewNotFoundError(messageKey string, cause error) *DomainError {
 	return &DomainError{
 		HTTPStatusCode: http.StatusNotFound, // 404
 		Code:           "NOT_FOUND",
-		Message:        message,
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewUnauthorizedError creates a new domain error for a request without a valid identity.
+func NewUnauthorizedError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusUnauthorized, // 401
+		Code:           "UNAUTHORIZED",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewForbiddenError creates a new domain error for an identity that lacks the required role.
+func NewForbiddenError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusForbidden, // 403
+		Code:           "FORBIDDEN",
+		MessageKey:     messageKey,
 		cause:          cause,
 	}
-}
\ No newline at end of file
+}
+
+// NewIdempotencyKeyReusedError creates a new domain error for an Idempotency-Key sent again with a different payload.
+func NewIdempotencyKeyReusedError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusUnprocessableEntity, // 422
+		Code:           "IDEMPOTENCY_KEY_REUSED",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewIdempotencyKeyInProgressError creates a new domain error for a retry whose original request has not finished.
+func NewIdempotencyKeyInProgressError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusConflict, // 409
+		Code:           "IDEMPOTENCY_KEY_IN_PROGRESS",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewVersionConflictError creates a new domain error for an update based on an outdated version (If-Match).
+func NewVersionConflictError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusPreconditionFailed, // 412
+		Code:           "VERSION_CONFLICT",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewPreconditionRequiredError creates a new domain error for an update sent without If-Match.
+func NewPreconditionRequiredError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusPreconditionRequired, // 428
+		Code:           "PRECONDITION_REQUIRED",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewPayloadTooLargeError creates a new domain error for an upload bigger than allowed.
+func NewPayloadTooLargeError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusRequestEntityTooLarge, // 413
+		Code:           "PAYLOAD_TOO_LARGE",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
+
+// NewUnsupportedMediaTypeError creates a new domain error for an upload whose content type is not accepted.
+func NewUnsupportedMediaTypeError(messageKey string, cause error) *DomainError {
+	return &DomainError{
+		HTTPStatusCode: http.StatusUnsupportedMediaType, // 415
+		Code:           "UNSUPPORTED_MEDIA_TYPE",
+		MessageKey:     messageKey,
+		cause:          cause,
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// DomainEvent - hecho del negocio que ya ocurrió (un empleado registrado, un cambio de sueldo).
+// Los agregados lo registran y la aplicación lo publica recién cuando la transacción se confirma.
+// Solo lleva identificadores y los datos del cambio, nunca datos personales.
+type DomainEvent interface {
+	// EventName identifica el tipo de evento, p. ej. "employee.registered"
+	EventName() string
+	OccurredAt() time.Time
+}
+
+// EventRecorder acumula los eventos de un agregado hasta que la aplicación los retira.
+// El valor cero está listo para usarse.
+type EventRecorder struct {
+	events []DomainEvent
+}
+
+// Record agrega un evento al final de los pendientes.
+func (r *EventRecorder) Record(event DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// PullEvents devuelve los eventos pendientes, en el orden en que ocurrieron, y los descarta.
+func (r *EventRecorder) PullEvents() []DomainEvent {
+	events := r.events
+	r.events = nil
+	return events
+}
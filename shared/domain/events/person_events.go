@@ -0,0 +1,22 @@
+package events
+
+import (
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// PersonRegistered - se registró una persona nueva (natural o jurídica)
+type PersonRegistered struct {
+	PersonID   string                   `json:"personId"`
+	PersonType value_objects.PersonType `json:"personType"`
+	At         time.Time                `json:"occurredAt"`
+}
+
+func (e PersonRegistered) EventName() string {
+	return "person.registered"
+}
+
+func (e PersonRegistered) OccurredAt() time.Time {
+	return e.At
+}
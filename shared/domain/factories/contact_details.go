@@ -0,0 +1,50 @@
+// domain/factories/contact_details.go
+package factories
+
+import (
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+// ContactParams - datos de un medio de contacto tipado
+type ContactParams struct {
+	Type         string
+	Value        string
+	Name         string
+	Relationship string
+	IsPrimary    bool
+}
+
+// AddressParams - datos del domicilio estructurado
+type AddressParams struct {
+	Street    string
+	Reference string
+	Ubigeo    string
+}
+
+// AttachContactDetails construye los contactos y el domicilio y los asigna al agregado.
+// Se usa tanto al crear una persona como al actualizarla.
+func AttachContactDetails(agg *aggregates.PersonAggregate, contacts []ContactParams, address *AddressParams) error {
+	personID := agg.Person.ID
+
+	built := make([]*entities.Contact, 0, len(contacts))
+	for _, c := range contacts {
+		contact, err := entities.NewContact(personID, c.Type, c.Value, c.Name, c.Relationship, c.IsPrimary)
+		if err != nil {
+			return err
+		}
+		built = append(built, contact)
+	}
+	if err := agg.ReplaceContacts(built); err != nil {
+		return err
+	}
+
+	if address == nil {
+		return agg.SetAddress(nil)
+	}
+	addr, err := entities.NewAddress(personID, address.Street, address.Reference, address.Ubigeo)
+	if err != nil {
+		return err
+	}
+	return agg.SetAddress(addr)
+}
@@ -28,12 +28,15 @@ func (f *NaturalPersonFactory) Create(params PersonFactoryParams) (*aggregates.P
 	// Crear entidad Natural
 	natural, err := entities.NewNaturalPerson(
 		person.ID,
+		params.DocumentType,
 		params.DocumentNumber,
 		params.FirstName,
 		params.LastNamePaternal,
 		params.LastNameMaternal,
 		params.Gender,
 		params.BirthDate,
+		params.Nationality,
+		params.WorkPermitExpiry,
 	)
 	if err != nil {
 		return nil, err
@@ -2,8 +2,7 @@
 package factories
 
 import (
-	"fmt"
-
+	"github.com/kevinsoras/employee-management/shared/domain"
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
 	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
 )
@@ -28,7 +27,15 @@ func RegisterFactory(personType value_objects.PersonType, factory PersonFactory)
 func CreatePerson(params PersonFactoryParams) (*aggregates.PersonAggregate, error) {
 	factory, exists := factoryRegistry[params.Type]
 	if !exists {
-		return nil, fmt.Errorf("no factory for person type: %s", params.Type)
+		return nil, domain.NewFieldError("type", "oneof", "validation.oneof", domain.Params{"values": "NATURAL, JURIDICAL"})
+	}
+	agg, err := factory.Create(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := AttachContactDetails(agg, params.Contacts, params.StructuredAddress); err != nil {
+		return nil, err
 	}
-	return factory.Create(params)
+	agg.MarkRegistered()
+	return agg, nil
 }
@@ -15,6 +15,7 @@ type PersonFactoryParams struct {
 	Address string
 	Country string
 
+	DocumentType   value_objects.DocumentType
 	DocumentNumber string
 
 	// Campos específicos de Natural
@@ -23,6 +24,8 @@ type PersonFactoryParams struct {
 	LastNameMaternal *string
 	BirthDate        *time.Time
 	Gender           *string
+	Nationality      *string
+	WorkPermitExpiry *time.Time
 
 	// Campos específicos de Juridical
 	BusinessName           *string
@@ -30,4 +33,8 @@ type PersonFactoryParams struct {
 	ConstitutionDate       *time.Time
 	RepresentativeName     *string
 	RepresentativeDocument *string
+
+	// Contactos tipados y domicilio estructurado (opcionales)
+	Contacts          []ContactParams
+	StructuredAddress *AddressParams
 }
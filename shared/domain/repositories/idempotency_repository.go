@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+type IdempotencyRepository interface {
+	// Reserve registra la clave; si ya existe una vigente la devuelve sin modificarla
+	Reserve(ctx context.Context, record *entities.IdempotencyRecord) (*entities.IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, record *entities.IdempotencyRecord) error
+}
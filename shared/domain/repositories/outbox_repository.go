@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+type OutboxRepository interface {
+	// Append guarda los mensajes; debe llamarse dentro de la transacción que originó los eventos
+	Append(ctx context.Context, messages ...*entities.OutboxMessage) error
+	// ClaimPending reserva hasta limit mensajes pendientes cuyo reintento ya venció, en orden de
+	// escritura, moviendo su próximo intento a leaseUntil. La reserva se confirma en el acto: los
+	// demás relays no los ven hasta que venza, y si el relay cae antes de guardar el intento se
+	// vuelven a publicar al vencer.
+	ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.OutboxMessage, error)
+	// SaveAttempt guarda el resultado de la publicación (estado, intentos, error) y libera la reserva
+	SaveAttempt(ctx context.Context, message *entities.OutboxMessage) error
+	// RequeueDead devuelve los mensajes DEAD a pendientes, con los intentos en cero
+	RequeueDead(ctx context.Context, now time.Time) (int, error)
+}
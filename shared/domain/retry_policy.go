@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// RetryPolicy - reintentos con espera exponencial: BaseDelay, 2×BaseDelay, 4×BaseDelay... hasta
+// MaxDelay. Tras MaxAttempts fallos se deja de intentar (el outbox y los webhooks lo usan).
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Delay es la espera antes del reintento que sigue al fallo número attempt (1, 2, ...).
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// Exhausted indica si ya no quedan intentos tras attempts fallos.
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return attempts >= p.MaxAttempts
+}
@@ -0,0 +1,57 @@
+package security
+
+import (
+	"context"
+	"slices"
+)
+
+// Role - rol funcional del usuario autenticado
+type Role string
+
+const (
+	RoleHRAdmin   Role = "HR_ADMIN"   // Administrador de RR.HH.: acceso total
+	RoleHRAnalyst Role = "HR_ANALYST" // Analista de RR.HH.: registra y mantiene personal
+	RoleManager   Role = "MANAGER"    // Jefe de área: consulta a su equipo
+	RoleEmployee  Role = "EMPLOYEE"   // Trabajador: consulta sus propios datos
+)
+
+// Principal - identidad autenticada que ejecuta la operación
+type Principal struct {
+	UserID     string
+	Email      string
+	Roles      []Role
+	EmployeeID string // Empleo del propio usuario, para las consultas de autoservicio; vacío si no es trabajador
+}
+
+// HasAnyRole indica si el usuario tiene al menos uno de los roles indicados
+func (p *Principal) HasAnyRole(roles ...Role) bool {
+	for _, role := range roles {
+		if slices.Contains(p.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmployee indica si el usuario es el trabajador del empleo indicado
+func (p *Principal) IsEmployee(employeeID string) bool {
+	return p.EmployeeID != "" && p.EmployeeID == employeeID
+}
+
+// TokenVerifier valida un token de acceso y devuelve la identidad que contiene
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*Principal, error)
+}
+
+type principalKey struct{}
+
+// WithPrincipal guarda la identidad autenticada en el contexto de la operación
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext recupera la identidad autenticada; false si la operación es anónima
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
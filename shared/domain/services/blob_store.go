@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrBlobNotFound - la clave no existe en el almacenamiento
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore - PUERTO hacia el almacenamiento de archivos (disco local o un servicio compatible con
+// S3). Las claves son rutas relativas separadas por "/"; escribir en una clave existente reemplaza
+// su contenido. Las implementaciones devuelven ErrBlobNotFound si la clave no existe e
+// infrastructure.NewExternalServiceError si el almacenamiento falla.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
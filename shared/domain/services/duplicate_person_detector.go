@@ -0,0 +1,146 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+// DuplicateReason identifica la coincidencia que hace sospechar de un duplicado
+type DuplicateReason string
+
+const (
+	ReasonSimilarName     DuplicateReason = "SIMILAR_NAME"     // Nombre completo o razón social casi idénticos
+	ReasonSameBirthDate   DuplicateReason = "SAME_BIRTH_DATE"  // Misma fecha de nacimiento
+	ReasonSamePhone       DuplicateReason = "SAME_PHONE"       // Mismo teléfono
+	ReasonSimilarDocument DuplicateReason = "SIMILAR_DOCUMENT" // Documento que difiere en un solo dígito (error de tipeo)
+)
+
+// Pesos de cada coincidencia; un candidato es duplicado si la suma alcanza duplicateThreshold
+const (
+	nameWeight          = 0.4
+	birthDateWeight     = 0.3
+	phoneWeight         = 0.3
+	documentWeight      = 0.3
+	duplicateThreshold  = 0.6
+	nameSimilarityLimit = 0.85
+)
+
+// DuplicateMatch - resultado de comparar una persona con un candidato
+type DuplicateMatch struct {
+	PersonID string
+	Score    float64
+	Reasons  []DuplicateReason
+}
+
+// DuplicatePersonDetector compara personas para encontrar posibles duplicados.
+// La unicidad de email y documento solo se garantiza en la base de datos, por lo que
+// pueden existir personas con el mismo nombre y otro email, o con un DNI mal digitado.
+type DuplicatePersonDetector struct{}
+
+func NewDuplicatePersonDetector() *DuplicatePersonDetector {
+	return &DuplicatePersonDetector{}
+}
+
+// Match evalúa al candidato contra la persona objetivo. Devuelve false si no alcanza el umbral.
+func (d *DuplicatePersonDetector) Match(target, candidate *aggregates.PersonAggregate) (DuplicateMatch, bool) {
+	match := DuplicateMatch{PersonID: candidate.Person.ID}
+	if target.Person.ID == candidate.Person.ID || target.Person.Type != candidate.Person.Type {
+		return match, false
+	}
+
+	if NameSimilarity(fullName(target), fullName(candidate)) >= nameSimilarityLimit {
+		match.add(ReasonSimilarName, nameWeight)
+	}
+	if target.NaturalPerson != nil && candidate.NaturalPerson != nil &&
+		!target.NaturalPerson.BirthDate.IsZero() &&
+		target.NaturalPerson.BirthDate.Equal(candidate.NaturalPerson.BirthDate) {
+		match.add(ReasonSameBirthDate, birthDateWeight)
+	}
+	if target.Person.Phone != "" && target.Person.Phone == candidate.Person.Phone {
+		match.add(ReasonSamePhone, phoneWeight)
+	}
+	targetDocument, candidateDocument := documentNumber(target), documentNumber(candidate)
+	if targetDocument != candidateDocument && len(targetDocument) == len(candidateDocument) &&
+		levenshtein(targetDocument, candidateDocument) == 1 {
+		match.add(ReasonSimilarDocument, documentWeight)
+	}
+
+	return match, match.Score >= duplicateThreshold
+}
+
+func (m *DuplicateMatch) add(reason DuplicateReason, weight float64) {
+	m.Reasons = append(m.Reasons, reason)
+	m.Score += weight
+}
+
+// NameSimilarity devuelve la similitud entre dos nombres en el rango [0, 1],
+// ignorando mayúsculas, tildes y espacios repetidos.
+func NameSimilarity(a, b string) float64 {
+	a, b = normalizeName(a), normalizeName(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	longest := max(len([]rune(a)), len([]rune(b)))
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+func fullName(agg *aggregates.PersonAggregate) string {
+	switch agg.Person.Type {
+	case value_objects.Natural:
+		if np := agg.NaturalPerson; np != nil {
+			return strings.Join([]string{np.FirstName, np.LastNamePaternal, np.LastNameMaternal}, " ")
+		}
+	case value_objects.Juridical:
+		if jp := agg.JuridicalPerson; jp != nil {
+			return jp.BusinessName
+		}
+	}
+	return ""
+}
+
+func documentNumber(agg *aggregates.PersonAggregate) string {
+	if agg.NaturalPerson != nil {
+		return agg.NaturalPerson.DocumentNumber
+	}
+	if agg.JuridicalPerson != nil {
+		return agg.JuridicalPerson.DocumentNumber
+	}
+	return ""
+}
+
+// accentReplacer quita las tildes y diéresis del español
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u",
+)
+
+func normalizeName(name string) string {
+	name = accentReplacer.Replace(strings.ToLower(name))
+	return strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}), " ")
+}
+
+// levenshtein calcula la distancia de edición entre dos cadenas
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,59 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+func naturalPerson(t *testing.T, email, phone, dni, firstName, lastPat, lastMat string, birthDate time.Time) *aggregates.PersonAggregate {
+	t.Helper()
+	person := entities.NewPerson(value_objects.Natural, value_objects.Email(email), value_objects.Phone(phone), "Av. Siempre Viva 123", "Peru")
+	gender := "F"
+	natural, err := entities.NewNaturalPerson(person.ID, value_objects.DNI, dni, &firstName, &lastPat, &lastMat, &gender, &birthDate, nil, nil)
+	assert.NoError(t, err)
+	return aggregates.NewPersonAggregate(person, natural, nil)
+}
+
+func TestNameSimilarity_IgnoresCaseAndAccents(t *testing.T) {
+	assert.Equal(t, 1.0, services.NameSimilarity("María  Pérez", "MARIA PEREZ"))
+	assert.Greater(t, services.NameSimilarity("Maria Perez Quispe", "Mario Perez Quispe"), 0.9)
+	assert.Less(t, services.NameSimilarity("Maria Perez", "Jorge Huaman"), 0.5)
+}
+
+func TestDuplicatePersonDetector_Match(t *testing.T) {
+	detector := services.NewDuplicatePersonDetector()
+	birthDate := time.Date(1992, 3, 14, 0, 0, 0, 0, time.UTC)
+	target := naturalPerson(t, "maria@example.com", "987654321", "45678912", "María", "Pérez", "Quispe", birthDate)
+
+	t.Run("same name with another email and birth date", func(t *testing.T) {
+		candidate := naturalPerson(t, "mperez@empresa.com", "912345678", "11223344", "Maria", "Perez", "Quispe", birthDate)
+		match, isDuplicate := detector.Match(target, candidate)
+		assert.True(t, isDuplicate)
+		assert.ElementsMatch(t, []services.DuplicateReason{services.ReasonSimilarName, services.ReasonSameBirthDate}, match.Reasons)
+	})
+
+	t.Run("typo in the DNI", func(t *testing.T) {
+		candidate := naturalPerson(t, "maria.p@example.com", "912345678", "45678913", "Maria", "Perez", "Quispe", time.Date(1992, 3, 15, 0, 0, 0, 0, time.UTC))
+		match, isDuplicate := detector.Match(target, candidate)
+		assert.True(t, isDuplicate)
+		assert.Contains(t, match.Reasons, services.ReasonSimilarDocument)
+	})
+
+	t.Run("only the phone matches", func(t *testing.T) {
+		candidate := naturalPerson(t, "jorge@example.com", "987654321", "87654321", "Jorge", "Huamán", "Rojas", time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC))
+		_, isDuplicate := detector.Match(target, candidate)
+		assert.False(t, isDuplicate)
+	})
+
+	t.Run("a person is not a duplicate of itself", func(t *testing.T) {
+		_, isDuplicate := detector.Match(target, target)
+		assert.False(t, isDuplicate)
+	})
+}
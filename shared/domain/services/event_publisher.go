@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+// EventPublisher - PUERTO hacia el transporte de los eventos de integración (webhook, NATS,
+// salida estándar). Publish devuelve nil solo cuando el destino aceptó el mensaje; cualquier
+// error hace que el relay lo reintente más tarde.
+type EventPublisher interface {
+	Publish(ctx context.Context, message *entities.OutboxMessage) error
+}
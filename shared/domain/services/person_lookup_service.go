@@ -0,0 +1,34 @@
+package services
+
+import "context"
+
+// LookupDocumentType identifica el padrón público a consultar
+type LookupDocumentType string
+
+const (
+	LookupDNI LookupDocumentType = "DNI" // RENIEC
+	LookupRUC LookupDocumentType = "RUC" // SUNAT
+)
+
+// PersonLookupResult - datos públicos de una persona según RENIEC o SUNAT
+type PersonLookupResult struct {
+	DocumentType   LookupDocumentType
+	DocumentNumber string
+	// DNI (RENIEC)
+	FirstName        string
+	LastNamePaternal string
+	LastNameMaternal string
+	// RUC (SUNAT)
+	BusinessName string
+	TradeName    string
+	Address      string
+	Status       string // ACTIVO, BAJA DE OFICIO, etc.
+	Condition    string // HABIDO, NO HABIDO, etc.
+}
+
+// PersonLookupService - PUERTO hacia los padrones públicos para prellenar datos de personas.
+// Las implementaciones deben devolver domain.NewNotFoundError si el documento no existe
+// e infrastructure.NewExternalServiceError si el proveedor falla.
+type PersonLookupService interface {
+	Lookup(ctx context.Context, documentType LookupDocumentType, number string) (*PersonLookupResult, error)
+}
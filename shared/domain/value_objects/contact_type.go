@@ -0,0 +1,42 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+type ContactType string
+
+// Tipos de contacto admitidos para una persona
+const (
+	PersonalEmail    ContactType = "PERSONAL_EMAIL"
+	WorkEmail        ContactType = "WORK_EMAIL"
+	Mobile           ContactType = "MOBILE"
+	EmergencyContact ContactType = "EMERGENCY"
+)
+
+var validContactTypes = map[ContactType]struct{}{
+	PersonalEmail:    {},
+	WorkEmail:        {},
+	Mobile:           {},
+	EmergencyContact: {},
+}
+
+func NewContactType(input string) (ContactType, error) {
+	if input == "" {
+		return "", domain.NewRequiredFieldError("type")
+	}
+
+	contactType := ContactType(strings.TrimSpace(strings.ToUpper(input)))
+	if _, isValid := validContactTypes[contactType]; !isValid {
+		return "", domain.NewFieldError("type", "oneof", "contact.type_invalid", domain.Params{"value": input})
+	}
+
+	return contactType, nil
+}
+
+// IsEmail indica si el valor del contacto debe ser un correo electrónico
+func (c ContactType) IsEmail() bool {
+	return c == PersonalEmail || c == WorkEmail
+}
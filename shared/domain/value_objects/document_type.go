@@ -0,0 +1,58 @@
+package value_objects
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+type DocumentType string
+
+// Tipos de documento de identidad admitidos para personas naturales
+const (
+	DNI       DocumentType = "DNI"       // Documento Nacional de Identidad
+	CE        DocumentType = "CE"        // Carné de Extranjería
+	Pasaporte DocumentType = "PASAPORTE" // Pasaporte
+	PTP       DocumentType = "PTP"       // Permiso Temporal de Permanencia
+	CPP       DocumentType = "CPP"       // Carné de Permiso Temporal de Permanencia
+)
+
+// documentFormats define UNA SOLA VEZ el formato válido de cada tipo de documento
+var documentFormats = map[DocumentType]*regexp.Regexp{
+	DNI:       regexp.MustCompile(`^\d{8}$`),
+	CE:        regexp.MustCompile(`^[A-Z0-9]{8,12}$`),
+	Pasaporte: regexp.MustCompile(`^[A-Z0-9]{6,12}$`),
+	PTP:       regexp.MustCompile(`^[A-Z0-9]{9,15}$`),
+	CPP:       regexp.MustCompile(`^[A-Z0-9]{9,15}$`),
+}
+
+func NewDocumentType(input string) (DocumentType, error) {
+	if input == "" {
+		return "", domain.NewRequiredFieldError("documentType")
+	}
+
+	documentType := DocumentType(strings.TrimSpace(strings.ToUpper(input)))
+	if _, isValid := documentFormats[documentType]; !isValid {
+		return "", domain.NewFieldError("documentType", "oneof", "validation.oneof", domain.Params{"values": "DNI, CE, PASAPORTE, PTP, CPP"})
+	}
+
+	return documentType, nil
+}
+
+// ValidateNumber verifica que el número cumpla el formato propio del tipo de documento
+func (d DocumentType) ValidateNumber(number string) error {
+	format, ok := documentFormats[d]
+	if !ok {
+		return domain.NewFieldError("documentType", "oneof", "validation.oneof", domain.Params{"values": "DNI, CE, PASAPORTE, PTP, CPP"})
+	}
+	if !format.MatchString(number) {
+		return domain.NewFieldError("documentNumber", "format", "person.document_format", domain.Params{"documentType": d})
+	}
+	return nil
+}
+
+// IsForeign indica si el documento corresponde a un ciudadano extranjero
+func (d DocumentType) IsForeign() bool {
+	return d != DNI
+}
@@ -0,0 +1,62 @@
+package value_objects_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+func TestNewDocumentType_NormalizesInput(t *testing.T) {
+	documentType, err := value_objects.NewDocumentType(" pasaporte ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, value_objects.Pasaporte, documentType)
+}
+
+func TestNewDocumentType_RejectsUnknownType(t *testing.T) {
+	_, err := value_objects.NewDocumentType("LIBRETA")
+
+	var fieldErr *domain.FieldError
+	assert.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "documentType", fieldErr.Field)
+	assert.Equal(t, "oneof", fieldErr.Rule)
+}
+
+func TestDocumentType_ValidateNumber(t *testing.T) {
+	tests := []struct {
+		name         string
+		documentType value_objects.DocumentType
+		number       string
+		wantErr      bool
+	}{
+		{"valid DNI", value_objects.DNI, "12345678", false},
+		{"DNI too short", value_objects.DNI, "1234567", true},
+		{"DNI with letters", value_objects.DNI, "1234567A", true},
+		{"valid CE", value_objects.CE, "001234567", false},
+		{"CE too long", value_objects.CE, "0012345678901", true},
+		{"valid passport", value_objects.Pasaporte, "AB123456", false},
+		{"passport with symbols", value_objects.Pasaporte, "AB-12345", true},
+		{"valid PTP", value_objects.PTP, "000123456", false},
+		{"valid CPP", value_objects.CPP, "CPP123456", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.documentType.ValidateNumber(tt.number)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDocumentType_IsForeign(t *testing.T) {
+	assert.False(t, value_objects.DNI.IsForeign())
+	assert.True(t, value_objects.CE.IsForeign())
+	assert.True(t, value_objects.Pasaporte.IsForeign())
+}
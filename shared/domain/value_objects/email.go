@@ -1,8 +1,9 @@
 package value_objects
 
 import (
-	"fmt"
 	"regexp"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
 )
 
 type Email string
@@ -10,7 +11,7 @@ type Email string
 func NewEmail(e string) (Email, error) {
 	re := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	if !re.MatchString(e) {
-		return "", fmt.Errorf("invalid email: %s", e)
+		return "", domain.NewFieldError("email", "email", "validation.email", nil)
 	}
 	return Email(e), nil
 }
@@ -1,8 +1,9 @@
 package value_objects
 
 import (
-	"fmt"
 	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
 )
 
 type PersonType string
@@ -25,14 +26,14 @@ func init() {
 
 func NewPersonType(input string) (PersonType, error) {
 	if input == "" {
-		return "", fmt.Errorf("person type cannot be empty")
+		return "", domain.NewRequiredFieldError("type")
 	}
 
 	normalizedInput := strings.TrimSpace(strings.ToUpper(input))
 	personType := PersonType(normalizedInput)
 
 	if _, isValid := validPersonTypes[personType]; !isValid {
-		return "", fmt.Errorf("invalid person type: %s", input)
+		return "", domain.NewFieldError("type", "oneof", "validation.oneof", domain.Params{"values": "NATURAL, JURIDICAL"})
 	}
 
 	return personType, nil
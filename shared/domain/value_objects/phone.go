@@ -1,12 +1,12 @@
 package value_objects
 
-import "fmt"
+import "github.com/kevinsoras/employee-management/shared/domain"
 
 type Phone string
 
 func NewPhone(p string) (Phone, error) {
 	if len(p) < 6 || len(p) > 20 {
-		return "", fmt.Errorf("invalid phone number: %s", p)
+		return "", domain.NewFieldError("phone", "phone", "validation.phone", nil)
 	}
 	return Phone(p), nil
 }
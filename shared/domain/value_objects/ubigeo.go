@@ -0,0 +1,90 @@
+package value_objects
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// Ubigeo es el código INEI de ubicación geográfica: DDPPDD (departamento, provincia, distrito)
+type Ubigeo string
+
+var ubigeoFormat = regexp.MustCompile(`^\d{6}$`)
+
+// ubigeoDepartment - departamento INEI y, por cada provincia (en orden de código), el código de su
+// último distrito. Los distritos no siempre son correlativos (en Piura la provincia 2001 salta del
+// 01 al 04), así que se guarda el código más alto y no la cantidad de distritos
+type ubigeoDepartment struct {
+	name      string
+	districts []int
+}
+
+var ubigeoDepartments = map[string]ubigeoDepartment{
+	"01": {"AMAZONAS", []int{21, 6, 12, 3, 23, 12, 7}},
+	"02": {"ÁNCASH", []int{12, 5, 6, 2, 15, 11, 3, 4, 7, 16, 5, 10, 8, 10, 11, 4, 10, 9, 10, 8}},
+	"03": {"APURÍMAC", []int{9, 20, 7, 17, 6, 12, 14}},
+	"04": {"AREQUIPA", []int{29, 8, 13, 14, 20, 8, 6, 11}},
+	"05": {"AYACUCHO", []int{16, 6, 4, 13, 16, 21, 8, 10, 11, 12, 8}},
+	"06": {"CAJAMARCA", []int{12, 4, 12, 19, 8, 15, 3, 12, 7, 7, 13, 4, 11}},
+	"07": {"CALLAO", []int{7}},
+	"08": {"CUSCO", []int{8, 7, 9, 8, 8, 8, 8, 8, 17, 9, 6, 12, 7}},
+	"09": {"HUANCAVELICA", []int{19, 8, 12, 13, 11, 16, 23}},
+	"10": {"HUÁNUCO", []int{13, 8, 9, 4, 11, 10, 5, 4, 5, 7, 8}},
+	"11": {"ICA", []int{14, 11, 5, 5, 8}},
+	"12": {"JUNÍN", []int{28, 15, 6, 34, 4, 9, 9, 10, 9}},
+	"13": {"LA LIBERTAD", []int{11, 8, 6, 3, 4, 10, 5, 13, 8, 8, 4, 3}},
+	"14": {"LAMBAYEQUE", []int{20, 6, 12}},
+	"15": {"LIMA", []int{43, 5, 5, 7, 16, 12, 32, 12, 6, 33}},
+	"16": {"LORETO", []int{14, 11, 5, 4, 11, 6, 6, 4}},
+	"17": {"MADRE DE DIOS", []int{4, 4, 3}},
+	"18": {"MOQUEGUA", []int{7, 11, 3}},
+	"19": {"PASCO", []int{13, 8, 9}},
+	"20": {"PIURA", []int{15, 10, 8, 10, 7, 8, 6, 6}},
+	"21": {"PUNO", []int{15, 15, 10, 7, 5, 8, 10, 9, 4, 5, 5, 10, 7}},
+	"22": {"SAN MARTÍN", []int{6, 6, 5, 6, 11, 5, 10, 9, 14, 5}},
+	"23": {"TACNA", []int{11, 6, 3, 8}},
+	"24": {"TUMBES", []int{6, 3, 4}},
+	"25": {"UCAYALI", []int{7, 4, 5, 1}},
+}
+
+func NewUbigeo(code string) (Ubigeo, error) {
+	if !ubigeoFormat.MatchString(code) {
+		return "", domain.NewFieldError("ubigeo", "ubigeo", "address.ubigeo_invalid", domain.Params{"value": code})
+	}
+
+	department, ok := ubigeoDepartments[code[:2]]
+	if !ok {
+		return "", domain.NewFieldError("ubigeo", "ubigeo", "address.ubigeo_invalid", domain.Params{"value": code})
+	}
+	province, _ := strconv.Atoi(code[2:4])
+	if province < 1 || province > len(department.districts) {
+		return "", domain.NewFieldError("ubigeo", "ubigeo", "address.ubigeo_invalid", domain.Params{"value": code})
+	}
+	district, _ := strconv.Atoi(code[4:])
+	if district < 1 || district > department.districts[province-1] {
+		return "", domain.NewFieldError("ubigeo", "ubigeo", "address.ubigeo_invalid", domain.Params{"value": code})
+	}
+
+	return Ubigeo(code), nil
+}
+
+// DepartmentCode devuelve los 2 primeros dígitos del ubigeo
+func (u Ubigeo) DepartmentCode() string {
+	return string(u[:2])
+}
+
+// ProvinceCode devuelve el código de provincia (4 primeros dígitos)
+func (u Ubigeo) ProvinceCode() string {
+	return string(u[:4])
+}
+
+// DistrictCode devuelve el código completo del distrito
+func (u Ubigeo) DistrictCode() string {
+	return string(u)
+}
+
+// DepartmentName devuelve el nombre oficial del departamento
+func (u Ubigeo) DepartmentName() string {
+	return ubigeoDepartments[u.DepartmentCode()].name
+}
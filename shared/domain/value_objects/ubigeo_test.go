@@ -0,0 +1,43 @@
+package value_objects_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+)
+
+func TestNewUbigeo(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  string
+		valid bool
+	}{
+		{"first district of Lima", "150101", true},
+		{"last district of Lima", "150143", true},
+		{"district past the last one of its province", "150144", false},
+		{"unknown district", "150199", false},
+		{"district zero", "150100", false},
+		{"non-correlative district code in Piura", "200115", true},
+		{"unknown province", "151101", false},
+		{"unknown department", "269901", false},
+		{"not six digits", "1501", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ubigeo, err := value_objects.NewUbigeo(tt.code)
+
+			if tt.valid {
+				assert.NoError(t, err)
+				assert.Equal(t, value_objects.Ubigeo(tt.code), ubigeo)
+				return
+			}
+			var fieldErr *domain.FieldError
+			assert.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, "address.ubigeo_invalid", fieldErr.MessageKey)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+)
+
+// Supported signing algorithms
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+)
+
+// ErrInvalidToken is returned for any token that cannot be trusted.
+// The wrapped message says why, but it must not be echoed to the client.
+var ErrInvalidToken = errors.New("invalid token")
+
+// JWTConfig holds the keys and expected claims used to validate access tokens.
+type JWTConfig struct {
+	HMACSecret string        // Shared secret for HS256; empty disables HS256
+	JWKSFile   string        // Local JWKS file with the RS256 public keys; empty disables RS256
+	Issuer     string        // Expected "iss"; empty skips the check
+	Audience   string        // Expected "aud"; empty skips the check
+	Leeway     time.Duration // Tolerated clock skew for exp/nbf
+}
+
+// JWTVerifier validates HS256 and RS256 JSON Web Tokens and builds the principal from their claims.
+type JWTVerifier struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+	issuer     string
+	audience   string
+	leeway     time.Duration
+	now        func() time.Time
+}
+
+// NewJWTVerifier creates a verifier, loading the JWKS file when configured.
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	v := &JWTVerifier{
+		hmacSecret: []byte(cfg.HMACSecret),
+		issuer:     cfg.Issuer,
+		audience:   cfg.Audience,
+		leeway:     cfg.Leeway,
+		now:        time.Now,
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := LoadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.rsaKeys = keys
+	}
+	return v, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// jwtClaims are the registered claims plus the custom ones this API relies on.
+type jwtClaims struct {
+	Subject    string   `json:"sub"`
+	Issuer     string   `json:"iss"`
+	Audience   audience `json:"aud"`
+	ExpiresAt  *int64   `json:"exp"`
+	NotBefore  *int64   `json:"nbf"`
+	Email      string   `json:"email"`
+	Roles      []string `json:"roles"`
+	EmployeeID string   `json:"employee_id"` // the user's own employment, for self-service operations
+}
+
+// audience accepts both a single string and an array, as allowed by RFC 7519.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+// Verify checks the signature and the time, issuer and audience claims.
+func (v *JWTVerifier) Verify(_ context.Context, token string) (*security.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: invalid header", ErrInvalidToken)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding", ErrInvalidToken)
+	}
+	if err := v.verifySignature(header, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: invalid claims", ErrInvalidToken)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+
+	roles := make([]security.Role, 0, len(claims.Roles))
+	for _, role := range claims.Roles {
+		roles = append(roles, security.Role(strings.ToUpper(role)))
+	}
+	return &security.Principal{UserID: claims.Subject, Email: claims.Email, Roles: roles, EmployeeID: claims.EmployeeID}, nil
+}
+
+func (v *JWTVerifier) verifySignature(header jwtHeader, signingInput string, signature []byte) error {
+	switch header.Alg {
+	case AlgHS256:
+		if len(v.hmacSecret) == 0 {
+			return fmt.Errorf("%w: HS256 is not enabled", ErrInvalidToken)
+		}
+		mac := hmac.New(sha256.New, v.hmacSecret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	case AlgRS256:
+		key, ok := v.rsaKeys[header.Kid]
+		if !ok {
+			return fmt.Errorf("%w: unknown key id %q", ErrInvalidToken, header.Kid)
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	default:
+		// Rejects "none" and any algorithm not explicitly supported
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, header.Alg)
+	}
+}
+
+func (v *JWTVerifier) validateClaims(claims jwtClaims) error {
+	now := v.now()
+	if claims.Subject == "" {
+		return fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("%w: missing expiration", ErrInvalidToken)
+	}
+	if now.After(time.Unix(*claims.ExpiresAt, 0).Add(v.leeway)) {
+		return fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+	if claims.NotBefore != nil && now.Add(v.leeway).Before(time.Unix(*claims.NotBefore, 0)) {
+		return fmt.Errorf("%w: token not valid yet", ErrInvalidToken)
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+	if v.audience != "" && !slices.Contains(claims.Audience, v.audience) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	return nil
+}
+
+func decodeSegment(segment string, target any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, target)
+}
+
+// SignHS256 issues an HS256 token for the given claims. It is meant for local development
+// and tests; production tokens come from the identity provider.
+func SignHS256(claims map[string]any, secret string) (string, error) {
+	header, err := json.Marshal(jwtHeader{Alg: AlgHS256, Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
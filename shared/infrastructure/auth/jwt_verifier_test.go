@@ -0,0 +1,131 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/auth"
+)
+
+const testSecret = "test-secret"
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub":   "user-1",
+		"email": "hr@example.com",
+		"roles": []string{"hr_analyst"},
+		"iss":   "https://idp.example.com",
+		"aud":   []string{"employee-api"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestJWTVerifier_HS256(t *testing.T) {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{HMACSecret: testSecret, Issuer: "https://idp.example.com", Audience: "employee-api"})
+	require.NoError(t, err)
+
+	t.Run("valid token", func(t *testing.T) {
+		token, err := auth.SignHS256(validClaims(), testSecret)
+		require.NoError(t, err)
+
+		principal, err := verifier.Verify(context.Background(), token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", principal.UserID)
+		assert.True(t, principal.HasAnyRole(security.RoleHRAnalyst))
+	})
+
+	t.Run("employee token", func(t *testing.T) {
+		claims := validClaims()
+		claims["roles"] = []string{"EMPLOYEE"}
+		claims["employee_id"] = "emp-1"
+		token, _ := auth.SignHS256(claims, testSecret)
+
+		principal, err := verifier.Verify(context.Background(), token)
+		require.NoError(t, err)
+		assert.True(t, principal.IsEmployee("emp-1"))
+		assert.False(t, principal.IsEmployee("emp-2"))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		token, _ := auth.SignHS256(claims, testSecret)
+
+		_, err := verifier.Verify(context.Background(), token)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		token, _ := auth.SignHS256(validClaims(), "other-secret")
+
+		_, err := verifier.Verify(context.Background(), token)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		claims := validClaims()
+		claims["aud"] = "another-api"
+		token, _ := auth.SignHS256(claims, testSecret)
+
+		_, err := verifier.Verify(context.Background(), token)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("alg none is rejected", func(t *testing.T) {
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+		payload, _ := json.Marshal(validClaims())
+		token := header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+
+		_, err := verifier.Verify(context.Background(), token)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
+
+func TestJWTVerifier_RS256WithJWKSFile(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": "key-1",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	raw, _ := json.Marshal(jwks)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, raw, 0o600))
+
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{JWKSFile: jwksFile})
+	require.NoError(t, err)
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"key-1","typ":"JWT"}`))
+	payload, _ := json.Marshal(validClaims())
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	principal, err := verifier.Verify(context.Background(), signingInput+"."+base64.RawURLEncoding.EncodeToString(signature))
+	require.NoError(t, err)
+	assert.Equal(t, "hr@example.com", principal.Email)
+
+	// HS256 is disabled when no secret is configured
+	hsToken, _ := auth.SignHS256(validClaims(), testSecret)
+	_, err = verifier.Verify(context.Background(), hsToken)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
@@ -0,0 +1,190 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Purposes bind each ciphertext to its column (used as GCM additional data), so a value
+// copied from one column to another fails to decrypt.
+const (
+	PurposeDocumentNumber = "natural_persons.document_number"
+	PurposeBankAccount    = "employees.bank_account"
+	// Payroll items keep their own copy of the data paid in each run
+	PurposePayrollDocumentNumber = "payroll_items.document_number"
+	PurposePayrollBankAccount    = "payroll_items.bank_account"
+	// PurposePayslipContent seals the issued payslip documents
+	PurposePayslipContent = "payslips.content"
+	// PurposeContractContent seals the generated employment contracts
+	PurposeContractContent = "contract_documents.content"
+	// PurposeEmployeeDocumentContent seals the employee documents kept in the blob store
+	PurposeEmployeeDocumentContent = "employee_documents.content"
+	// PurposeWebhookSecret seals the HMAC secrets of the webhook subscriptions
+	PurposeWebhookSecret = "webhook_subscriptions.secret"
+	// PurposeIdempotencyResponse seals the stored responses, which may contain unmasked data
+	PurposeIdempotencyResponse = "idempotency_keys.response"
+	// PurposePersonMergeSnapshot seals the audit snapshot of a person removed by a merge
+	PurposePersonMergeSnapshot = "person_merges.merged_snapshot"
+)
+
+// ErrDecryption is returned when a ciphertext is corrupt, tampered with or uses an unknown key.
+var ErrDecryption = errors.New("decryption failed")
+
+// envelopeFormat is "v<version>.<wrapped data key>.<sealed value>", both parts in base64url.
+var envelopeFormat = regexp.MustCompile(`^v(\d+)\.([A-Za-z0-9_-]+)\.([A-Za-z0-9_-]+)$`)
+
+// FieldEncrypter implements envelope encryption for sensitive columns: every value is sealed with
+// a random AES-256-GCM data key, and the data key is wrapped with the active key-encryption key.
+// The key version travels in the ciphertext, so old rows keep decrypting after a rotation.
+type FieldEncrypter struct {
+	keyring       *keyring
+	blindIndexKey []byte
+}
+
+// NewFieldEncrypter loads the keys from the configuration. There are no default keys: without
+// encryption keys or a blind index key it fails, so the application refuses to start.
+func NewFieldEncrypter(cfg Config) (*FieldEncrypter, error) {
+	kr, err := loadKeyring(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(cfg.BlindIndexKey) == "" {
+		return nil, errors.New("no blind index key configured")
+	}
+	blindIndexKey, err := base64.StdEncoding.DecodeString(cfg.BlindIndexKey)
+	if err != nil || len(blindIndexKey) < keySize {
+		return nil, fmt.Errorf("blind index key must be at least %d bytes encoded in base64", keySize)
+	}
+	return &FieldEncrypter{keyring: kr, blindIndexKey: blindIndexKey}, nil
+}
+
+// ActiveVersion returns the key version used for new ciphertexts.
+func (e *FieldEncrypter) ActiveVersion() int {
+	return e.keyring.active
+}
+
+// Encrypt seals the value for the given purpose. Empty values are stored as empty.
+func (e *FieldEncrypter) Encrypt(plaintext, purpose string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+
+	kek := e.keyring.keys[e.keyring.active]
+	wrappedKey, err := seal(kek, dataKey, []byte(purpose))
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataKey, []byte(plaintext), []byte(purpose))
+	if err != nil {
+		return "", err
+	}
+
+	return "v" + strconv.Itoa(e.keyring.active) + "." +
+		base64.RawURLEncoding.EncodeToString(wrappedKey) + "." +
+		base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value sealed by Encrypt. Values that are not in the envelope format are
+// legacy plaintext rows written before encryption was enabled and are returned unchanged
+// until the re-encryption command rewrites them.
+func (e *FieldEncrypter) Decrypt(ciphertext, purpose string) (string, error) {
+	version, wrappedKey, sealed, ok := parseEnvelope(ciphertext)
+	if !ok {
+		return ciphertext, nil
+	}
+	kek, known := e.keyring.keys[version]
+	if !known {
+		return "", fmt.Errorf("%w: unknown key version %d", ErrDecryption, version)
+	}
+	dataKey, err := open(kek, wrappedKey, []byte(purpose))
+	if err != nil {
+		return "", fmt.Errorf("%w: cannot unwrap data key", ErrDecryption)
+	}
+	plaintext, err := open(dataKey, sealed, []byte(purpose))
+	if err != nil {
+		return "", fmt.Errorf("%w: cannot open value", ErrDecryption)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReencryption reports whether the stored value is plaintext or uses a non-active key.
+func (e *FieldEncrypter) NeedsReencryption(stored string) bool {
+	if stored == "" {
+		return false
+	}
+	version, _, _, ok := parseEnvelope(stored)
+	return !ok || version != e.keyring.active
+}
+
+// BlindIndex returns a deterministic HMAC-SHA256 of the normalized value. It lets the database
+// enforce uniqueness and search by exact value without storing it in clear.
+func (e *FieldEncrypter) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, e.blindIndexKey)
+	mac.Write([]byte(strings.ToUpper(strings.TrimSpace(value))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseEnvelope(value string) (version int, wrappedKey, sealed []byte, ok bool) {
+	match := envelopeFormat.FindStringSubmatch(value)
+	if match == nil {
+		return 0, nil, nil, false
+	}
+	version, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, nil, nil, false
+	}
+	if wrappedKey, err = base64.RawURLEncoding.DecodeString(match[2]); err != nil {
+		return 0, nil, nil, false
+	}
+	if sealed, err = base64.RawURLEncoding.DecodeString(match[3]); err != nil {
+		return 0, nil, nil, false
+	}
+	return version, wrappedKey, sealed, true
+}
+
+// seal encrypts with AES-GCM and prepends the random nonce.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrDecryption
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,111 @@
+package crypto_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32))
+}
+
+func newEncrypter(t *testing.T, keys string, active int) *crypto.FieldEncrypter {
+	t.Helper()
+	encrypter, err := crypto.NewFieldEncrypter(crypto.Config{Keys: keys, ActiveVersion: active, BlindIndexKey: testKey(9)})
+	require.NoError(t, err)
+	return encrypter
+}
+
+func TestFieldEncrypter_RoundTrip(t *testing.T) {
+	encrypter := newEncrypter(t, "1:"+testKey(1), 0)
+
+	ciphertext, err := encrypter.Encrypt("19112345678901", crypto.PurposeBankAccount)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "v1."))
+	assert.NotContains(t, ciphertext, "19112345678901")
+
+	plaintext, err := encrypter.Decrypt(ciphertext, crypto.PurposeBankAccount)
+	require.NoError(t, err)
+	assert.Equal(t, "19112345678901", plaintext)
+
+	again, err := encrypter.Encrypt("19112345678901", crypto.PurposeBankAccount)
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, again, "each value uses a fresh data key and nonce")
+}
+
+func TestFieldEncrypter_PurposeIsAuthenticated(t *testing.T) {
+	encrypter := newEncrypter(t, "1:"+testKey(1), 0)
+
+	ciphertext, err := encrypter.Encrypt("45678912", crypto.PurposeDocumentNumber)
+	require.NoError(t, err)
+
+	_, err = encrypter.Decrypt(ciphertext, crypto.PurposeBankAccount)
+	assert.ErrorIs(t, err, crypto.ErrDecryption)
+}
+
+func TestFieldEncrypter_KeyRotation(t *testing.T) {
+	oldEncrypter := newEncrypter(t, "1:"+testKey(1), 0)
+	oldCiphertext, err := oldEncrypter.Encrypt("45678912", crypto.PurposeDocumentNumber)
+	require.NoError(t, err)
+
+	rotated := newEncrypter(t, "1:"+testKey(1)+",2:"+testKey(2), 0)
+	assert.Equal(t, 2, rotated.ActiveVersion())
+	assert.True(t, rotated.NeedsReencryption(oldCiphertext))
+
+	plaintext, err := rotated.Decrypt(oldCiphertext, crypto.PurposeDocumentNumber)
+	require.NoError(t, err)
+	assert.Equal(t, "45678912", plaintext)
+
+	newCiphertext, err := rotated.Encrypt(plaintext, crypto.PurposeDocumentNumber)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(newCiphertext, "v2."))
+	assert.False(t, rotated.NeedsReencryption(newCiphertext))
+
+	// Once version 1 is retired its ciphertexts can no longer be opened
+	retired := newEncrypter(t, "2:"+testKey(2), 0)
+	_, err = retired.Decrypt(oldCiphertext, crypto.PurposeDocumentNumber)
+	assert.ErrorIs(t, err, crypto.ErrDecryption)
+}
+
+func TestFieldEncrypter_LegacyPlaintext(t *testing.T) {
+	encrypter := newEncrypter(t, "1:"+testKey(1), 0)
+
+	plaintext, err := encrypter.Decrypt("45678912", crypto.PurposeDocumentNumber)
+	require.NoError(t, err)
+	assert.Equal(t, "45678912", plaintext)
+	assert.True(t, encrypter.NeedsReencryption("45678912"))
+	assert.False(t, encrypter.NeedsReencryption(""))
+}
+
+func TestFieldEncrypter_BlindIndex(t *testing.T) {
+	encrypter := newEncrypter(t, "1:"+testKey(1), 0)
+
+	index := encrypter.BlindIndex("abc12345")
+	assert.Len(t, index, 64)
+	assert.Equal(t, index, encrypter.BlindIndex(" ABC12345 "))
+	assert.NotEqual(t, index, encrypter.BlindIndex("ABC12346"))
+}
+
+func TestNewFieldEncrypter_InvalidConfig(t *testing.T) {
+	_, err := crypto.NewFieldEncrypter(crypto.Config{})
+	assert.EqualError(t, err, "no encryption keys configured", "there are no default keys")
+
+	_, err = crypto.NewFieldEncrypter(crypto.Config{BlindIndexKey: testKey(9)})
+	assert.Error(t, err, "keys are required")
+
+	_, err = crypto.NewFieldEncrypter(crypto.Config{Keys: "1:c2hvcnQ=", BlindIndexKey: testKey(9)})
+	assert.Error(t, err, "keys must be 32 bytes")
+
+	_, err = crypto.NewFieldEncrypter(crypto.Config{Keys: "1:" + testKey(1), ActiveVersion: 3, BlindIndexKey: testKey(9)})
+	assert.Error(t, err, "active version must exist")
+
+	_, err = crypto.NewFieldEncrypter(crypto.Config{Keys: "1:" + testKey(1)})
+	assert.EqualError(t, err, "no blind index key configured")
+}
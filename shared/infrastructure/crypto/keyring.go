@@ -0,0 +1,104 @@
+package crypto
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// keySize is the size of the AES-256 key-encryption keys and data keys.
+const keySize = 32
+
+// Config holds the key material used to encrypt sensitive columns.
+// Keys are "version:base64" pairs, separated by commas (env) or one per line (file).
+type Config struct {
+	Keys          string // e.g. "1:<base64>,2:<base64>"
+	KeysFile      string // File with one "version:base64" per line; '#' starts a comment
+	ActiveVersion int    // Version used to encrypt; 0 selects the highest one
+	BlindIndexKey string // Base64 HMAC key for the blind indexes; never rotated
+}
+
+// keyring holds the key-encryption keys indexed by version.
+type keyring struct {
+	keys   map[int][]byte
+	active int
+}
+
+func loadKeyring(cfg Config) (*keyring, error) {
+	entries := splitKeyEntries(cfg.Keys, ",")
+	if cfg.KeysFile != "" {
+		fileEntries, err := readKeysFile(cfg.KeysFile)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no encryption keys configured")
+	}
+
+	kr := &keyring{keys: make(map[int][]byte, len(entries))}
+	for _, entry := range entries {
+		versionPart, keyPart, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid key entry %q: expected version:base64", redactEntry(entry))
+		}
+		version, err := strconv.Atoi(strings.TrimSpace(versionPart))
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid key version %q", versionPart)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(keyPart))
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("key version %d must be %d bytes encoded in base64", version, keySize)
+		}
+		if _, duplicated := kr.keys[version]; duplicated {
+			return nil, fmt.Errorf("key version %d is defined twice", version)
+		}
+		kr.keys[version] = key
+		kr.active = max(kr.active, version)
+	}
+
+	if cfg.ActiveVersion != 0 {
+		if _, ok := kr.keys[cfg.ActiveVersion]; !ok {
+			return nil, fmt.Errorf("active key version %d is not configured", cfg.ActiveVersion)
+		}
+		kr.active = cfg.ActiveVersion
+	}
+	return kr, nil
+}
+
+func splitKeyEntries(raw, separator string) []string {
+	var entries []string
+	for _, entry := range strings.Split(raw, separator) {
+		if entry = strings.TrimSpace(entry); entry != "" && !strings.HasPrefix(entry, "#") {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func readKeysFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading encryption keys file: %w", err)
+	}
+	defer file.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entries = append(entries, splitKeyEntries(scanner.Text(), "\n")...)
+	}
+	return entries, scanner.Err()
+}
+
+// redactEntry avoids echoing key material in configuration errors.
+func redactEntry(entry string) string {
+	if len(entry) <= 4 {
+		return "****"
+	}
+	return entry[:2] + "****"
+}
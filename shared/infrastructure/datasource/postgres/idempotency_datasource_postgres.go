@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/kevinsoras/employee-management/shared/domain/datasource"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+)
+
+// reserveIdempotencyKeyQuery inserta la clave o toma una vencida. Si otra transacción acaba de
+// insertar la misma clave, Postgres espera a que termine: así un reintento concurrente ve la
+// respuesta ya guardada (o reserva la clave si la original hizo rollback).
+const reserveIdempotencyKeyQuery = `INSERT INTO idempotency_keys (
+	scope, idempotency_key, user_id, request_hash, created_at, expires_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (scope, user_id, idempotency_key) DO UPDATE SET
+	request_hash = EXCLUDED.request_hash, response = NULL,
+	created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+RETURNING idempotency_key`
+
+const selectIdempotencyKeyQuery = `SELECT request_hash, COALESCE(response, ''), created_at, expires_at
+FROM idempotency_keys WHERE scope = $1 AND user_id = $2 AND idempotency_key = $3`
+
+const updateIdempotencyResponseQuery = `UPDATE idempotency_keys SET response = $4
+WHERE scope = $1 AND user_id = $2 AND idempotency_key = $3`
+
+type IdempotencyDataSourcePostgres struct {
+	db        *sql.DB
+	encrypter *crypto.FieldEncrypter
+}
+
+func NewIdempotencyDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.IdempotencyDataSource {
+	return &IdempotencyDataSourcePostgres{db: db, encrypter: encrypter}
+}
+
+// Reserve registra la clave. Devuelve (nil, nil) si quedó reservada para esta solicitud,
+// o el registro vigente si la clave ya se había usado.
+func (ds *IdempotencyDataSourcePostgres) Reserve(ctx context.Context, record *entities.IdempotencyRecord) (*entities.IdempotencyRecord, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var reservedKey string
+	err := querier.QueryRowContext(ctx, reserveIdempotencyKeyQuery,
+		record.Scope, record.Key, record.UserID, record.RequestHash, record.CreatedAt, record.ExpiresAt,
+	).Scan(&reservedKey)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, infrastructure.NewDBError("Error al reservar el Idempotency-Key", err)
+	}
+
+	existing := &entities.IdempotencyRecord{Scope: record.Scope, Key: record.Key, UserID: record.UserID}
+	var sealedResponse string
+	err = querier.QueryRowContext(ctx, selectIdempotencyKeyQuery, record.Scope, record.UserID, record.Key).
+		Scan(&existing.RequestHash, &sealedResponse, &existing.CreatedAt, &existing.ExpiresAt)
+	if err != nil {
+		return nil, infrastructure.NewDBError("Error al leer el Idempotency-Key", err)
+	}
+	// La respuesta puede incluir datos sin enmascarar, por eso se guarda cifrada
+	response, err := ds.encrypter.Decrypt(sealedResponse, crypto.PurposeIdempotencyResponse)
+	if err != nil {
+		return nil, infrastructure.NewDBError("Error al descifrar la respuesta guardada", err)
+	}
+	existing.Response = []byte(response)
+	return existing, nil
+}
+
+// SaveResponse guarda la respuesta cifrada de la operación reservada.
+func (ds *IdempotencyDataSourcePostgres) SaveResponse(ctx context.Context, record *entities.IdempotencyRecord) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	sealedResponse, err := ds.encrypter.Encrypt(string(record.Response), crypto.PurposeIdempotencyResponse)
+	if err != nil {
+		return infrastructure.NewDBError("Error al cifrar la respuesta", err)
+	}
+	if _, err := querier.ExecContext(ctx, updateIdempotencyResponseQuery,
+		record.Scope, record.UserID, record.Key, sealedResponse,
+	); err != nil {
+		return infrastructure.NewDBError("Error al guardar la respuesta del Idempotency-Key", err)
+	}
+	return nil
+}
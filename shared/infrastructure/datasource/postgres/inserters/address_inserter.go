@@ -0,0 +1,36 @@
+package inserters
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+)
+
+// upsertAddressQuery crea o reemplaza el domicilio estructurado (una fila por persona)
+const upsertAddressQuery = `INSERT INTO person_addresses (person_id, street, reference, ubigeo, department_code, province_code, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+ON CONFLICT (person_id) DO UPDATE SET
+	street = EXCLUDED.street,
+	reference = EXCLUDED.reference,
+	ubigeo = EXCLUDED.ubigeo,
+	department_code = EXCLUDED.department_code,
+	province_code = EXCLUDED.province_code,
+	updated_at = now();`
+
+type addressInserter struct{}
+
+func NewAddressInserter() PersonInserter {
+	return &addressInserter{}
+}
+
+func (a *addressInserter) Insert(ctx context.Context, querier db.Querier, agg *aggregates.PersonAggregate) error {
+	address := agg.Address
+	if address == nil {
+		return nil
+	}
+	_, err := querier.ExecContext(ctx, upsertAddressQuery,
+		address.PersonID, address.Street, address.Reference, address.Ubigeo, address.Ubigeo.DepartmentCode(), address.Ubigeo.ProvinceCode(),
+	)
+	return err
+}
@@ -0,0 +1,29 @@
+package inserters
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+)
+
+const insertContactQuery = `INSERT INTO person_contacts (contact_id, person_id, contact_type, value, contact_name, relationship, is_primary)
+VALUES ($1, $2, $3, $4, $5, $6, $7);`
+
+type contactInserter struct{}
+
+func NewContactInserter() PersonInserter {
+	return &contactInserter{}
+}
+
+func (c *contactInserter) Insert(ctx context.Context, querier db.Querier, agg *aggregates.PersonAggregate) error {
+	for _, contact := range agg.Contacts {
+		_, err := querier.ExecContext(ctx, insertContactQuery,
+			contact.ID, contact.PersonID, contact.Type, contact.Value, contact.Name, contact.Relationship, contact.IsPrimary,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,23 +2,39 @@ package inserters
 
 import (
 	"context"
+	"time"
+
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
 	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
 )
 
-const insertNaturalPersonQuery = `INSERT INTO natural_persons (person_id, document_number, first_name, last_name_paternal, last_name_maternal, birth_date, gender)
-VALUES ($1, $2, $3, $4, $5, $6, $7);`
+const insertNaturalPersonQuery = `INSERT INTO natural_persons (person_id, document_type, document_number, document_number_hash, first_name, last_name_paternal, last_name_maternal, birth_date, gender, nationality, work_permit_expiry)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`
 
-type naturalPersonInserter struct{}
+// naturalPersonInserter guarda el número de documento cifrado junto con su índice ciego,
+// que es el que garantiza la unicidad y permite buscar por documento.
+type naturalPersonInserter struct {
+	encrypter *crypto.FieldEncrypter
+}
 
-func NewNaturalPersonInserter() PersonInserter {
-	return &naturalPersonInserter{}
+func NewNaturalPersonInserter(encrypter *crypto.FieldEncrypter) PersonInserter {
+	return &naturalPersonInserter{encrypter: encrypter}
 }
 
 func (n *naturalPersonInserter) Insert(ctx context.Context, querier db.Querier, agg *aggregates.PersonAggregate) error {
 	np := agg.NaturalPerson
-	_, err := querier.ExecContext(ctx, insertNaturalPersonQuery, 
-		np.PersonID, np.DocumentNumber, np.FirstName, np.LastNamePaternal, np.LastNameMaternal, np.BirthDate, np.Gender,
+	var workPermitExpiry *time.Time
+	if !np.WorkPermitExpiry.IsZero() {
+		workPermitExpiry = &np.WorkPermitExpiry
+	}
+	encryptedDocument, err := n.encrypter.Encrypt(np.DocumentNumber, crypto.PurposeDocumentNumber)
+	if err != nil {
+		return err
+	}
+	_, err = querier.ExecContext(ctx, insertNaturalPersonQuery,
+		np.PersonID, np.DocumentType, encryptedDocument, n.encrypter.BlindIndex(np.DocumentNumber),
+		np.FirstName, np.LastNamePaternal, np.LastNameMaternal, np.BirthDate, np.Gender, np.Nationality, workPermitExpiry,
 	)
 	return err
 }
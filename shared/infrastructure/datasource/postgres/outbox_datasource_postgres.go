@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/datasource"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+)
+
+const insertOutboxMessageQuery = `INSERT INTO outbox (
+	message_id, event_name, payload, occurred_at, status, attempts, next_attempt_at, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+// claimOutboxMessagesQuery reserva los pendientes vencidos en una sola sentencia: mueve su próximo
+// intento al fin de la reserva y los devuelve. SKIP LOCKED hace que dos relays en paralelo se
+// repartan los mensajes en lugar de esperarse; el bloqueo dura solo lo que dura el UPDATE.
+const claimOutboxMessagesQuery = `WITH claimed AS (
+	UPDATE outbox SET next_attempt_at = $3
+	WHERE message_id IN (
+		SELECT message_id FROM outbox
+		WHERE status = 'PENDING' AND next_attempt_at <= $1
+		ORDER BY sequence LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING message_id, event_name, payload, occurred_at, status, attempts, next_attempt_at,
+		COALESCE(last_error, '') AS last_error, created_at, sequence
+)
+SELECT message_id, event_name, payload, occurred_at, status, attempts, next_attempt_at, last_error, created_at
+FROM claimed ORDER BY sequence`
+
+const updateOutboxAttemptQuery = `UPDATE outbox SET status = $2, attempts = $3, next_attempt_at = $4,
+	last_error = NULLIF($5, ''), published_at = $6
+WHERE message_id = $1`
+
+const requeueDeadOutboxQuery = `UPDATE outbox SET status = 'PENDING', attempts = 0, next_attempt_at = $1
+WHERE status = 'DEAD'`
+
+type OutboxDataSourcePostgres struct {
+	db *sql.DB
+}
+
+func NewOutboxDataSourcePostgres(db *sql.DB) datasource.OutboxDataSource {
+	return &OutboxDataSourcePostgres{db: db}
+}
+
+// Append usa la transacción del contexto: los mensajes se confirman junto con el cambio que los originó.
+func (ds *OutboxDataSourcePostgres) Append(ctx context.Context, messages ...*entities.OutboxMessage) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	for _, m := range messages {
+		_, err := querier.ExecContext(ctx, insertOutboxMessageQuery,
+			m.ID, m.EventName, string(m.Payload), m.OccurredAt, m.Status, m.Attempts, m.NextAttemptAt, m.CreatedAt)
+		if err != nil {
+			return infrastructure.NewDBError("Error al guardar el evento en el outbox", err)
+		}
+	}
+	return nil
+}
+
+func (ds *OutboxDataSourcePostgres) ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.OutboxMessage, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	rows, err := querier.QueryContext(ctx, claimOutboxMessagesQuery, now, limit, leaseUntil)
+	if err != nil {
+		return nil, infrastructure.NewDBError("Error al leer los eventos pendientes del outbox", err)
+	}
+	defer rows.Close()
+
+	var messages []*entities.OutboxMessage
+	for rows.Next() {
+		m := &entities.OutboxMessage{}
+		var status string
+		if err := rows.Scan(&m.ID, &m.EventName, &m.Payload, &m.OccurredAt, &status, &m.Attempts,
+			&m.NextAttemptAt, &m.LastError, &m.CreatedAt); err != nil {
+			return nil, infrastructure.NewDBError("Error al leer un evento del outbox", err)
+		}
+		m.Status = entities.OutboxStatus(status)
+		messages = append(messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, infrastructure.NewDBError("Error al leer los eventos pendientes del outbox", err)
+	}
+	return messages, nil
+}
+
+func (ds *OutboxDataSourcePostgres) SaveAttempt(ctx context.Context, m *entities.OutboxMessage) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	_, err := querier.ExecContext(ctx, updateOutboxAttemptQuery,
+		m.ID, m.Status, m.Attempts, m.NextAttemptAt, m.LastError, m.PublishedAt)
+	if err != nil {
+		return infrastructure.NewDBError("Error al actualizar el evento del outbox", err)
+	}
+	return nil
+}
+
+func (ds *OutboxDataSourcePostgres) RequeueDead(ctx context.Context, now time.Time) (int, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+	result, err := querier.ExecContext(ctx, requeueDeadOutboxQuery, now)
+	if err != nil {
+		return 0, infrastructure.NewDBError("Error al reencolar los eventos descartados del outbox", err)
+	}
+	requeued, err := result.RowsAffected()
+	if err != nil {
+		return 0, infrastructure.NewDBError("Error al reencolar los eventos descartados del outbox", err)
+	}
+	return int(requeued), nil
+}
@@ -5,12 +5,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kevinsoras/employee-management/shared/domain"
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
 	"github.com/kevinsoras/employee-management/shared/domain/datasource"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
 	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
 	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
 	"github.com/kevinsoras/employee-management/shared/infrastructure/datasource/postgres/inserters"
 	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
 	"github.com/lib/pq"
@@ -18,18 +21,74 @@ import (
 
 const uniqueViolationCode = "23505"
 
+// updatePersonQuery solo actualiza si nadie guardó otra versión desde que se leyó la persona
+const updatePersonQuery = `UPDATE persons SET email = $2, phone = $3, address = $4, country = $5, updated_at = $6, version = version + 1
+WHERE person_id = $1 AND version = $7`
+
+const existsPersonQuery = `SELECT EXISTS (SELECT 1 FROM persons WHERE person_id = $1)`
+
+const deleteContactsQuery = `DELETE FROM person_contacts WHERE person_id = $1`
+
+const deleteAddressQuery = `DELETE FROM person_addresses WHERE person_id = $1`
+
+const selectNaturalPersonIDByDocumentQuery = `SELECT person_id FROM natural_persons WHERE document_type = $1 AND document_number_hash = $2`
+
+const selectJuridicalPersonIDByDocumentQuery = `SELECT person_id FROM juridical_persons WHERE document_number = $1`
+
+// selectDuplicateCandidatesQuery preselecciona por teléfono, fecha de nacimiento o nombre parecido
+// (operador % de pg_trgm, que usa los índices de trigramas) y se queda con los 50 candidatos más
+// cercanos, ponderados como en DuplicatePersonDetector
+const selectDuplicateCandidatesQuery = `SELECT p.person_id FROM persons p
+LEFT JOIN natural_persons np ON np.person_id = p.person_id
+LEFT JOIN juridical_persons jp ON jp.person_id = p.person_id
+WHERE p.person_id <> $1 AND p.person_type = $2 AND (
+	($3 <> '' AND p.phone = $3)
+	OR np.birth_date = $4
+	OR ($5 <> '' AND lower(np.first_name || ' ' || np.last_name_paternal || ' ' || coalesce(np.last_name_maternal, '')) % $5)
+	OR ($5 <> '' AND lower(jp.business_name) % $5)
+)
+ORDER BY
+	0.4 * greatest(
+		coalesce(similarity(lower(np.first_name || ' ' || np.last_name_paternal || ' ' || coalesce(np.last_name_maternal, '')), $5), 0),
+		coalesce(similarity(lower(jp.business_name), $5), 0)
+	)
+	+ CASE WHEN np.birth_date = $4 THEN 0.3 ELSE 0 END
+	+ CASE WHEN $3 <> '' AND p.phone = $3 THEN 0.3 ELSE 0 END DESC,
+	p.created_at
+LIMIT 50`
+
+const deletePersonQuery = `DELETE FROM persons WHERE person_id = $1`
+
+const insertPersonMergeQuery = `INSERT INTO person_merges (
+	merge_id, survivor_person_id, merged_person_id, reason, employees_moved, merged_snapshot, merged_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+// rucDocumentType identifica la búsqueda de personas jurídicas por RUC
+const rucDocumentType = "RUC"
+
 type PersonDataSourcePostgres struct {
 	db        *sql.DB
+	encrypter *crypto.FieldEncrypter
 	inserters map[value_objects.PersonType]inserters.PersonInserter
+	// detailInserters persisten los datos comunes a todo tipo de persona (contactos, domicilio)
+	detailInserters []inserters.PersonInserter
+	contactInserter inserters.PersonInserter
+	addressInserter inserters.PersonInserter
 }
 
-func NewPersonDataSourcePostgres(db *sql.DB) datasource.PersonDataSource {
+func NewPersonDataSourcePostgres(db *sql.DB, encrypter *crypto.FieldEncrypter) datasource.PersonDataSource {
+	contactInserter := inserters.NewContactInserter()
+	addressInserter := inserters.NewAddressInserter()
 	return &PersonDataSourcePostgres{
-		db: db,
+		db:        db,
+		encrypter: encrypter,
 		inserters: map[value_objects.PersonType]inserters.PersonInserter{
-			value_objects.Natural:   inserters.NewNaturalPersonInserter(),
+			value_objects.Natural:   inserters.NewNaturalPersonInserter(encrypter),
 			value_objects.Juridical: inserters.NewJuridicPersonInserter(),
 		},
+		detailInserters: []inserters.PersonInserter{contactInserter, addressInserter},
+		contactInserter: contactInserter,
+		addressInserter: addressInserter,
 	}
 }
 
@@ -51,24 +110,185 @@ func (ds *PersonDataSourcePostgres) SavePerson(ctx context.Context, agg *aggrega
 		return ds.handleError(err)
 	}
 
+	// Finally persist the details shared by every person type
+	for _, detailInserter := range ds.detailInserters {
+		if err = detailInserter.Insert(ctx, querier, agg); err != nil {
+			return ds.handleError(err)
+		}
+	}
+
+	return nil
+}
+
+// UpdatePerson persiste los datos de contacto y reemplaza contactos y domicilio estructurado.
+func (ds *PersonDataSourcePostgres) UpdatePerson(ctx context.Context, agg *aggregates.PersonAggregate) error {
+	querier := db.GetQuerier(ctx, ds.db)
+	person := agg.Person
+
+	result, err := querier.ExecContext(ctx, updatePersonQuery,
+		person.ID, person.Email, person.Phone, person.Address, person.Country, person.UpdatedAt, person.Version,
+	)
+	if err != nil {
+		return ds.handleError(err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return ds.updateConflict(ctx, querier, person.ID)
+	}
+	person.Version++
+
+	if _, err := querier.ExecContext(ctx, deleteContactsQuery, person.ID); err != nil {
+		return ds.handleError(err)
+	}
+	if err := ds.contactInserter.Insert(ctx, querier, agg); err != nil {
+		return ds.handleError(err)
+	}
+
+	if agg.Address == nil {
+		if _, err := querier.ExecContext(ctx, deleteAddressQuery, person.ID); err != nil {
+			return ds.handleError(err)
+		}
+		return nil
+	}
+	if err := ds.addressInserter.Insert(ctx, querier, agg); err != nil {
+		return ds.handleError(err)
+	}
 	return nil
 }
 
+// GetPersonByID reconstruye el agregado completo. Devuelve (nil, nil) si la persona no existe.
 func (ds *PersonDataSourcePostgres) GetPersonByID(ctx context.Context, id string) (*aggregates.PersonAggregate, error) {
-	// Aquí va la lógica SQL para obtener una persona por ID
-	return nil, nil
+	querier := db.GetQuerier(ctx, ds.db)
+
+	agg, err := loadPerson(ctx, querier, ds.encrypter, id)
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	return agg, nil
+}
+
+// GetPersonByDocument busca la persona por su documento. Devuelve (nil, nil) si no existe.
+func (ds *PersonDataSourcePostgres) GetPersonByDocument(ctx context.Context, documentType, documentNumber string) (*aggregates.PersonAggregate, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var personID string
+	var err error
+	if documentType == rucDocumentType {
+		err = querier.QueryRowContext(ctx, selectJuridicalPersonIDByDocumentQuery, documentNumber).Scan(&personID)
+	} else {
+		// El documento está cifrado: se busca por su índice ciego
+		err = querier.QueryRowContext(ctx, selectNaturalPersonIDByDocumentQuery, documentType, ds.encrypter.BlindIndex(documentNumber)).Scan(&personID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+
+	return ds.GetPersonByID(ctx, personID)
+}
+
+// FindDuplicateCandidates devuelve las personas que comparten teléfono o fecha de nacimiento con la
+// persona indicada o cuyo nombre completo o razón social se le parece, de la más a la menos cercana.
+func (ds *PersonDataSourcePostgres) FindDuplicateCandidates(ctx context.Context, agg *aggregates.PersonAggregate) ([]*aggregates.PersonAggregate, error) {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	var birthDate sql.NullTime
+	var name string
+	if np := agg.NaturalPerson; np != nil {
+		birthDate = sql.NullTime{Time: np.BirthDate, Valid: !np.BirthDate.IsZero()}
+		name = np.FirstName + " " + np.LastNamePaternal + " " + np.LastNameMaternal
+	}
+	if jp := agg.JuridicalPerson; jp != nil {
+		name = jp.BusinessName
+	}
+
+	rows, err := querier.QueryContext(ctx, selectDuplicateCandidatesQuery,
+		agg.Person.ID, agg.Person.Type, agg.Person.Phone, birthDate, strings.ToLower(strings.TrimSpace(name)),
+	)
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, ds.handleError(err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, ds.handleError(err)
+	}
+
+	candidates, err := loadPersons(ctx, querier, ds.encrypter, ids)
+	if err != nil {
+		return nil, ds.handleError(err)
+	}
+	return candidates, nil
+}
+
+// DeletePerson elimina la persona; sus datos específicos, contactos y domicilio se borran en cascada.
+func (ds *PersonDataSourcePostgres) DeletePerson(ctx context.Context, id string) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	result, err := querier.ExecContext(ctx, deletePersonQuery, id)
+	if err != nil {
+		return ds.handleError(err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return domain.NewNotFoundError("person.not_found", nil)
+	}
+	return nil
+}
+
+// SavePersonMerge persiste el registro de auditoría de una fusión. La foto de la persona fusionada
+// contiene sus datos personales, por eso se guarda cifrada.
+func (ds *PersonDataSourcePostgres) SavePersonMerge(ctx context.Context, merge *entities.PersonMerge) error {
+	querier := db.GetQuerier(ctx, ds.db)
+
+	sealedSnapshot, err := ds.encrypter.Encrypt(string(merge.MergedSnapshot), crypto.PurposePersonMergeSnapshot)
+	if err != nil {
+		return ds.handleError(err)
+	}
+	_, err = querier.ExecContext(ctx, insertPersonMergeQuery,
+		merge.ID, merge.SurvivorPersonID, merge.MergedPersonID, merge.Reason, merge.EmployeesMoved, sealedSnapshot, merge.MergedAt,
+	)
+	if err != nil {
+		return ds.handleError(err)
+	}
+	return nil
+}
+
+// updateConflict distingue, cuando el UPDATE no afectó filas, una persona inexistente
+// de una que otro usuario modificó después de ser leída.
+func (ds *PersonDataSourcePostgres) updateConflict(ctx context.Context, querier db.Querier, personID string) error {
+	var exists bool
+	if err := querier.QueryRowContext(ctx, existsPersonQuery, personID).Scan(&exists); err != nil {
+		return ds.handleError(err)
+	}
+	if !exists {
+		return domain.NewNotFoundError("person.not_found", nil)
+	}
+	return domain.NewVersionConflictError("person.version_conflict", nil)
 }
 
 // handleError translates specific database errors into domain errors or infrastructure errors.
 func (ds *PersonDataSourcePostgres) handleError(err error) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		if pqErr.Code == uniqueViolationCode {
-			return domain.NewAlreadyExistsError("La persona o el documento ya se encuentra registrado.", err)
+			return domain.NewAlreadyExistsError("person.already_exists", err)
 		}
 		// For any other pq.Error, wrap it as a generic DB error.
 		return infrastructure.NewDBError(fmt.Sprintf("Error de base de datos: %s", pqErr.Message), err)
 	}
 	// For any other non-pq error, wrap it as a generic DB error.
-		return infrastructure.NewDBError("Error inesperado de infraestructura", err)
+	return infrastructure.NewDBError("Error inesperado de infraestructura", err)
 }
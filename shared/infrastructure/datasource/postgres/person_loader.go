@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/value_objects"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
+	"github.com/lib/pq"
+)
+
+const selectPersonQuery = `SELECT person_id, person_type, COALESCE(email, ''), COALESCE(phone, ''), COALESCE(address, ''), COALESCE(country, ''), version, created_at, updated_at
+FROM persons WHERE person_id = $1`
+
+const selectNaturalPersonQuery = `SELECT document_type, document_number, first_name, last_name_paternal, COALESCE(last_name_maternal, ''),
+	birth_date, COALESCE(gender, ''), COALESCE(nationality, ''), work_permit_expiry
+FROM natural_persons WHERE person_id = $1`
+
+const selectJuridicalPersonQuery = `SELECT document_number, business_name, COALESCE(trade_name, ''), constitution_date,
+	COALESCE(representative_name, ''), COALESCE(representative_document, '')
+FROM juridical_persons WHERE person_id = $1`
+
+const selectContactsQuery = `SELECT contact_id, contact_type, value, COALESCE(contact_name, ''), COALESCE(relationship, ''), is_primary
+FROM person_contacts WHERE person_id = $1 ORDER BY created_at, contact_id`
+
+const selectAddressQuery = `SELECT street, COALESCE(reference, ''), ubigeo FROM person_addresses WHERE person_id = $1`
+
+// selectPersonsQuery trae varias personas completas en una sola consulta: los datos específicos y el
+// domicilio por LEFT JOIN y los contactos agregados como JSON, en el mismo orden que selectContactsQuery
+const selectPersonsQuery = `SELECT p.person_id, p.person_type, COALESCE(p.email, ''), COALESCE(p.phone, ''), COALESCE(p.address, ''), COALESCE(p.country, ''),
+	p.version, p.created_at, p.updated_at,
+	np.person_id IS NOT NULL, COALESCE(np.document_type, ''), COALESCE(np.document_number, ''), COALESCE(np.first_name, ''),
+	COALESCE(np.last_name_paternal, ''), COALESCE(np.last_name_maternal, ''), np.birth_date, COALESCE(np.gender, ''),
+	COALESCE(np.nationality, ''), np.work_permit_expiry,
+	jp.person_id IS NOT NULL, COALESCE(jp.document_number, ''), COALESCE(jp.business_name, ''), COALESCE(jp.trade_name, ''),
+	jp.constitution_date, COALESCE(jp.representative_name, ''), COALESCE(jp.representative_document, ''),
+	a.person_id IS NOT NULL, COALESCE(a.street, ''), COALESCE(a.reference, ''), COALESCE(a.ubigeo, ''),
+	COALESCE(c.contacts, '[]')
+FROM persons p
+LEFT JOIN natural_persons np ON np.person_id = p.person_id
+LEFT JOIN juridical_persons jp ON jp.person_id = p.person_id
+LEFT JOIN person_addresses a ON a.person_id = p.person_id
+LEFT JOIN LATERAL (
+	SELECT json_agg(json_build_object(
+		'id', contact_id, 'type', contact_type, 'value', value, 'name', COALESCE(contact_name, ''),
+		'relationship', COALESCE(relationship, ''), 'isPrimary', is_primary
+	) ORDER BY created_at, contact_id) AS contacts
+	FROM person_contacts WHERE person_id = p.person_id
+) c ON true
+WHERE p.person_id = ANY($1)`
+
+// contactRow es un contacto tal como lo agrega selectPersonsQuery
+type contactRow struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Value        string `json:"value"`
+	Name         string `json:"name"`
+	Relationship string `json:"relationship"`
+	IsPrimary    bool   `json:"isPrimary"`
+}
+
+// loadPerson reconstruye un PersonAggregate desde sus tablas. Devuelve (nil, nil) si no existe.
+// Los datos ya fueron validados al persistirse, por eso se rehidratan sin volver a validar.
+func loadPerson(ctx context.Context, querier db.Querier, encrypter *crypto.FieldEncrypter, id string) (*aggregates.PersonAggregate, error) {
+	person := &entities.Person{}
+	err := querier.QueryRowContext(ctx, selectPersonQuery, id).Scan(
+		&person.ID, &person.Type, &person.Email, &person.Phone, &person.Address, &person.Country, &person.Version, &person.CreatedAt, &person.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	agg := aggregates.NewPersonAggregate(person, nil, nil)
+	switch person.Type {
+	case value_objects.Natural:
+		if agg.NaturalPerson, err = loadNaturalPerson(ctx, querier, encrypter, id); err != nil {
+			return nil, err
+		}
+	case value_objects.Juridical:
+		if agg.JuridicalPerson, err = loadJuridicalPerson(ctx, querier, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if agg.Contacts, err = loadContacts(ctx, querier, id); err != nil {
+		return nil, err
+	}
+	if agg.Address, err = loadAddress(ctx, querier, id); err != nil {
+		return nil, err
+	}
+	return agg, nil
+}
+
+// loadPersons reconstruye varias personas con una sola consulta y las devuelve en el orden de ids.
+// Los ids que no existen se omiten.
+func loadPersons(ctx context.Context, querier db.Querier, encrypter *crypto.FieldEncrypter, ids []string) ([]*aggregates.PersonAggregate, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := querier.QueryContext(ctx, selectPersonsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byID := make(map[string]*aggregates.PersonAggregate, len(ids))
+	for rows.Next() {
+		agg, err := scanPersonRow(rows, encrypter)
+		if err != nil {
+			return nil, err
+		}
+		byID[agg.Person.ID] = agg
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	persons := make([]*aggregates.PersonAggregate, 0, len(byID))
+	for _, id := range ids {
+		if agg, ok := byID[id]; ok {
+			persons = append(persons, agg)
+		}
+	}
+	return persons, nil
+}
+
+// scanPersonRow arma el agregado desde una fila de selectPersonsQuery y descifra el documento en memoria.
+func scanPersonRow(rows *sql.Rows, encrypter *crypto.FieldEncrypter) (*aggregates.PersonAggregate, error) {
+	person := &entities.Person{}
+	np := &entities.NaturalPerson{}
+	jp := &entities.JuridicalPerson{}
+	address := &entities.Address{}
+	var hasNatural, hasJuridical, hasAddress bool
+	var birthDate, workPermitExpiry, constitutionDate sql.NullTime
+	var contactsJSON []byte
+	err := rows.Scan(
+		&person.ID, &person.Type, &person.Email, &person.Phone, &person.Address, &person.Country,
+		&person.Version, &person.CreatedAt, &person.UpdatedAt,
+		&hasNatural, &np.DocumentType, &np.DocumentNumber, &np.FirstName,
+		&np.LastNamePaternal, &np.LastNameMaternal, &birthDate, &np.Gender,
+		&np.Nationality, &workPermitExpiry,
+		&hasJuridical, &jp.DocumentNumber, &jp.BusinessName, &jp.TradeName,
+		&constitutionDate, &jp.RepresentativeName, &jp.RepresentativeDocument,
+		&hasAddress, &address.Street, &address.Reference, &address.Ubigeo,
+		&contactsJSON,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	agg := aggregates.NewPersonAggregate(person, nil, nil)
+	if hasNatural {
+		np.PersonID = person.ID
+		np.BirthDate = birthDate.Time
+		np.WorkPermitExpiry = workPermitExpiry.Time
+		if np.DocumentNumber, err = encrypter.Decrypt(np.DocumentNumber, crypto.PurposeDocumentNumber); err != nil {
+			return nil, err
+		}
+		agg.NaturalPerson = np
+	}
+	if hasJuridical {
+		jp.PersonID = person.ID
+		jp.ConstitutionDate = constitutionDate.Time
+		agg.JuridicalPerson = jp
+	}
+	if hasAddress {
+		address.PersonID = person.ID
+		agg.Address = address
+	}
+
+	var contacts []contactRow
+	if err := json.Unmarshal(contactsJSON, &contacts); err != nil {
+		return nil, err
+	}
+	for _, c := range contacts {
+		agg.Contacts = append(agg.Contacts, &entities.Contact{
+			ID: c.ID, PersonID: person.ID, Type: value_objects.ContactType(c.Type), Value: c.Value,
+			Name: c.Name, Relationship: c.Relationship, IsPrimary: c.IsPrimary,
+		})
+	}
+	return agg, nil
+}
+
+// loadNaturalPerson descifra el número de documento; las filas aún en texto plano se leen tal cual.
+func loadNaturalPerson(ctx context.Context, querier db.Querier, encrypter *crypto.FieldEncrypter, id string) (*entities.NaturalPerson, error) {
+	np := &entities.NaturalPerson{PersonID: id}
+	var workPermitExpiry sql.NullTime
+	err := querier.QueryRowContext(ctx, selectNaturalPersonQuery, id).Scan(
+		&np.DocumentType, &np.DocumentNumber, &np.FirstName, &np.LastNamePaternal, &np.LastNameMaternal,
+		&np.BirthDate, &np.Gender, &np.Nationality, &workPermitExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if workPermitExpiry.Valid {
+		np.WorkPermitExpiry = workPermitExpiry.Time
+	}
+	if np.DocumentNumber, err = encrypter.Decrypt(np.DocumentNumber, crypto.PurposeDocumentNumber); err != nil {
+		return nil, err
+	}
+	return np, nil
+}
+
+func loadJuridicalPerson(ctx context.Context, querier db.Querier, id string) (*entities.JuridicalPerson, error) {
+	jp := &entities.JuridicalPerson{PersonID: id}
+	err := querier.QueryRowContext(ctx, selectJuridicalPersonQuery, id).Scan(
+		&jp.DocumentNumber, &jp.BusinessName, &jp.TradeName, &jp.ConstitutionDate, &jp.RepresentativeName, &jp.RepresentativeDocument,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
+
+func loadContacts(ctx context.Context, querier db.Querier, id string) ([]*entities.Contact, error) {
+	rows, err := querier.QueryContext(ctx, selectContactsQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contacts []*entities.Contact
+	for rows.Next() {
+		c := &entities.Contact{PersonID: id}
+		if err := rows.Scan(&c.ID, &c.Type, &c.Value, &c.Name, &c.Relationship, &c.IsPrimary); err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, c)
+	}
+	return contacts, rows.Err()
+}
+
+func loadAddress(ctx context.Context, querier db.Querier, id string) (*entities.Address, error) {
+	address := &entities.Address{PersonID: id}
+	err := querier.QueryRowContext(ctx, selectAddressQuery, id).Scan(&address.Street, &address.Reference, &address.Ubigeo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return address, nil
+}
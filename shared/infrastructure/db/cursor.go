@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// DefaultCursorBatchSize is how many rows each FETCH brings from the server.
+const DefaultCursorBatchSize = 500
+
+// StreamCursor runs query through a server-side cursor and calls scan for every row, fetching
+// batchSize rows at a time, so large results are never held in memory at once.
+// A cursor only lives inside a transaction: the one in the context is reused, otherwise a
+// read-only transaction is opened for the duration of the stream.
+func StreamCursor(ctx context.Context, database *sql.DB, name, query string, args []any, batchSize int, scan func(*sql.Rows) error) error {
+	if batchSize <= 0 {
+		batchSize = DefaultCursorBatchSize
+	}
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return streamCursor(ctx, state.tx, name, query, args, batchSize, scan)
+	}
+
+	tx, err := database.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Nothing was written: the transaction is always rolled back, which also closes the cursor
+	defer func() { _ = tx.Rollback() }()
+	return streamCursor(ctx, tx, name, query, args, batchSize, scan)
+}
+
+func streamCursor(ctx context.Context, tx *sql.Tx, name, query string, args []any, batchSize int, scan func(*sql.Rows) error) error {
+	if _, err := tx.ExecContext(ctx, "DECLARE "+name+" NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return fmt.Errorf("failed to declare cursor: %w", err)
+	}
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", batchSize, name)
+	for {
+		rows, err := tx.QueryContext(ctx, fetch)
+		if err != nil {
+			return fmt.Errorf("failed to fetch from cursor: %w", err)
+		}
+		fetched := 0
+		for rows.Next() {
+			fetched++
+			if err := scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return err
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+		if fetched < batchSize {
+			break
+		}
+	}
+	_, err := tx.ExecContext(ctx, "CLOSE "+name)
+	return err
+}
@@ -11,6 +11,12 @@ import (
 // txKey is an unexported type to be used as a key for storing the transaction in the context.
 type txKey struct{}
 
+// txState is the transaction stored in the context, with the counter used to name its savepoints.
+type txState struct {
+	tx         *sql.Tx
+	savepoints int
+}
+
 // Querier defines the common methods for sql.DB and sql.Tx, allowing repositories
 // to work with both transactions and regular connections.
 type Querier interface {
@@ -30,14 +36,21 @@ func NewPostgresUoW(db *sql.DB) *PostgresUoW {
 }
 
 // Execute runs the given function within a single atomic transaction.
+// When the context already carries a transaction, the function runs inside a SAVEPOINT of it
+// instead: its failure only undoes its own writes and the outer transaction can go on
+// (the bulk import relies on this to keep processing the remaining rows).
 func (uow *PostgresUoW) Execute(ctx context.Context, fn domain.UowCallback) error {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return executeInSavepoint(ctx, state, fn)
+	}
+
 	tx, err := uow.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
 	// Create a new context with the transaction object.
-	txCtx := context.WithValue(ctx, txKey{}, tx)
+	txCtx := context.WithValue(ctx, txKey{}, &txState{tx: tx})
 
 	// Execute the callback with the transactional context.
 	err = fn(txCtx)
@@ -53,13 +66,33 @@ func (uow *PostgresUoW) Execute(ctx context.Context, fn domain.UowCallback) erro
 	return tx.Commit()
 }
 
+func executeInSavepoint(ctx context.Context, state *txState, fn domain.UowCallback) error {
+	state.savepoints++
+	name := fmt.Sprintf("sp_%d", state.savepoints)
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("savepoint failed: %v, and rollback failed: %w", err, rbErr)
+		}
+		return fmt.Errorf("savepoint failed: %w", err)
+	}
+
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
 // GetQuerier extracts a Querier (either a *sql.Tx or *sql.DB) from the context.
 // If a transaction (*sql.Tx) is present in the context, it is returned.
 // Otherwise, it returns the provided database connection pool (*sql.DB).
 func GetQuerier(ctx context.Context, db *sql.DB) Querier {
-	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	state, ok := ctx.Value(txKey{}).(*txState)
 	if ok {
-		return tx
+		return state.tx
 	}
 	return db
 }
@@ -0,0 +1,89 @@
+// Package docx writes simple Word documents: a title, headings and justified paragraphs of plain
+// text on A4 pages. The parts carry no timestamps, so the same content always produces the same
+// bytes.
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// ContentType is the media type of the documents written by this package.
+const ContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+// The static parts of a document without styles part: formatting is applied to each run.
+const (
+	contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/><Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/></Types>`
+	rootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/></Relationships>`
+	coreStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/"><dc:title>`
+	coreEnd       = `</dc:title></cp:coreProperties>`
+	documentStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>`
+	// A4 with 2.5 cm margins, in twentieths of a point
+	documentEnd = `<w:sectPr><w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:top="1418" w:right="1418" w:bottom="1418" w:left="1418" w:header="709" w:footer="709" w:gutter="0"/></w:sectPr></w:body></w:document>`
+)
+
+// Document accumulates the paragraphs until Bytes serializes them.
+type Document struct {
+	title string
+	body  bytes.Buffer
+}
+
+// New creates an empty document; title goes to the document properties.
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+// Title adds a centered bold line in a larger size.
+func (d *Document) Title(text string) {
+	d.paragraph(`<w:jc w:val="center"/><w:spacing w:after="240"/>`, `<w:b/><w:sz w:val="28"/>`, text)
+}
+
+// Heading adds a bold line that starts a section.
+func (d *Document) Heading(text string) {
+	d.paragraph(`<w:keepNext/><w:spacing w:before="240" w:after="120"/>`, `<w:b/><w:sz w:val="22"/>`, text)
+}
+
+// Paragraph adds justified text.
+func (d *Document) Paragraph(text string) {
+	d.paragraph(`<w:jc w:val="both"/><w:spacing w:after="160"/>`, `<w:sz w:val="22"/>`, text)
+}
+
+func (d *Document) paragraph(paragraphProperties, runProperties, text string) {
+	d.body.WriteString(`<w:p><w:pPr>` + paragraphProperties + `</w:pPr><w:r><w:rPr><w:rFonts w:ascii="Arial" w:hAnsi="Arial"/>` + runProperties + `</w:rPr><w:t xml:space="preserve">`)
+	_ = xml.EscapeText(&d.body, []byte(text))
+	d.body.WriteString(`</w:t></w:r></w:p>`)
+}
+
+// Bytes writes the package: content types, relationships, properties and the document part.
+func (d *Document) Bytes() ([]byte, error) {
+	var title strings.Builder
+	_ = xml.EscapeText(&title, []byte(d.title))
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRels},
+		{"docProps/core.xml", coreStart + title.String() + coreEnd},
+		{"word/document.xml", documentStart + d.body.String() + documentEnd},
+	} {
+		w, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,45 @@
+package docx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/docx"
+)
+
+func readPart(t *testing.T, content []byte, name string) string {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	part, err := archive.Open(name)
+	require.NoError(t, err)
+	defer part.Close()
+	data, err := io.ReadAll(part)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestDocument_WritesEscapedParagraphsDeterministically(t *testing.T) {
+	build := func() []byte {
+		doc := docx.New("Contrato <Núñez>")
+		doc.Title("CONTRATO DE TRABAJO")
+		doc.Heading("PRIMERA: OBJETO")
+		doc.Paragraph("EL EMPLEADOR & EL TRABAJADOR acuerdan...")
+		content, err := doc.Bytes()
+		require.NoError(t, err)
+		return content
+	}
+	content := build()
+
+	document := readPart(t, content, "word/document.xml")
+	assert.Contains(t, document, ">CONTRATO DE TRABAJO</w:t>")
+	assert.Contains(t, document, ">PRIMERA: OBJETO</w:t>")
+	assert.Contains(t, document, ">EL EMPLEADOR &amp; EL TRABAJADOR acuerdan...</w:t>")
+	assert.Contains(t, readPart(t, content, "docProps/core.xml"), "<dc:title>Contrato &lt;Núñez&gt;</dc:title>")
+	assert.Contains(t, readPart(t, content, "[Content_Types].xml"), "/word/document.xml")
+	assert.Equal(t, content, build())
+}
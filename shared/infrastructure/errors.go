@@ -33,8 +33,11 @@ func NewNetworkError(msg string, err error) error {
 	return &InfrastructureError{Msg: msg, Code: "NETWORK_ERROR", WrappedErr: err}
 }
 
+// ExternalServiceErrorCode identifies failures of third-party providers (mapped to 502 Bad Gateway).
+const ExternalServiceErrorCode = "EXTERNAL_SERVICE_ERROR"
+
 func NewExternalServiceError(msg string, err error) error {
-	return &InfrastructureError{Msg: msg, Code: "EXTERNAL_SERVICE_ERROR", WrappedErr: err}
+	return &InfrastructureError{Msg: msg, Code: ExternalServiceErrorCode, WrappedErr: err}
 }
 
 // You can define specific error instances if they are common
@@ -0,0 +1,149 @@
+// Package i18n renders the user-facing messages from the catalogs in locales/,
+// one JSON file per locale mapping message keys to templates like "{field} es obligatorio.".
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale identifies a catalog by its BCP 47 tag.
+type Locale string
+
+const (
+	SpanishPeru Locale = "es-PE"
+	EnglishUS   Locale = "en-US"
+)
+
+// DefaultLocale is used when the client does not ask for a supported language.
+const DefaultLocale = SpanishPeru
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// catalogs is loaded once from the embedded files; a broken catalog is a build defect.
+var catalogs = mustLoadCatalogs(SpanishPeru, EnglishUS)
+
+func mustLoadCatalogs(locales ...Locale) map[Locale]map[string]string {
+	loaded := make(map[Locale]map[string]string, len(locales))
+	for _, locale := range locales {
+		content, err := localeFiles.ReadFile("locales/" + string(locale) + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("i18n: missing catalog for %s: %v", locale, err))
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(content, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: invalid catalog for %s: %v", locale, err))
+		}
+		loaded[locale] = messages
+	}
+	return loaded
+}
+
+// Locales returns the supported locales, the default first.
+func Locales() []Locale {
+	return []Locale{SpanishPeru, EnglishUS}
+}
+
+// Keys returns the message keys of a locale's catalog, sorted.
+func Keys(locale Locale) []string {
+	keys := make([]string, 0, len(catalogs[locale]))
+	for key := range catalogs[locale] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Translate renders the message for key in the given locale, replacing each {name} with its param.
+// Keys missing in the locale fall back to the default locale, and then to the key itself.
+func Translate(locale Locale, key string, params map[string]any) string {
+	template, ok := catalogs[locale][key]
+	if !ok {
+		template, ok = catalogs[DefaultLocale][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(params) == 0 {
+		return template
+	}
+	replacements := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(template)
+}
+
+// MatchLocale picks the supported locale that best fits an Accept-Language header,
+// honoring the q-values. A language without an exact region match (es-MX, en-GB) falls back
+// to the supported locale of the same language.
+func MatchLocale(acceptLanguage string) Locale {
+	type candidate struct {
+		tag     string
+		quality float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, options, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(options), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality > 0 {
+			candidates = append(candidates, candidate{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+
+	for _, c := range candidates {
+		if c.tag == "*" {
+			return DefaultLocale
+		}
+		language, _, _ := strings.Cut(c.tag, "-")
+		for _, locale := range Locales() {
+			if strings.EqualFold(c.tag, string(locale)) {
+				return locale
+			}
+		}
+		for _, locale := range Locales() {
+			supported, _, _ := strings.Cut(string(locale), "-")
+			if strings.EqualFold(language, supported) {
+				return locale
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+type localeKey struct{}
+
+// WithLocale stores the locale negotiated for the request.
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale stored by WithLocale, or DefaultLocale outside a request.
+func LocaleFromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(localeKey{}).(Locale); ok {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// T renders key in the locale of the request.
+func T(ctx context.Context, key string, params map[string]any) string {
+	return Translate(LocaleFromContext(ctx), key, params)
+}
@@ -0,0 +1,42 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/i18n"
+)
+
+func TestCatalogs_HaveTheSameKeys(t *testing.T) {
+	assert.Equal(t, i18n.Keys(i18n.DefaultLocale), i18n.Keys(i18n.EnglishUS))
+}
+
+func TestTranslate(t *testing.T) {
+	params := map[string]any{"documentType": "DNI", "length": 8}
+
+	assert.Equal(t, "El DNI debe tener 8 dígitos.", i18n.Translate(i18n.SpanishPeru, "person.document_length", params))
+	assert.Equal(t, "The DNI must have 8 digits.", i18n.Translate(i18n.EnglishUS, "person.document_length", params))
+	assert.Equal(t, "unknown.key", i18n.Translate(i18n.EnglishUS, "unknown.key", nil), "unknown keys are returned as is")
+}
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           i18n.Locale
+	}{
+		{"", i18n.SpanishPeru},
+		{"en-US", i18n.EnglishUS},
+		{"en-GB,en;q=0.8", i18n.EnglishUS},
+		{"es-MX", i18n.SpanishPeru},
+		{"fr-FR, en;q=0.5", i18n.EnglishUS},
+		{"es;q=0.4, en;q=0.9", i18n.EnglishUS},
+		{"en;q=0, es", i18n.SpanishPeru},
+		{"de, *;q=0.1", i18n.SpanishPeru},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, tt.want, i18n.MatchLocale(tt.acceptLanguage))
+		})
+	}
+}
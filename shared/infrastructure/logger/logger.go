@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/application/masking"
 )
 
 // New creates a new slog.Logger based on environment variables.
@@ -66,10 +68,13 @@ func New() *slog.Logger {
 		handler = slog.NewTextHandler(multiWriter, &slog.HandlerOptions{Level: level})
 	}
 
+	// Redact PII from every log line with the same masking policy used by the API responses
+	handler = NewRedactingHandler(handler, masking.DefaultPolicy())
+
 	logger := slog.New(handler)
 	slog.SetDefault(logger)
 
 	logger.Info("Logger initialized", "level", level.String(), "format", logFormat, "outputs", logOutputs)
 
 	return logger
-}
\ No newline at end of file
+}
@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+)
+
+// redactedValue replaces values whose masking strategy hides them completely.
+const redactedValue = "[REDACTED]"
+
+// RedactingHandler wraps a slog.Handler and masks PII attributes (bank account, salary, document,
+// email, phone, secrets) with the same policy used by the API responses. Logs never have a role,
+// so every sensitive attribute is masked, including those nested in groups or LogValuers.
+type RedactingHandler struct {
+	next   slog.Handler
+	policy masking.Policy
+}
+
+// NewRedactingHandler wraps next with the given masking policy.
+func NewRedactingHandler(next slog.Handler, policy masking.Policy) *RedactingHandler {
+	return &RedactingHandler{next: next, policy: policy}
+}
+
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *RedactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redact(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.redact(attr)
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(redacted), policy: h.policy}
+}
+
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name), policy: h.policy}
+}
+
+func (h *RedactingHandler) redact(attr slog.Attr) slog.Attr {
+	attr.Value = attr.Value.Resolve()
+
+	if attr.Value.Kind() == slog.KindGroup {
+		group := attr.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, member := range group {
+			redacted[i] = h.redact(member)
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(redacted...)}
+	}
+
+	field, sensitive := masking.FieldForKey(attr.Key)
+	if !sensitive {
+		return attr
+	}
+	rule, ok := h.policy.Rule(field)
+	if !ok {
+		return attr
+	}
+	masked := masking.Apply(rule.Strategy, valueString(attr.Value))
+	if masked == "" {
+		masked = redactedValue
+	}
+	return slog.String(attr.Key, masked)
+}
+
+func valueString(value slog.Value) string {
+	if value.Kind() == slog.KindString {
+		return value.String()
+	}
+	return fmt.Sprint(value.Any())
+}
@@ -0,0 +1,39 @@
+package logger_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/application/masking"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/logger"
+)
+
+type employeeLog struct{}
+
+func (employeeLog) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("bankAccount", "19112345678901"), slog.String("position", "Analyst"))
+}
+
+func TestRedactingHandler_MasksPII(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(logger.NewRedactingHandler(slog.NewTextHandler(&buf, nil), masking.DefaultPolicy()))
+
+	log.With("email", "juan.perez@empresa.pe").Info("registered",
+		"salary", 5000.0,
+		"dni", "45678912",
+		"employee", employeeLog{},
+		slog.Group("person", slog.String("phone", "987654321")),
+	)
+
+	out := buf.String()
+	assert.Contains(t, out, "email=j***@empresa.pe")
+	assert.Contains(t, out, "salary=[REDACTED]")
+	assert.Contains(t, out, "dni=45****12")
+	assert.Contains(t, out, "employee.bankAccount=****8901")
+	assert.Contains(t, out, "employee.position=Analyst")
+	assert.Contains(t, out, "person.phone=****4321")
+	assert.NotContains(t, out, "5000")
+}
@@ -0,0 +1,109 @@
+package lookup
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// defaultCacheMaxEntries bounds the cache when no explicit size is given.
+const defaultCacheMaxEntries = 10000
+
+// cacheEntry holds a lookup result together with its key and expiration time.
+type cacheEntry struct {
+	key       string
+	result    services.PersonLookupResult
+	expiresAt time.Time
+}
+
+// CachedPersonLookupService decorates a PersonLookupService with an in-memory TTL cache.
+// Only successful lookups are cached, so provider failures are retried on the next call.
+// The cache holds at most maxEntries results and evicts the least recently used one when full.
+type CachedPersonLookupService struct {
+	next       services.PersonLookupService
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	mu         sync.Mutex
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+// NewCachedPersonLookupService creates a new caching decorator. A maxEntries of zero or less
+// falls back to defaultCacheMaxEntries.
+func NewCachedPersonLookupService(next services.PersonLookupService, ttl time.Duration, maxEntries int) *CachedPersonLookupService {
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheMaxEntries
+	}
+	return &CachedPersonLookupService{
+		next:       next,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Lookup returns the cached result when it is still fresh, otherwise it delegates to the wrapped service.
+func (c *CachedPersonLookupService) Lookup(ctx context.Context, documentType services.LookupDocumentType, number string) (*services.PersonLookupResult, error) {
+	key := string(documentType) + ":" + number
+
+	if result, ok := c.get(key); ok {
+		return &result, nil
+	}
+
+	result, err := c.next.Lookup(ctx, documentType, number)
+	if err != nil {
+		return nil, err
+	}
+
+	c.put(key, *result)
+	return result, nil
+}
+
+// get returns a fresh entry and marks it as recently used. Stale entries are dropped.
+func (c *CachedPersonLookupService) get(key string) (services.PersonLookupResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return services.PersonLookupResult{}, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return services.PersonLookupResult{}, false
+	}
+	c.order.MoveToFront(element)
+	return entry.result, true
+}
+
+// put stores a result, evicting the least recently used entry when the cache is full.
+func (c *CachedPersonLookupService) put(key string, result services.PersonLookupResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		entry.result, entry.expiresAt = result, expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, result: result, expiresAt: expiresAt})
+	if c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops an entry. The caller must hold the lock.
+func (c *CachedPersonLookupService) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*cacheEntry).key)
+}
@@ -0,0 +1,133 @@
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+)
+
+const defaultTimeout = 5 * time.Second
+
+// HTTPLookupConfig configures the HTTP lookup provider.
+type HTTPLookupConfig struct {
+	BaseURL string        // e.g. https://api.apis.net.pe/v2
+	Token   string        // Bearer token issued by the provider
+	Timeout time.Duration // Per-request timeout
+}
+
+// HTTPPersonLookupService queries a RENIEC/SUNAT lookup provider over HTTP.
+// The provider is expected to expose `/reniec/dni?numero=` and `/sunat/ruc?numero=`.
+type HTTPPersonLookupService struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewHTTPPersonLookupService creates a new HTTP adapter for the lookup provider.
+func NewHTTPPersonLookupService(cfg HTTPLookupConfig) services.PersonLookupService {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &HTTPPersonLookupService{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+		token:   cfg.Token,
+	}
+}
+
+// reniecResponse is the provider payload for a DNI lookup.
+type reniecResponse struct {
+	FirstName        string `json:"nombres"`
+	LastNamePaternal string `json:"apellidoPaterno"`
+	LastNameMaternal string `json:"apellidoMaterno"`
+	DocumentNumber   string `json:"numeroDocumento"`
+}
+
+// sunatResponse is the provider payload for a RUC lookup.
+type sunatResponse struct {
+	BusinessName   string `json:"razonSocial"`
+	TradeName      string `json:"nombreComercial"`
+	DocumentNumber string `json:"numeroDocumento"`
+	Address        string `json:"direccion"`
+	Status         string `json:"estado"`
+	Condition      string `json:"condicion"`
+}
+
+// Lookup fetches the public registry data for the given document.
+func (s *HTTPPersonLookupService) Lookup(ctx context.Context, documentType services.LookupDocumentType, number string) (*services.PersonLookupResult, error) {
+	switch documentType {
+	case services.LookupDNI:
+		var payload reniecResponse
+		if err := s.get(ctx, "/reniec/dni", number, &payload); err != nil {
+			return nil, err
+		}
+		return &services.PersonLookupResult{
+			DocumentType:     services.LookupDNI,
+			DocumentNumber:   number,
+			FirstName:        payload.FirstName,
+			LastNamePaternal: payload.LastNamePaternal,
+			LastNameMaternal: payload.LastNameMaternal,
+		}, nil
+	case services.LookupRUC:
+		var payload sunatResponse
+		if err := s.get(ctx, "/sunat/ruc", number, &payload); err != nil {
+			return nil, err
+		}
+		return &services.PersonLookupResult{
+			DocumentType:   services.LookupRUC,
+			DocumentNumber: number,
+			BusinessName:   payload.BusinessName,
+			TradeName:      payload.TradeName,
+			Address:        payload.Address,
+			Status:         payload.Status,
+			Condition:      payload.Condition,
+		}, nil
+	default:
+		return nil, domain.NewInvalidInputError("person.lookup_unsupported_document", nil).WithParams(domain.Params{"documentType": documentType})
+	}
+}
+
+// get performs the request and decodes a successful response into dst.
+func (s *HTTPPersonLookupService) get(ctx context.Context, path, number string, dst interface{}) error {
+	endpoint := fmt.Sprintf("%s%s?numero=%s", s.baseURL, path, url.QueryEscape(number))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo construir la consulta al proveedor", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return infrastructure.NewExternalServiceError("El proveedor de consulta de documentos no responde", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return domain.NewNotFoundError("person.lookup_not_registered", nil)
+	case resp.StatusCode == http.StatusUnprocessableEntity:
+		return domain.NewInvalidInputError("person.lookup_rejected", nil)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return infrastructure.NewExternalServiceError(
+			"El proveedor de consulta de documentos devolvió un error",
+			fmt.Errorf("unexpected status %d from %s", resp.StatusCode, path),
+		)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return infrastructure.NewExternalServiceError("Respuesta inválida del proveedor de consulta de documentos", err)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package lookup_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/lookup"
+)
+
+func TestHTTPPersonLookupService_LookupDNI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reniec/dni", r.URL.Path)
+		assert.Equal(t, "12345678", r.URL.Query().Get("numero"))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"nombres":"JUAN","apellidoPaterno":"PEREZ","apellidoMaterno":"GOMEZ","numeroDocumento":"12345678"}`))
+	}))
+	defer server.Close()
+
+	service := lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL, Token: "secret"})
+
+	result, err := service.Lookup(context.Background(), services.LookupDNI, "12345678")
+
+	require.NoError(t, err)
+	assert.Equal(t, "JUAN", result.FirstName)
+	assert.Equal(t, "PEREZ", result.LastNamePaternal)
+	assert.Equal(t, "GOMEZ", result.LastNameMaternal)
+}
+
+func TestHTTPPersonLookupService_LookupRUC(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sunat/ruc", r.URL.Path)
+		_, _ = w.Write([]byte(`{"razonSocial":"EMPRESA SAC","numeroDocumento":"20123456789","estado":"ACTIVO","condicion":"HABIDO","direccion":"AV. LIMA 123"}`))
+	}))
+	defer server.Close()
+
+	service := lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL})
+
+	result, err := service.Lookup(context.Background(), services.LookupRUC, "20123456789")
+
+	require.NoError(t, err)
+	assert.Equal(t, "EMPRESA SAC", result.BusinessName)
+	assert.Equal(t, "ACTIVO", result.Status)
+}
+
+func TestHTTPPersonLookupService_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	service := lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL})
+
+	_, err := service.Lookup(context.Background(), services.LookupDNI, "12345678")
+
+	var domainErr *domain.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "NOT_FOUND", domainErr.Code)
+}
+
+func TestHTTPPersonLookupService_ProviderFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	service := lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL})
+
+	_, err := service.Lookup(context.Background(), services.LookupDNI, "12345678")
+
+	var infraErr *infrastructure.InfrastructureError
+	require.True(t, errors.As(err, &infraErr))
+	assert.Equal(t, infrastructure.ExternalServiceErrorCode, infraErr.Code)
+}
+
+func TestHTTPPersonLookupService_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	service := lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL, Timeout: 20 * time.Millisecond})
+
+	_, err := service.Lookup(context.Background(), services.LookupDNI, "12345678")
+
+	var infraErr *infrastructure.InfrastructureError
+	require.True(t, errors.As(err, &infraErr))
+	assert.Equal(t, infrastructure.ExternalServiceErrorCode, infraErr.Code)
+}
+
+func TestCachedPersonLookupService_CachesSuccessfulLookups(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"nombres":"JUAN","apellidoPaterno":"PEREZ"}`))
+	}))
+	defer server.Close()
+
+	service := lookup.NewCachedPersonLookupService(
+		lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL}),
+		time.Minute,
+		0,
+	)
+
+	for i := 0; i < 3; i++ {
+		result, err := service.Lookup(context.Background(), services.LookupDNI, "12345678")
+		require.NoError(t, err)
+		assert.Equal(t, "JUAN", result.FirstName)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestCachedPersonLookupService_EvictsLeastRecentlyUsedWhenFull(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Query().Get("numero")]++
+		_, _ = w.Write([]byte(`{"nombres":"JUAN","apellidoPaterno":"PEREZ"}`))
+	}))
+	defer server.Close()
+
+	service := lookup.NewCachedPersonLookupService(
+		lookup.NewHTTPPersonLookupService(lookup.HTTPLookupConfig{BaseURL: server.URL}),
+		time.Minute,
+		2,
+	)
+
+	for _, number := range []string{"11111111", "22222222", "11111111", "33333333", "11111111", "22222222"} {
+		_, err := service.Lookup(context.Background(), services.LookupDNI, number)
+		require.NoError(t, err)
+	}
+	// 22222222 was the least recently used when 33333333 came in, so it was evicted and fetched again
+	assert.Equal(t, map[string]int{"11111111": 1, "22222222": 2, "33333333": 1}, calls)
+}
+
+func TestInMemoryPersonLookupService(t *testing.T) {
+	service := lookup.NewInMemoryPersonLookupService(services.PersonLookupResult{
+		DocumentType:   services.LookupDNI,
+		DocumentNumber: "12345678",
+		FirstName:      "JUAN",
+	})
+
+	result, err := service.Lookup(context.Background(), services.LookupDNI, "12345678")
+	require.NoError(t, err)
+	assert.Equal(t, "JUAN", result.FirstName)
+
+	_, err = service.Lookup(context.Background(), services.LookupDNI, "87654321")
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package lookup
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+)
+
+// InMemoryPersonLookupService serves lookups from a fixed set of fixtures.
+// It is meant for tests and local development without access to the real provider.
+type InMemoryPersonLookupService struct {
+	fixtures map[string]services.PersonLookupResult
+}
+
+// NewInMemoryPersonLookupService creates a lookup service preloaded with the given fixtures.
+func NewInMemoryPersonLookupService(fixtures ...services.PersonLookupResult) *InMemoryPersonLookupService {
+	s := &InMemoryPersonLookupService{fixtures: make(map[string]services.PersonLookupResult)}
+	for _, fixture := range fixtures {
+		s.fixtures[string(fixture.DocumentType)+":"+fixture.DocumentNumber] = fixture
+	}
+	return s
+}
+
+// Lookup returns the matching fixture or a NOT_FOUND domain error.
+func (s *InMemoryPersonLookupService) Lookup(_ context.Context, documentType services.LookupDocumentType, number string) (*services.PersonLookupResult, error) {
+	fixture, ok := s.fixtures[string(documentType)+":"+number]
+	if !ok {
+		return nil, domain.NewNotFoundError("person.lookup_not_registered", nil)
+	}
+	return &fixture, nil
+}
@@ -0,0 +1,28 @@
+// Package messaging implements services.EventPublisher over the transports the outbox relay can
+// publish to: an HTTP webhook, a NATS server and the standard output.
+package messaging
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+)
+
+// Envelope is the JSON document every publisher sends. ID is stable across retries, so consumers
+// use it to discard duplicates.
+type Envelope struct {
+	ID         string          `json:"id"`
+	Event      string          `json:"event"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewEnvelope wraps the outbox message payload.
+func NewEnvelope(message *entities.OutboxMessage) Envelope {
+	return Envelope{ID: message.ID, Event: message.EventName, OccurredAt: message.OccurredAt, Data: message.Payload}
+}
+
+func marshalEnvelope(message *entities.OutboxMessage) ([]byte, error) {
+	return json.Marshal(NewEnvelope(message))
+}
@@ -0,0 +1,247 @@
+package messaging_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/messaging"
+)
+
+func newMessage() *entities.OutboxMessage {
+	return &entities.OutboxMessage{
+		ID:         "6f1c2a54-8a1e-4d5f-9f1a-2b3c4d5e6f70",
+		EventName:  "employee.salary_changed",
+		Payload:    []byte(`{"employeeId":"emp-1","previousSalary":3000,"newSalary":3500}`),
+		OccurredAt: time.Date(2025, 9, 25, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestStdoutPublisher_WritesOneJSONLinePerMessage(t *testing.T) {
+	var out bytes.Buffer
+	publisher := messaging.NewStdoutPublisher(&out)
+
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	var envelope messaging.Envelope
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &envelope))
+	assert.Equal(t, "employee.salary_changed", envelope.Event)
+	assert.JSONEq(t, `{"employeeId":"emp-1","previousSalary":3000,"newSalary":3500}`, string(envelope.Data))
+}
+
+func TestWebhookPublisher_PostsTheEnvelope(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	publisher, err := messaging.NewWebhookPublisher(messaging.WebhookConfig{URL: server.URL + "/events", Token: "secret"})
+	require.NoError(t, err)
+
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "/events", received.URL.Path)
+	assert.Equal(t, "6f1c2a54-8a1e-4d5f-9f1a-2b3c4d5e6f70", received.Header.Get("X-Event-Id"))
+	assert.Equal(t, "employee.salary_changed", received.Header.Get("X-Event-Name"))
+	assert.Equal(t, "Bearer secret", received.Header.Get("Authorization"))
+	assert.JSONEq(t, `{"id":"6f1c2a54-8a1e-4d5f-9f1a-2b3c4d5e6f70","event":"employee.salary_changed",
+		"occurredAt":"2025-09-25T10:00:00Z","data":{"employeeId":"emp-1","previousSalary":3000,"newSalary":3500}}`, string(body))
+}
+
+func TestWebhookPublisher_RejectedStatusIsAnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "maintenance", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	publisher, err := messaging.NewWebhookPublisher(messaging.WebhookConfig{URL: server.URL})
+	require.NoError(t, err)
+
+	err = publisher.Publish(context.Background(), newMessage())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 503")
+	assert.NotContains(t, err.Error(), "maintenance", "the response body is not kept")
+}
+
+// fakeNATS accepts one connection at a time and speaks enough of the protocol to receive PUB/HPUB
+// and, for JetStream, to answer each publish on its reply subject with a stream acknowledgement
+type fakeNATS struct {
+	listener  net.Listener
+	published chan string // "<subject> <headers>|<payload>"
+	connects  chan string
+	stored    map[string]bool // Nats-Msg-Id of the messages the fake stream kept
+}
+
+func startFakeNATS(t *testing.T) *fakeNATS {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeNATS{listener: listener, published: make(chan string, 10), connects: make(chan string, 10), stored: map[string]bool{}}
+	t.Cleanup(func() { listener.Close() })
+	go server.serve()
+	return server
+}
+
+func (s *fakeNATS) url() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *fakeNATS) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.handle(conn)
+	}
+}
+
+func (s *fakeNATS) handle(conn net.Conn) {
+	defer conn.Close()
+	fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"version\":\"2.10.0\",\"proto\":1,\"headers\":true,\"max_payload\":1048576}\r\n")
+	reader := bufio.NewReader(conn)
+	subscriptions := map[string]string{} // subject prefix of a wildcard inbox -> sid
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "CONNECT":
+			s.connects <- strings.TrimSpace(strings.TrimPrefix(line, "CONNECT"))
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case "SUB":
+			subscriptions[strings.TrimSuffix(fields[1], "*")] = fields[len(fields)-1]
+		case "PUB", "HPUB":
+			headerLen := 0
+			if fields[0] == "HPUB" {
+				headerLen, _ = strconv.Atoi(fields[len(fields)-2])
+			}
+			total, _ := strconv.Atoi(fields[len(fields)-1])
+			frame := make([]byte, total+2)
+			if _, err := io.ReadFull(reader, frame); err != nil {
+				return
+			}
+			headers, payload := string(frame[:headerLen]), string(frame[headerLen:total])
+			s.published <- fields[1] + " " + headers + "|" + payload
+			if hasReply := len(fields) == 5 || (fields[0] == "PUB" && len(fields) == 4); hasReply {
+				s.ack(conn, subscriptions, fields[1], fields[2], headers)
+			}
+		}
+	}
+}
+
+// ack answers a JetStream publish the way a stream does: rejected subjects get an API error and
+// a repeated Nats-Msg-Id is reported as a duplicate instead of being stored again
+func (s *fakeNATS) ack(conn net.Conn, subscriptions map[string]string, subject, reply, headers string) {
+	var ack string
+	_, msgID, _ := strings.Cut(headers, "Nats-Msg-Id: ")
+	msgID, _, _ = strings.Cut(msgID, "\r\n")
+	switch {
+	case strings.Contains(subject, "reject"):
+		ack = `{"error":{"code":503,"err_code":10077,"description":"maximum messages exceeded"}}`
+	case s.stored[msgID]:
+		ack = `{"stream":"EVENTS","seq":1,"duplicate":true}`
+	default:
+		s.stored[msgID] = true
+		ack = `{"stream":"EVENTS","seq":1}`
+	}
+	for prefix, sid := range subscriptions {
+		if strings.HasPrefix(reply, prefix) {
+			fmt.Fprintf(conn, "MSG %s %s %d\r\n%s\r\n", reply, sid, len(ack), ack)
+			return
+		}
+	}
+}
+
+func TestNATSPublisher_PublishesWithMessageIDHeader(t *testing.T) {
+	server := startFakeNATS(t)
+	publisher, err := messaging.NewNATSPublisher(messaging.NATSConfig{URL: server.url(), SubjectPrefix: "hr."})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+
+	assert.Contains(t, <-server.connects, `"name":"employee-management-outbox"`)
+	first := <-server.published
+	assert.True(t, strings.HasPrefix(first, "hr.employee.salary_changed NATS/1.0\r\nNats-Msg-Id: 6f1c2a54-8a1e-4d5f-9f1a-2b3c4d5e6f70\r\n\r\n|"), first)
+	assert.Contains(t, first, `"data":{"employeeId":"emp-1"`)
+	<-server.published
+	assert.Empty(t, server.connects, "the connection is reused")
+}
+
+func TestNATSPublisher_SendsCredentials(t *testing.T) {
+	server := startFakeNATS(t)
+	publisher, err := messaging.NewNATSPublisher(messaging.NATSConfig{URL: strings.Replace(server.url(), "nats://", "nats://relay:s3cret@", 1)})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+
+	connect := <-server.connects
+	assert.Contains(t, connect, `"user":"relay"`)
+	assert.Contains(t, connect, `"pass":"s3cret"`)
+	assert.True(t, strings.HasPrefix(<-server.published, "employee.salary_changed NATS/1.0"))
+}
+
+func TestNATSPublisher_JetStreamWaitsForTheStreamAck(t *testing.T) {
+	server := startFakeNATS(t)
+	publisher, err := messaging.NewNATSPublisher(messaging.NATSConfig{URL: server.url(), JetStream: true})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()))
+	require.NoError(t, publisher.Publish(context.Background(), newMessage()), "a retry the stream already stored is not an error")
+
+	assert.Len(t, server.stored, 1)
+}
+
+func TestNATSPublisher_JetStreamRejectionIsAnError(t *testing.T) {
+	server := startFakeNATS(t)
+	publisher, err := messaging.NewNATSPublisher(messaging.NATSConfig{URL: server.url(), SubjectPrefix: "reject", JetStream: true})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	err = publisher.Publish(context.Background(), newMessage())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maximum messages exceeded")
+}
+
+func TestNATSPublisher_UnreachableServerIsAnError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+	publisher, err := messaging.NewNATSPublisher(messaging.NATSConfig{URL: "nats://" + address, Timeout: 200 * time.Millisecond})
+	require.NoError(t, err, "the client keeps trying to connect in the background")
+	defer publisher.Close()
+
+	assert.Error(t, publisher.Publish(context.Background(), newMessage()))
+}
@@ -0,0 +1,112 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+)
+
+// NATSConfig configures the NATS server the events are published to.
+type NATSConfig struct {
+	URL           string // nats://[user:password@]host:4222, tls://host:4222 or a comma-separated list of servers
+	SubjectPrefix string // The subject is "<prefix>.<event name>", e.g. hr.employee.registered
+	Timeout       time.Duration
+	// JetStream publishes through JetStream and waits for the stream acknowledgement; the stream
+	// discards the retries of a message it already stored by their Nats-Msg-Id
+	JetStream   bool
+	CredsFile   string // User JWT and NKey seed (.creds), for servers with decentralized auth
+	TLSCAFile   string // CA that signed the server certificate; enables TLS
+	TLSCertFile string // Client certificate and key, when the server requires mutual TLS
+	TLSKeyFile  string
+}
+
+// NATSPublisher publishes each message to "<prefix>.<event name>" with the nats.go client, which
+// keeps the connection open, reconnects on its own and supports TLS. The message ID travels as
+// the Nats-Msg-Id header. With JetStream the publish succeeds once the stream stored the message;
+// otherwise a flush confirms the server received it.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	config NATSConfig
+}
+
+func NewNATSPublisher(config NATSConfig) (*NATSPublisher, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("NATS URL is required")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+	config.SubjectPrefix = strings.Trim(config.SubjectPrefix, ".")
+
+	options := []nats.Option{
+		nats.Name("employee-management-outbox"),
+		nats.Timeout(config.Timeout),
+		// The relay retries the messages itself: keep reconnecting instead of giving up
+		nats.MaxReconnects(-1),
+		nats.RetryOnFailedConnect(true),
+	}
+	if config.CredsFile != "" {
+		options = append(options, nats.UserCredentials(config.CredsFile))
+	}
+	if config.TLSCAFile != "" {
+		options = append(options, nats.RootCAs(config.TLSCAFile))
+	}
+	if config.TLSCertFile != "" || config.TLSKeyFile != "" {
+		options = append(options, nats.ClientCert(config.TLSCertFile, config.TLSKeyFile))
+	}
+	conn, err := nats.Connect(config.URL, options...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to NATS: %w", err)
+	}
+
+	publisher := &NATSPublisher{conn: conn, config: config}
+	if config.JetStream {
+		if publisher.js, err = jetstream.New(conn); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("error creating the JetStream context: %w", err)
+		}
+	}
+	return publisher, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, message *entities.OutboxMessage) error {
+	payload, err := marshalEnvelope(message)
+	if err != nil {
+		return err
+	}
+	msg := nats.NewMsg(p.subject(message.EventName))
+	msg.Header.Set(jetstream.MsgIDHeader, message.ID)
+	msg.Data = payload
+
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout)
+	defer cancel()
+	if p.js != nil {
+		_, err = p.js.PublishMsg(ctx, msg)
+	} else if err = p.conn.PublishMsg(msg); err == nil {
+		err = p.conn.FlushWithContext(ctx)
+	}
+	if err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo publicar el evento en NATS", err)
+	}
+	return nil
+}
+
+// Close flushes what is pending and closes the connection.
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+func (p *NATSPublisher) subject(eventName string) string {
+	if p.config.SubjectPrefix == "" {
+		return eventName
+	}
+	return p.config.SubjectPrefix + "." + eventName
+}
@@ -0,0 +1,35 @@
+package messaging
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+)
+
+// StdoutPublisher writes each message as a JSON line. It suits local development and deployments
+// where a log shipper forwards the relay output.
+type StdoutPublisher struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewStdoutPublisher writes to w (os.Stdout in the relay).
+func NewStdoutPublisher(w io.Writer) *StdoutPublisher {
+	return &StdoutPublisher{writer: w}
+}
+
+func (p *StdoutPublisher) Publish(_ context.Context, message *entities.OutboxMessage) error {
+	line, err := marshalEnvelope(message)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, err := p.writer.Write(append(line, '\n')); err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo escribir el evento", err)
+	}
+	return nil
+}
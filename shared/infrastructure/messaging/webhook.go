@@ -0,0 +1,56 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/webhook"
+)
+
+// WebhookConfig configures the HTTP endpoint that receives the events.
+type WebhookConfig struct {
+	URL     string
+	Token   string // Sent as "Authorization: Bearer <token>" when set
+	Timeout time.Duration
+}
+
+// WebhookPublisher POSTs each message as JSON to a fixed URL. Any 2xx response acknowledges it;
+// other statuses and network errors are retried by the relay. The URL comes from the deployment,
+// not from users, so it may be plain http or point to an internal address.
+type WebhookPublisher struct {
+	client *webhook.Client
+	config WebhookConfig
+}
+
+func NewWebhookPublisher(config WebhookConfig) (*WebhookPublisher, error) {
+	client := webhook.NewClient(webhook.Config{Timeout: config.Timeout, AllowHTTP: true, AllowPrivateNetworks: true})
+	if err := client.CheckURL(config.URL); err != nil {
+		return nil, fmt.Errorf("invalid webhook URL %q", config.URL)
+	}
+	return &WebhookPublisher{client: client, config: config}, nil
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, message *entities.OutboxMessage) error {
+	body, err := marshalEnvelope(message)
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("X-Event-Id", message.ID)
+	header.Set("X-Event-Name", message.EventName)
+	if p.config.Token != "" {
+		header.Set("Authorization", "Bearer "+p.config.Token)
+	}
+	status, err := p.client.Post(ctx, p.config.URL, body, header)
+	if err != nil && status == 0 {
+		return infrastructure.NewExternalServiceError("No se pudo enviar el evento al webhook", err)
+	}
+	if err != nil {
+		return infrastructure.NewExternalServiceError("El webhook rechazó el evento", err)
+	}
+	return nil
+}
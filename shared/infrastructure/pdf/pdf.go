@@ -0,0 +1,151 @@
+// Package pdf writes simple PDF 1.4 documents: A4 pages with text in the standard Helvetica fonts,
+// lines and rectangles. The standard fonts need no embedding, and the output has no timestamps or
+// random identifiers, so the same content always produces the same bytes (and the same hash).
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A4 page size in points (1/72 inch). The origin is the bottom-left corner.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font is one of the two standard fonts a document can use.
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+func (f Font) resource() string {
+	if f == Bold {
+		return "/F2"
+	}
+	return "/F1"
+}
+
+// Document holds the pages until Write serializes them.
+type Document struct {
+	title string
+	pages []*Page
+}
+
+// Page accumulates the drawing operators of one page.
+type Page struct {
+	content bytes.Buffer
+}
+
+// New creates an empty document; title goes to the document information dictionary.
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+// AddPage appends a blank A4 page and returns it for drawing.
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text draws text with its baseline starting at (x, y).
+func (p *Page) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT %s %s Tf %s %s Td (%s) Tj ET\n", font.resource(), num(size), num(x), num(y), escape(text))
+}
+
+// TextRight draws text so that it ends at x, for columns of amounts.
+func (p *Page) TextRight(x, y float64, font Font, size float64, text string) {
+	p.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Line draws a straight line of the given width.
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(width), num(x1), num(y1), num(x2), num(y2))
+}
+
+// FillRect paints a rectangle in a shade of gray (0 black, 1 white) and restores black for what follows.
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f 0 g\n", num(gray), num(x), num(y), num(w), num(h))
+}
+
+// StrokeRect draws the outline of a rectangle.
+func (p *Page) StrokeRect(x, y, w, h, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s %s %s re S\n", num(width), num(x), num(y), num(w), num(h))
+}
+
+// Bytes serializes the document.
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	_, _ = d.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// WriteTo writes the objects, the cross-reference table and the trailer.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1 catálogo, 2 árbol de páginas, 3 y 4 fuentes, 5 información; luego página y contenido por página
+	const firstPage = 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (employee-management) >>", escape(d.title)))
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+// escape encodes text in WinAnsi and escapes the string delimiters. Characters outside Latin-1
+// cannot be shown with the standard fonts and are replaced by '?'.
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r < 0x20 || r > 0xff:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	return b.String()
+}
+
+func num(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,66 @@
+package pdf_test
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/pdf"
+)
+
+func sampleDocument() []byte {
+	doc := pdf.New("Boleta (2025-09)")
+	page := doc.AddPage()
+	page.FillRect(40, 780, 515, 20, 0.9)
+	page.Text(50, 786, pdf.Bold, 12, "BOLETA DE PAGO")
+	page.TextRight(545, 760, pdf.Regular, 10, "S/ 1,234.50")
+	page.Text(50, 740, pdf.Regular, 10, "Peña (Núñez) \\ 100%")
+	page.Line(40, 730, 555, 730, 0.5)
+	doc.AddPage()
+	return doc.Bytes()
+}
+
+func TestDocument_IsDeterministicWithValidCrossReferences(t *testing.T) {
+	content := sampleDocument()
+
+	assert.Equal(t, content, sampleDocument(), "same content, same bytes")
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(content, []byte("%%EOF\n")))
+	assert.Contains(t, string(content), "/Count 2")
+
+	// Cada entrada del xref apunta al inicio de su objeto
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(content)
+	require.NotNil(t, startxref)
+	xref, _ := strconv.Atoi(string(startxref[1]))
+	require.True(t, bytes.HasPrefix(content[xref:], []byte("xref\n")))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(content[xref:], -1)
+	require.Len(t, entries, 9)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		assert.True(t, bytes.HasPrefix(content[offset:], []byte(strconv.Itoa(i+1)+" 0 obj")), "object %d", i+1)
+	}
+}
+
+func TestPage_EscapesTextInWinAnsi(t *testing.T) {
+	content := sampleDocument()
+
+	assert.Contains(t, string(content), "(Pe\xf1a \\(N\xfa\xf1ez\\) \\\\ 100%) Tj")
+	assert.Contains(t, string(content), "/Title (Boleta \\(2025-09\\))")
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 5.56, pdf.TextWidth(pdf.Regular, 10, "1"), 0.001)
+	assert.InDelta(t, 6.11, pdf.TextWidth(pdf.Bold, 10, "b"), 0.001)
+}
+
+func TestWrapText_BreaksBetweenWords(t *testing.T) {
+	// "aaaa" is 22.24 points wide at size 10
+	lines := pdf.WrapText(pdf.Regular, 10, "aaaa aaaa  aaaa aaaaaaaaaaaa", 50)
+
+	assert.Equal(t, []string{"aaaa aaaa", "aaaa", "aaaaaaaaaaaa"}, lines)
+	assert.Empty(t, pdf.WrapText(pdf.Regular, 10, "   ", 50))
+}
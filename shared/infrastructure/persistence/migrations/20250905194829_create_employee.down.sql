@@ -1,2 +1,2 @@
--- Eliminar tabla EMPLOYEES
-DROP TABLE IF EXISTS employees;
+-- Sin cambios: la tabla employees se elimina desde contexts/employee.
+SELECT 1;
@@ -0,0 +1,6 @@
+ALTER TABLE natural_persons DROP CONSTRAINT IF EXISTS natural_persons_document_type_number_key;
+ALTER TABLE natural_persons ADD CONSTRAINT natural_persons_document_number_key UNIQUE (document_number);
+ALTER TABLE natural_persons
+    DROP COLUMN IF EXISTS work_permit_expiry,
+    DROP COLUMN IF EXISTS nationality,
+    DROP COLUMN IF EXISTS document_type;
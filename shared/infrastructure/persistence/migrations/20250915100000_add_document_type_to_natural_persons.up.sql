@@ -0,0 +1,11 @@
+-- 🔹 Tipos de documento para trabajadores extranjeros
+ALTER TABLE natural_persons
+    ADD COLUMN document_type VARCHAR(10) NOT NULL DEFAULT 'DNI'
+        CHECK (document_type IN ('DNI', 'CE', 'PASAPORTE', 'PTP', 'CPP')),
+    ADD COLUMN nationality CHAR(3) DEFAULT 'PER',   -- ISO 3166-1 alfa-3
+    ADD COLUMN work_permit_expiry DATE;             -- Vencimiento del permiso de trabajo
+
+-- 🔹 La unicidad ahora es por (tipo, número): un DNI y un pasaporte pueden compartir número
+ALTER TABLE natural_persons DROP CONSTRAINT natural_persons_document_number_key;
+ALTER TABLE natural_persons
+    ADD CONSTRAINT natural_persons_document_type_number_key UNIQUE (document_type, document_number);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS person_addresses;
+DROP TABLE IF EXISTS person_contacts;
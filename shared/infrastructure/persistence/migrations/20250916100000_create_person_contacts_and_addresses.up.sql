@@ -0,0 +1,25 @@
+-- 🔹 Tabla: CONTACTOS TIPADOS (correo personal/laboral, celular, contacto de emergencia)
+CREATE TABLE person_contacts (
+    contact_id UUID PRIMARY KEY,
+    person_id UUID NOT NULL REFERENCES persons(person_id) ON DELETE CASCADE,
+    contact_type VARCHAR(20) NOT NULL
+        CHECK (contact_type IN ('PERSONAL_EMAIL', 'WORK_EMAIL', 'MOBILE', 'EMERGENCY')),
+    value VARCHAR(100) NOT NULL,
+    contact_name VARCHAR(100),                  -- Solo contactos de emergencia
+    relationship VARCHAR(30),                   -- Parentesco del contacto de emergencia
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT now(),
+    UNIQUE (person_id, contact_type, value)
+);
+CREATE INDEX idx_person_contacts_person_id ON person_contacts(person_id);
+
+-- 🔹 Tabla: DOMICILIO ESTRUCTURADO con ubigeo INEI
+CREATE TABLE person_addresses (
+    person_id UUID PRIMARY KEY REFERENCES persons(person_id) ON DELETE CASCADE,
+    street VARCHAR(200) NOT NULL,
+    reference VARCHAR(200),
+    ubigeo CHAR(6) NOT NULL,                    -- DDPPDD
+    department_code CHAR(2) NOT NULL,
+    province_code CHAR(4) NOT NULL,
+    updated_at TIMESTAMP DEFAULT now()
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_natural_persons_last_name_paternal;
+DROP INDEX IF EXISTS idx_natural_persons_birth_date;
+DROP INDEX IF EXISTS idx_persons_phone;
+DROP TABLE IF EXISTS person_merges;
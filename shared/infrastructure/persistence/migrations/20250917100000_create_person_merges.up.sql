@@ -0,0 +1,18 @@
+-- 🔹 Tabla: AUDITORÍA DE FUSIONES de personas duplicadas
+-- Sin FK hacia persons: la persona fusionada se elimina y el registro debe conservarse
+CREATE TABLE person_merges (
+    merge_id UUID PRIMARY KEY,
+    survivor_person_id UUID NOT NULL,           -- Persona que se conserva
+    merged_person_id UUID NOT NULL,             -- Persona duplicada eliminada
+    reason VARCHAR(255),
+    employees_moved INTEGER NOT NULL DEFAULT 0,
+    merged_snapshot JSONB NOT NULL,             -- Datos de la persona eliminada al momento de la fusión
+    merged_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_person_merges_survivor ON person_merges(survivor_person_id);
+CREATE INDEX idx_person_merges_merged ON person_merges(merged_person_id);
+
+-- Índices de apoyo para la búsqueda de candidatos duplicados
+CREATE INDEX idx_persons_phone ON persons(phone);
+CREATE INDEX idx_natural_persons_birth_date ON natural_persons(birth_date);
+CREATE INDEX idx_natural_persons_last_name_paternal ON natural_persons(lower(last_name_paternal));
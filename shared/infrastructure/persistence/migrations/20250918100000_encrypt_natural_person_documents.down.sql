@@ -0,0 +1,7 @@
+-- Requiere que los documentos estén nuevamente en texto plano
+DROP INDEX IF EXISTS natural_persons_document_type_hash_key;
+ALTER TABLE natural_persons
+    DROP COLUMN IF EXISTS document_number_hash,
+    ALTER COLUMN document_number TYPE VARCHAR(20);
+ALTER TABLE natural_persons
+    ADD CONSTRAINT natural_persons_document_type_number_key UNIQUE (document_type, document_number);
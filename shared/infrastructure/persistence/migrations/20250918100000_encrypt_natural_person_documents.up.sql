@@ -0,0 +1,11 @@
+-- 🔹 Cifrado en reposo del número de documento
+-- document_number guarda el valor cifrado (sobre AES-GCM con versión de clave) y
+-- document_number_hash su índice ciego (HMAC-SHA256), usado para unicidad y búsquedas.
+-- Las filas existentes quedan en texto plano con hash NULL hasta ejecutar cmd/reencrypt.
+ALTER TABLE natural_persons
+    ALTER COLUMN document_number TYPE TEXT,
+    ADD COLUMN document_number_hash CHAR(64);
+
+ALTER TABLE natural_persons DROP CONSTRAINT natural_persons_document_type_number_key;
+CREATE UNIQUE INDEX natural_persons_document_type_hash_key
+    ON natural_persons(document_type, document_number_hash);
@@ -0,0 +1,14 @@
+-- 🔹 Tabla: IDEMPOTENCY KEYS de las operaciones POST
+-- Se escribe en la misma transacción que la operación: si esta falla, la clave no queda registrada.
+CREATE TABLE idempotency_keys (
+    scope VARCHAR(100) NOT NULL,                -- Operación protegida (ej: employee.register)
+    idempotency_key VARCHAR(255) NOT NULL,      -- Valor del header Idempotency-Key
+    user_id VARCHAR(255) NOT NULL,              -- Usuario autenticado (sub del token)
+    request_hash CHAR(64) NOT NULL,             -- SHA-256 del payload
+    response TEXT,                              -- Respuesta serializada y cifrada
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (scope, user_id, idempotency_key)
+);
+-- Permite purgar periódicamente las claves vencidas
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
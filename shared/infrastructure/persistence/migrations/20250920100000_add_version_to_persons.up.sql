@@ -0,0 +1,2 @@
+-- 🔹 Control de concurrencia optimista: cada actualización exige la versión leída (If-Match)
+ALTER TABLE persons ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,18 @@
+-- 🔹 Tabla: OUTBOX de eventos de integración (nómina, identidad)
+-- Se escribe en la misma transacción que el cambio que originó el evento: si la transacción se
+-- revierte, el evento no existe. El relay (cmd/outbox-relay) la lee y publica los pendientes.
+CREATE TABLE outbox (
+    message_id UUID PRIMARY KEY,
+    sequence BIGSERIAL NOT NULL,                -- Orden de escritura; created_at se repite dentro de una transacción
+    event_name VARCHAR(100) NOT NULL,           -- Tipo de evento (ej: employee.registered)
+    payload JSONB NOT NULL,                     -- Evento serializado, sin datos personales
+    occurred_at TIMESTAMP NOT NULL,             -- Momento en que ocurrió el hecho
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'PUBLISHED', 'DEAD')),
+    attempts INT NOT NULL DEFAULT 0,            -- Intentos de publicación fallidos
+    next_attempt_at TIMESTAMP NOT NULL,         -- No se reintenta antes de esta fecha
+    last_error TEXT,                            -- Último error del publicador
+    published_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+-- El relay solo recorre los pendientes, en orden de llegada
+CREATE INDEX idx_outbox_pending ON outbox(sequence) WHERE status = 'PENDING';
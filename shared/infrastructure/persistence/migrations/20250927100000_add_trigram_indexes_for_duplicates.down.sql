@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS idx_natural_persons_last_name_paternal ON natural_persons(lower(last_name_paternal));
+DROP INDEX IF EXISTS idx_juridical_persons_business_name_trgm;
+DROP INDEX IF EXISTS idx_natural_persons_full_name_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
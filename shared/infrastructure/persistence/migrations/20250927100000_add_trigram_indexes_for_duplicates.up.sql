@@ -0,0 +1,12 @@
+-- 🔹 Búsqueda aproximada de personas duplicadas con pg_trgm
+-- La preselección compara el nombre completo o la razón social por similitud de trigramas,
+-- así encuentra nombres mal digitados y no solo apellidos idénticos
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_natural_persons_full_name_trgm ON natural_persons
+    USING gin (lower(first_name || ' ' || last_name_paternal || ' ' || coalesce(last_name_maternal, '')) gin_trgm_ops);
+CREATE INDEX idx_juridical_persons_business_name_trgm ON juridical_persons
+    USING gin (lower(business_name) gin_trgm_ops);
+
+-- Reemplazado por el índice de trigramas sobre el nombre completo
+DROP INDEX IF EXISTS idx_natural_persons_last_name_paternal;
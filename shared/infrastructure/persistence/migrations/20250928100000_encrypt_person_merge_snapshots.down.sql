@@ -0,0 +1,3 @@
+-- Requiere que las fotos estén nuevamente en texto plano
+ALTER TABLE person_merges
+    ALTER COLUMN merged_snapshot TYPE JSONB USING merged_snapshot::jsonb;
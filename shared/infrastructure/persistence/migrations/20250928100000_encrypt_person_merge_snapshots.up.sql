@@ -0,0 +1,6 @@
+-- 🔹 Cifrado en reposo de la foto de la persona fusionada
+-- merged_snapshot incluye el documento, la fecha de nacimiento, el correo y los contactos de la
+-- persona eliminada, así que se guarda cifrado como texto. Las filas existentes quedan en texto
+-- plano (JSON) hasta ejecutar cmd/reencrypt.
+ALTER TABLE person_merges
+    ALTER COLUMN merged_snapshot TYPE TEXT USING merged_snapshot::text;
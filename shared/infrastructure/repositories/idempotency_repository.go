@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kevinsoras/employee-management/shared/domain/datasource"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+type IdempotencyRepositoryImpl struct {
+	dataSource datasource.IdempotencyDataSource
+}
+
+func NewIdempotencyRepositoryImpl(ds datasource.IdempotencyDataSource) repositories.IdempotencyRepository {
+	return &IdempotencyRepositoryImpl{dataSource: ds}
+}
+
+func (r *IdempotencyRepositoryImpl) Reserve(ctx context.Context, record *entities.IdempotencyRecord) (*entities.IdempotencyRecord, error) {
+	return r.dataSource.Reserve(ctx, record)
+}
+
+func (r *IdempotencyRepositoryImpl) SaveResponse(ctx context.Context, record *entities.IdempotencyRecord) error {
+	return r.dataSource.SaveResponse(ctx, record)
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/kevinsoras/employee-management/shared/domain/datasource"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
+	"github.com/kevinsoras/employee-management/shared/domain/repositories"
+)
+
+type OutboxRepositoryImpl struct {
+	dataSource datasource.OutboxDataSource
+}
+
+func NewOutboxRepositoryImpl(ds datasource.OutboxDataSource) repositories.OutboxRepository {
+	return &OutboxRepositoryImpl{dataSource: ds}
+}
+
+func (r *OutboxRepositoryImpl) Append(ctx context.Context, messages ...*entities.OutboxMessage) error {
+	return r.dataSource.Append(ctx, messages...)
+}
+
+func (r *OutboxRepositoryImpl) ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*entities.OutboxMessage, error) {
+	return r.dataSource.ClaimPending(ctx, limit, now, leaseUntil)
+}
+
+func (r *OutboxRepositoryImpl) SaveAttempt(ctx context.Context, message *entities.OutboxMessage) error {
+	return r.dataSource.SaveAttempt(ctx, message)
+}
+
+func (r *OutboxRepositoryImpl) RequeueDead(ctx context.Context, now time.Time) (int, error) {
+	return r.dataSource.RequeueDead(ctx, now)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kevinsoras/employee-management/shared/domain/aggregates"
 	"github.com/kevinsoras/employee-management/shared/domain/datasource"
+	"github.com/kevinsoras/employee-management/shared/domain/entities"
 	"github.com/kevinsoras/employee-management/shared/domain/repositories"
 )
 
@@ -20,6 +21,26 @@ func (r *PersonRepositoryImpl) SavePerson(ctx context.Context, person *aggregate
 	return r.dataSource.SavePerson(ctx, person)
 }
 
+func (r *PersonRepositoryImpl) UpdatePerson(ctx context.Context, person *aggregates.PersonAggregate) error {
+	return r.dataSource.UpdatePerson(ctx, person)
+}
+
 func (r *PersonRepositoryImpl) GetPersonByID(ctx context.Context, id string) (*aggregates.PersonAggregate, error) {
 	return r.dataSource.GetPersonByID(ctx, id)
 }
+
+func (r *PersonRepositoryImpl) GetPersonByDocument(ctx context.Context, documentType, documentNumber string) (*aggregates.PersonAggregate, error) {
+	return r.dataSource.GetPersonByDocument(ctx, documentType, documentNumber)
+}
+
+func (r *PersonRepositoryImpl) FindDuplicateCandidates(ctx context.Context, person *aggregates.PersonAggregate) ([]*aggregates.PersonAggregate, error) {
+	return r.dataSource.FindDuplicateCandidates(ctx, person)
+}
+
+func (r *PersonRepositoryImpl) DeletePerson(ctx context.Context, id string) error {
+	return r.dataSource.DeletePerson(ctx, id)
+}
+
+func (r *PersonRepositoryImpl) SavePersonMerge(ctx context.Context, merge *entities.PersonMerge) error {
+	return r.dataSource.SavePersonMerge(ctx, merge)
+}
@@ -0,0 +1,35 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+)
+
+// utf8BOM is written by Excel at the start of "CSV UTF-8" files.
+var utf8BOM = []byte("\xEF\xBB\xBF")
+
+// ReadCSV parses a CSV file. The delimiter is detected from the header: Excel with a Spanish
+// locale exports with ';' instead of ','.
+func ReadCSV(content []byte) ([][]string, error) {
+	content = bytes.TrimPrefix(content, utf8BOM)
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = detectDelimiter(content)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("spreadsheet: invalid CSV: %w", err)
+	}
+	return rows, nil
+}
+
+func detectDelimiter(content []byte) rune {
+	header, _, _ := bytes.Cut(content, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
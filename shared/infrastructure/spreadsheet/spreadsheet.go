@@ -0,0 +1,73 @@
+// Package spreadsheet reads the first sheet of CSV and XLSX files as rows of text cells,
+// so the bulk imports can treat both formats the same way.
+package spreadsheet
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+// Format identifies the file type of an upload.
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+// ErrUnsupportedFormat is returned for files that are neither CSV nor XLSX.
+var ErrUnsupportedFormat = errors.New("spreadsheet: unsupported file format")
+
+// zipMagic starts every XLSX file (an OOXML package is a zip archive).
+var zipMagic = []byte("PK\x03\x04")
+
+// DetectFormat decides the format from the file name, falling back to the content:
+// a zip archive is read as XLSX and anything else as CSV.
+func DetectFormat(filename string, content []byte) (Format, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv", ".txt":
+		return CSV, nil
+	case ".xlsx":
+		return XLSX, nil
+	case ".xls", ".ods":
+		return "", ErrUnsupportedFormat
+	}
+	if bytes.HasPrefix(content, zipMagic) {
+		return XLSX, nil
+	}
+	return CSV, nil
+}
+
+// Read returns the rows of the file, the header included. Trailing empty rows are dropped.
+func Read(filename string, content []byte) ([][]string, error) {
+	format, err := DetectFormat(filename, content)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	switch format {
+	case XLSX:
+		rows, err = ReadXLSX(content)
+	default:
+		rows, err = ReadCSV(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for len(rows) > 0 && IsBlank(rows[len(rows)-1]) {
+		rows = rows[:len(rows)-1]
+	}
+	return rows, nil
+}
+
+// IsBlank reports whether every cell of the row is empty.
+func IsBlank(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package spreadsheet_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+)
+
+// buildXLSX writes the minimum parts of a workbook: one sheet with shared, inline and numeric cells.
+func buildXLSX(t *testing.T) []byte {
+	t.Helper()
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Empleados" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>documentNumber</t></si><si><t>salary</t></si><si><r><t>Pérez </t></r><r><t>Gómez</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>lastName</t></is></c></row>
+<row r="3"><c r="A3" t="inlineStr"><is><t>12345678</t></is></c><c r="C3" t="s"><v>2</v></c><c r="D3" t="b"><v>1</v></c></row>
+<row r="4"><c r="B4"><v>1500.5</v></c></row>
+</sheetData></worksheet>`,
+	}
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	return buf.Bytes()
+}
+
+func TestRead_XLSX(t *testing.T) {
+	rows, err := spreadsheet.Read("empleados.xlsx", buildXLSX(t))
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"documentNumber", "salary", "lastName"},
+		nil, // row 2 is missing in the sheet
+		{"12345678", "", "Pérez Gómez", "TRUE"},
+		{"", "1500.5"},
+	}, rows)
+}
+
+func TestRead_XLSXDetectedByContent(t *testing.T) {
+	rows, err := spreadsheet.Read("upload", buildXLSX(t))
+
+	require.NoError(t, err)
+	assert.Equal(t, "documentNumber", rows[0][0])
+}
+
+func TestRead_CSVWithBOMAndSemicolons(t *testing.T) {
+	content := "\xEF\xBB\xBFdocumentNumber;salary\n12345678;1500,50\n;\n"
+
+	rows, err := spreadsheet.Read("empleados.csv", []byte(content))
+
+	require.NoError(t, err)
+	// The trailing blank row is dropped
+	assert.Equal(t, [][]string{{"documentNumber", "salary"}, {"12345678", "1500,50"}}, rows)
+}
+
+func TestRead_UnsupportedFormat(t *testing.T) {
+	_, err := spreadsheet.Read("empleados.xls", []byte{0xD0, 0xCF})
+
+	assert.ErrorIs(t, err, spreadsheet.ErrUnsupportedFormat)
+}
+
+func TestRead_InvalidXLSX(t *testing.T) {
+	_, err := spreadsheet.Read("empleados.xlsx", []byte("not a zip"))
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,296 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JSONL is JSON Lines: one JSON object per row, keyed by the header.
+const JSONL Format = "jsonl"
+
+// ParseFormat validates an export format given by the client ("csv", "xlsx" or "jsonl").
+func ParseFormat(value string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(value))); format {
+	case CSV, XLSX, JSONL:
+		return format, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// ContentType is the media type of the files written in the format.
+func (f Format) ContentType() string {
+	switch f {
+	case XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case JSONL:
+		return "application/x-ndjson"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Writer writes a header and then one row at a time, so a file can be streamed without
+// building it in memory. Values may be nil, string, bool, int, float64 or time.Time;
+// a time.Time without clock is written as a date (2006-01-02). An XLSX written with an empty
+// header starts with the first row, for upload layouts that have no header.
+type Writer interface {
+	WriteHeader(columns []string) error
+	WriteRow(values []any) error
+	// Close completes the file; for XLSX it writes the end of the sheet and the zip directory.
+	Close() error
+}
+
+// NewWriter creates the writer of the format on top of w.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case CSV:
+		return &csvWriter{w: w, csv: csv.NewWriter(w)}, nil
+	case JSONL:
+		return &jsonlWriter{w: bufio.NewWriter(w)}, nil
+	case XLSX:
+		return &xlsxWriter{zip: zip.NewWriter(w)}, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+func formatText(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		if v.Equal(v.Truncate(24 * time.Hour)) {
+			return v.Format("2006-01-02")
+		}
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// --- CSV ---
+
+type csvWriter struct {
+	w   io.Writer
+	csv *csv.Writer
+}
+
+func (cw *csvWriter) WriteHeader(columns []string) error {
+	// The BOM makes Excel open the file as UTF-8
+	if _, err := io.WriteString(cw.w, string(utf8BOM)); err != nil {
+		return err
+	}
+	return cw.csv.Write(columns)
+}
+
+func (cw *csvWriter) WriteRow(values []any) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = formatText(value)
+		if _, isText := value.(string); isText {
+			record[i] = escapeFormula(record[i])
+		}
+	}
+	return cw.csv.Write(record)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.csv.Flush()
+	return cw.csv.Error()
+}
+
+// escapeFormula prefixes with ' the texts a spreadsheet would run as a formula (CSV injection).
+// "+51 987..." and "-5" are still written as they are: a sign followed by a digit is a number.
+func escapeFormula(value string) string {
+	if value == "" {
+		return value
+	}
+	switch value[0] {
+	case '=', '@', '\t', '\r':
+		return "'" + value
+	case '+', '-':
+		if len(value) == 1 || !strings.ContainsRune("0123456789 ", rune(value[1])) {
+			return "'" + value
+		}
+	}
+	return value
+}
+
+// --- JSON Lines ---
+
+type jsonlWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (jw *jsonlWriter) WriteHeader(columns []string) error {
+	jw.columns = columns
+	return nil
+}
+
+// WriteRow keeps the order of the columns, which a map would lose.
+func (jw *jsonlWriter) WriteRow(values []any) error {
+	jw.w.WriteByte('{')
+	for i, column := range jw.columns {
+		if i > 0 {
+			jw.w.WriteByte(',')
+		}
+		key, _ := json.Marshal(column)
+		jw.w.Write(key)
+		jw.w.WriteByte(':')
+
+		var value any
+		if i < len(values) {
+			value = values[i]
+		}
+		if t, ok := value.(time.Time); ok {
+			value = formatText(t)
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		jw.w.Write(encoded)
+	}
+	jw.w.WriteString("}\n")
+	// Each row reaches the client as soon as the buffer fills, the stream never grows with the export
+	if jw.w.Buffered() >= 32<<10 {
+		return jw.w.Flush()
+	}
+	return nil
+}
+
+func (jw *jsonlWriter) Close() error {
+	return jw.w.Flush()
+}
+
+// --- XLSX ---
+
+// The static parts of a workbook with a single sheet. Strings are written inline, so no
+// sharedStrings part is needed and each row can be written as soon as it arrives.
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+type xlsxWriter struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+func (xw *xlsxWriter) WriteHeader(columns []string) error {
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbookXML},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	} {
+		w, err := xw.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, part.content); err != nil {
+			return err
+		}
+	}
+
+	// The sheet is the last entry: it stays open while the rows are streamed
+	w, err := xw.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	xw.sheet = bufio.NewWriter(w)
+	xw.sheet.WriteString(xlsxSheetStart)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	header := make([]any, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+	return xw.WriteRow(header)
+}
+
+func (xw *xlsxWriter) WriteRow(values []any) error {
+	xw.rows++
+	fmt.Fprintf(xw.sheet, `<row r="%d">`, xw.rows)
+	for i, value := range values {
+		ref := columnName(i) + strconv.Itoa(xw.rows)
+		switch v := value.(type) {
+		case nil:
+			continue
+		case int, float64:
+			fmt.Fprintf(xw.sheet, `<c r="%s"><v>%s</v></c>`, ref, formatText(v))
+		case bool:
+			flag := "0"
+			if v {
+				flag = "1"
+			}
+			fmt.Fprintf(xw.sheet, `<c r="%s" t="b"><v>%s</v></c>`, ref, flag)
+		default:
+			text := formatText(v)
+			if text == "" {
+				continue
+			}
+			fmt.Fprintf(xw.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(xw.sheet, []byte(text)); err != nil {
+				return err
+			}
+			xw.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := xw.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (xw *xlsxWriter) Close() error {
+	if xw.sheet == nil {
+		if err := xw.WriteHeader(nil); err != nil {
+			return err
+		}
+	}
+	xw.sheet.WriteString(xlsxSheetEnd)
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+	return xw.zip.Close()
+}
+
+// columnName converts a 0-based column index to its letters: 0 is "A", 27 is "AB".
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
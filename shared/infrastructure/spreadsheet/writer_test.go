@@ -0,0 +1,72 @@
+package spreadsheet_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/spreadsheet"
+)
+
+var (
+	writerHeader = []string{"documentNumber", "salary", "startDate", "hasCTS", "note"}
+	writerRow    = []any{"12345678", 1500.5, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), true, nil}
+)
+
+func writeAll(t *testing.T, format spreadsheet.Format, rows ...[]any) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := spreadsheet.NewWriter(format, &buf)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteHeader(writerHeader))
+	for _, row := range rows {
+		require.NoError(t, w.WriteRow(row))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestWriter_XLSXCanBeReadBack(t *testing.T) {
+	content := writeAll(t, spreadsheet.XLSX, writerRow, []any{"AT&T <S.A.>", 0, nil, false, "x"})
+
+	rows, err := spreadsheet.ReadXLSX(content)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		writerHeader,
+		{"12345678", "1500.5", "2024-01-31", "TRUE"},
+		{"AT&T <S.A.>", "0", "", "FALSE", "x"},
+	}, rows)
+}
+
+func TestWriter_CSV(t *testing.T) {
+	content := writeAll(t, spreadsheet.CSV, writerRow, []any{"=HYPERLINK(\"x\")", -5.0, nil, false, "+51 987654321"})
+
+	rows, err := spreadsheet.ReadCSV(content)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"12345678", "1500.5", "2024-01-31", "true", ""}, rows[1])
+	// Formulas are neutralized, numbers and phones are not
+	assert.Equal(t, []string{`'=HYPERLINK("x")`, "-5", "", "false", "+51 987654321"}, rows[2])
+}
+
+func TestWriter_JSONLKeepsColumnOrder(t *testing.T) {
+	content := writeAll(t, spreadsheet.JSONL, writerRow)
+
+	assert.Equal(t,
+		`{"documentNumber":"12345678","salary":1500.5,"startDate":"2024-01-31","hasCTS":true,"note":null}`,
+		strings.TrimSpace(string(content)))
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := spreadsheet.ParseFormat("XLSX")
+	require.NoError(t, err)
+	assert.Equal(t, spreadsheet.XLSX, format)
+
+	_, err = spreadsheet.ParseFormat("pdf")
+	assert.ErrorIs(t, err, spreadsheet.ErrUnsupportedFormat)
+}
@@ -0,0 +1,211 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// maxXLSXPartSize bounds each decompressed XML part, so a zip bomb cannot exhaust the memory.
+const maxXLSXPartSize = 64 << 20
+
+// ReadXLSX returns the cells of the first worksheet as text. Shared strings, inline strings,
+// numbers and booleans are supported; dates come as Excel serial numbers, as stored in the file.
+func ReadXLSX(content []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("spreadsheet: invalid XLSX: %w", err)
+	}
+	parts := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		parts[file.Name] = file
+	}
+
+	sheetPath, err := firstSheetPath(parts)
+	if err != nil {
+		return nil, err
+	}
+	sharedStrings, err := readSharedStrings(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheet xlsxWorksheet
+	if err := decodePart(parts, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		// Rows without data may be omitted by the writer; keep the line numbers as in Excel
+		for row.Index > len(rows)+1 {
+			rows = append(rows, nil)
+		}
+		var cells []string
+		for i, cell := range row.Cells {
+			column := i
+			if cell.Ref != "" {
+				if column, err = columnIndex(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(cells) < column {
+				cells = append(cells, "")
+			}
+			value, err := cell.text(sharedStrings)
+			if err != nil {
+				return nil, err
+			}
+			cells = append(cells, value)
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+// xlsxRichText is a plain <t> or the <r><t> runs of formatted text.
+type xlsxRichText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Index int        `xml:"r,attr"`
+		Cells []xlsxCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type xlsxCell struct {
+	Ref    string       `xml:"r,attr"`
+	Type   string       `xml:"t,attr"`
+	Value  string       `xml:"v"`
+	Inline xlsxRichText `xml:"is"`
+}
+
+func (c xlsxCell) text(sharedStrings []string) (string, error) {
+	switch c.Type {
+	case "s":
+		index, err := strconv.Atoi(c.Value)
+		if err != nil || index < 0 || index >= len(sharedStrings) {
+			return "", fmt.Errorf("spreadsheet: invalid shared string in cell %s", c.Ref)
+		}
+		return sharedStrings[index], nil
+	case "inlineStr":
+		return c.Inline.String(), nil
+	case "b":
+		if c.Value == "1" {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	default:
+		return c.Value, nil
+	}
+}
+
+func firstSheetPath(parts map[string]*zip.File) (string, error) {
+	var workbook xlsxWorkbook
+	if err := decodePart(parts, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("spreadsheet: the workbook has no sheets")
+	}
+	var rels xlsxRelationships
+	if err := decodePart(parts, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("spreadsheet: the first sheet has no relationship")
+}
+
+func readSharedStrings(parts map[string]*zip.File) ([]string, error) {
+	if _, ok := parts["xl/sharedStrings.xml"]; !ok {
+		return nil, nil
+	}
+	var sst xlsxSharedStrings
+	if err := decodePart(parts, "xl/sharedStrings.xml", &sst); err != nil {
+		return nil, err
+	}
+	values := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		values[i] = item.String()
+	}
+	return values, nil
+}
+
+func decodePart(parts map[string]*zip.File, name string, dst any) error {
+	file, ok := parts[name]
+	if !ok {
+		return fmt.Errorf("spreadsheet: invalid XLSX: missing %s", name)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("spreadsheet: invalid XLSX: %w", err)
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(io.LimitReader(rc, maxXLSXPartSize)).Decode(dst); err != nil {
+		return fmt.Errorf("spreadsheet: invalid XLSX part %s: %w", name, err)
+	}
+	return nil
+}
+
+// columnIndex converts the letters of a cell reference ("C7", "AB12") to a 0-based column.
+func columnIndex(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		column = column*26 + int(r-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return 0, fmt.Errorf("spreadsheet: invalid cell reference %q", ref)
+	}
+	return column - 1, nil
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+)
+
+// EncryptedBlobStore seals each blob with the field encrypter before handing it to the wrapped
+// store, so neither the disk nor the bucket holds plaintext documents. Blobs written with an old
+// key version stay readable while that version is configured.
+type EncryptedBlobStore struct {
+	next      services.BlobStore
+	encrypter *crypto.FieldEncrypter
+	purpose   string
+}
+
+func NewEncryptedBlobStore(next services.BlobStore, encrypter *crypto.FieldEncrypter, purpose string) *EncryptedBlobStore {
+	return &EncryptedBlobStore{next: next, encrypter: encrypter, purpose: purpose}
+}
+
+func (s *EncryptedBlobStore) Put(ctx context.Context, key string, content []byte, _ string) error {
+	sealed, err := s.encrypter.Encrypt(string(content), s.purpose)
+	if err != nil {
+		return fmt.Errorf("error encrypting blob %s: %w", key, err)
+	}
+	// The sealed blob is opaque: its content type no longer describes it
+	return s.next.Put(ctx, key, []byte(sealed), "application/octet-stream")
+}
+
+func (s *EncryptedBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	sealed, err := s.next.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.encrypter.Decrypt(string(sealed), s.purpose)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting blob %s: %w", key, err)
+	}
+	return []byte(content), nil
+}
+
+func (s *EncryptedBlobStore) Delete(ctx context.Context, key string) error {
+	return s.next.Delete(ctx, key)
+}
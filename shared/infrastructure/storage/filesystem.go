@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+)
+
+// FileSystemBlobStore keeps each blob as a file under a root directory, using the key as its
+// relative path. It suits a single instance or a shared volume; the content type is not kept.
+type FileSystemBlobStore struct {
+	root string
+}
+
+// NewFileSystemBlobStore creates the root directory if it does not exist.
+func NewFileSystemBlobStore(root string) (*FileSystemBlobStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating storage directory %s: %w", root, err)
+	}
+	return &FileSystemBlobStore{root: root}, nil
+}
+
+// Put writes the content to a temporary file and renames it, so a reader never sees a partial blob.
+func (s *FileSystemBlobStore) Put(_ context.Context, key string, content []byte, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo guardar el archivo", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo guardar el archivo", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return infrastructure.NewExternalServiceError("No se pudo guardar el archivo", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo guardar el archivo", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo guardar el archivo", err)
+	}
+	return nil
+}
+
+func (s *FileSystemBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, services.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, infrastructure.NewExternalServiceError("No se pudo leer el archivo", err)
+	}
+	return content, nil
+}
+
+// Delete succeeds when the blob does not exist.
+func (s *FileSystemBlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return infrastructure.NewExternalServiceError("No se pudo eliminar el archivo", err)
+	}
+	return nil
+}
+
+func (s *FileSystemBlobStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
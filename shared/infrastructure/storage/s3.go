@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+)
+
+// S3Config configures an S3-compatible bucket.
+type S3Config struct {
+	Endpoint        string // e.g. https://s3.us-east-1.amazonaws.com or http://localhost:9000 (MinIO)
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	ForcePathStyle  bool // bucket in the path instead of the host; MinIO needs it
+	Timeout         time.Duration
+	MaxRetries      int // attempts per request; zero keeps the client default
+}
+
+// S3BlobStore stores blobs as objects of a bucket through the minio-go client, which signs the
+// requests, retries transient failures and works with AWS S3 and compatible services.
+type S3BlobStore struct {
+	client  *minio.Client
+	bucket  string
+	timeout time.Duration
+}
+
+func NewS3BlobStore(config S3Config) (*S3BlobStore, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", config.Endpoint)
+	}
+	if endpoint.Path != "" && endpoint.Path != "/" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: the endpoint cannot have a path", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket is required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 30 * time.Second
+	}
+	lookup := minio.BucketLookupDNS
+	if config.ForcePathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(config.AccessKeyID, config.SecretAccessKey, ""),
+		Secure:       endpoint.Scheme == "https",
+		Region:       config.Region,
+		BucketLookup: lookup,
+		MaxRetries:   config.MaxRetries,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating S3 client: %w", err)
+	}
+	return &S3BlobStore{client: client, bucket: config.Bucket, timeout: config.Timeout}, nil
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(content), int64(len(content)),
+		minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return infrastructure.NewExternalServiceError("No se pudo guardar el archivo", err)
+	}
+	return nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, infrastructure.NewExternalServiceError("No se pudo leer el archivo", err)
+	}
+	defer object.Close()
+	content, err := io.ReadAll(object)
+	if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+		return nil, services.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, infrastructure.NewExternalServiceError("No se pudo leer el archivo", err)
+	}
+	return content, nil
+}
+
+// Delete succeeds when the object does not exist, as S3 itself does.
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).Code != minio.NoSuchKey {
+		return infrastructure.NewExternalServiceError("No se pudo eliminar el archivo", err)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// Package storage implements the BlobStore port on the local filesystem and on S3-compatible
+// object storage (AWS S3, MinIO, Cloudflare R2...).
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateKey rejects keys that could escape the store root or address a different object than
+// intended: empty segments, "." and ".." segments and backslashes.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+	return nil
+}
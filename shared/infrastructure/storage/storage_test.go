@@ -0,0 +1,212 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/domain/services"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/storage"
+)
+
+// assertRoundTrip exercises the BlobStore contract every implementation must honour
+func assertRoundTrip(t *testing.T, store services.BlobStore) {
+	t.Helper()
+	ctx := context.Background()
+	key := "employees/emp-1/doc-" + strings.ReplaceAll(t.Name(), "/", "-")
+
+	_, err := store.Get(ctx, key)
+	require.ErrorIs(t, err, services.ErrBlobNotFound)
+
+	require.NoError(t, store.Put(ctx, key, []byte("%PDF-1.4 primera"), "application/pdf"))
+	require.NoError(t, store.Put(ctx, key, []byte("%PDF-1.4 segunda"), "application/pdf"))
+	content, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4 segunda", string(content))
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	require.ErrorIs(t, err, services.ErrBlobNotFound)
+	require.NoError(t, store.Delete(ctx, key), "deleting a missing blob succeeds")
+}
+
+func TestFileSystemBlobStore_RoundTrip(t *testing.T) {
+	root := t.TempDir()
+	store, err := storage.NewFileSystemBlobStore(root)
+	require.NoError(t, err)
+
+	assertRoundTrip(t, store)
+
+	require.NoError(t, store.Put(context.Background(), "employees/emp-1/doc-1", []byte("x"), ""))
+	entries, err := os.ReadDir(filepath.Join(root, "employees", "emp-1"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "no temporary file is left behind")
+	assert.Equal(t, "doc-1", entries[0].Name())
+}
+
+func TestFileSystemBlobStore_RejectsKeysOutsideTheRoot(t *testing.T) {
+	store, err := storage.NewFileSystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../secret", "employees/../../secret", "/etc/passwd", "a//b", `a\..\b`} {
+		assert.Error(t, store.Put(context.Background(), key, []byte("x"), ""), key)
+		_, err := store.Get(context.Background(), key)
+		assert.Error(t, err, key)
+	}
+}
+
+// Example "GET Object" of the Signature Version 4 documentation for S3
+// fakeS3 answers the object requests of a path-style bucket from memory
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+	failing bool
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	if f.failing {
+		http.Error(w, "<Error><Code>InternalError</Code></Error>", http.StatusInternalServerError)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/documents/")
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			body = decodeAWSChunked(body)
+		}
+		f.objects[key] = body
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Type", f.types[key])
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// decodeAWSChunked strips the chunk headers ("<hex size>;chunk-signature=...\r\n") of a
+// streaming-signed upload, as S3 does, without checking the signatures
+func decodeAWSChunked(body []byte) []byte {
+	var content []byte
+	for len(body) > 0 {
+		header, rest, _ := bytes.Cut(body, []byte("\r\n"))
+		sizeHex, _, _ := bytes.Cut(header, []byte(";"))
+		size, err := strconv.ParseInt(string(sizeHex), 16, 64)
+		if err != nil || size == 0 || int64(len(rest)) < size {
+			break
+		}
+		content = append(content, rest[:size]...)
+		body = bytes.TrimPrefix(rest[size:], []byte("\r\n"))
+	}
+	return content
+}
+
+func newS3Store(t *testing.T, endpoint string) *storage.S3BlobStore {
+	t.Helper()
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint: endpoint, Bucket: "documents", AccessKeyID: "minio", SecretAccessKey: "minio-secret", ForcePathStyle: true, MaxRetries: 1,
+	})
+	require.NoError(t, err)
+	return store
+}
+
+func TestS3BlobStore_RoundTrip(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	store := newS3Store(t, server.URL)
+
+	assertRoundTrip(t, store)
+
+	require.NoError(t, store.Put(context.Background(), "employees/emp-1/doc-1", []byte("\x89PNG"), "image/png"))
+	assert.Equal(t, "image/png", fake.types["employees/emp-1/doc-1"])
+}
+
+func TestS3BlobStore_ServiceErrors(t *testing.T) {
+	server := httptest.NewServer(&fakeS3{objects: map[string][]byte{}, types: map[string]string{}, failing: true})
+	defer server.Close()
+	store := newS3Store(t, server.URL)
+
+	err := store.Put(context.Background(), "employees/emp-1/doc-1", []byte("x"), "")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, services.ErrBlobNotFound)
+	var s3Err minio.ErrorResponse
+	require.ErrorAs(t, err, &s3Err)
+	assert.Equal(t, "InternalError", s3Err.Code)
+}
+
+func TestNewS3BlobStore_InvalidConfig(t *testing.T) {
+	_, err := storage.NewS3BlobStore(storage.S3Config{Endpoint: "localhost:9000", Bucket: "documents"})
+	assert.Error(t, err)
+	_, err = storage.NewS3BlobStore(storage.S3Config{Endpoint: "http://localhost:9000"})
+	assert.Error(t, err)
+}
+
+// TestS3BlobStore_MinIO runs the contract against a real S3-compatible server, e.g.
+//
+//	docker run -p 9000:9000 minio/minio server /data
+//
+// with S3_TEST_ENDPOINT=http://localhost:9000 and an existing bucket S3_TEST_BUCKET.
+func TestS3BlobStore_MinIO(t *testing.T) {
+	endpoint := os.Getenv("S3_TEST_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("S3_TEST_ENDPOINT not set")
+	}
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        endpoint,
+		Region:          os.Getenv("S3_TEST_REGION"),
+		Bucket:          os.Getenv("S3_TEST_BUCKET"),
+		AccessKeyID:     os.Getenv("S3_TEST_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("S3_TEST_SECRET_ACCESS_KEY"),
+		ForcePathStyle:  true,
+	})
+	require.NoError(t, err)
+
+	assertRoundTrip(t, store)
+}
+
+func TestEncryptedBlobStore_SealsTheContent(t *testing.T) {
+	inner, err := storage.NewFileSystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	encrypter, err := crypto.NewFieldEncrypter(crypto.Config{Keys: "1:" + key, ActiveVersion: 1, BlindIndexKey: key})
+	require.NoError(t, err)
+	store := storage.NewEncryptedBlobStore(inner, encrypter, crypto.PurposeEmployeeDocumentContent)
+
+	assertRoundTrip(t, store)
+
+	require.NoError(t, store.Put(context.Background(), "employees/emp-1/doc-1", []byte("DNI 12345678"), "application/pdf"))
+	raw, err := inner.Get(context.Background(), "employees/emp-1/doc-1")
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "12345678")
+}
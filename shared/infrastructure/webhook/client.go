@@ -0,0 +1,152 @@
+// Package webhook POSTs JSON bodies to HTTP endpoints on behalf of the outbox webhook publisher and
+// the webhook subscriptions. The endpoints of the subscriptions are chosen by users, so by default
+// the client only speaks https and refuses to connect to loopback, private or link-local addresses.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// maxDrainedBody caps how much of a response is read to reuse the connection; it is never kept.
+const maxDrainedBody = 4096
+
+// Config configures the HTTP client.
+type Config struct {
+	Timeout time.Duration
+	// AllowHTTP accepts plain http:// endpoints; otherwise only https:// ones are called
+	AllowHTTP bool
+	// AllowPrivateNetworks lets the client reach loopback, private and link-local addresses. The
+	// check runs when dialing, after DNS resolution, so a host name that resolves, or later
+	// rebinds, to an internal address is refused as well
+	AllowPrivateNetworks bool
+}
+
+// Errors returned instead of the network error: their text is safe to show to whoever registered
+// the endpoint, since it tells nothing about the internal network.
+var (
+	ErrURLNotAllowed     = errors.New("the URL must be https and point to a public address")
+	ErrAddressNotAllowed = errors.New("destination address not allowed")
+	ErrTimeout           = errors.New("destination did not answer in time")
+	ErrUnreachable       = errors.New("destination unreachable")
+)
+
+// StatusError reports a response outside 2xx. Only the status code is kept, never the body.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status %d", e.StatusCode)
+}
+
+// Client sends one POST per call. Redirects are not followed: they would send the body somewhere
+// the endpoint owner did not register, so a 3xx is reported like any other rejection.
+type Client struct {
+	http   *http.Client
+	config Config
+}
+
+func NewClient(config Config) *Client {
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !config.AllowPrivateNetworks {
+		dialer.Control = refusePrivateAddresses
+		// A proxy would dial the destination itself, out of reach of the check
+		transport.Proxy = nil
+	}
+	transport.DialContext = dialer.DialContext
+	return &Client{
+		http: &http.Client{
+			Timeout:       config.Timeout,
+			Transport:     transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		config: config,
+	}
+}
+
+// CheckURL validates an endpoint before it is stored: the scheme, the host and, when the host is
+// an IP literal, the address. Host names are checked again on every connection.
+func (c *Client) CheckURL(raw string) error {
+	endpoint, err := url.Parse(raw)
+	if err != nil || endpoint.Host == "" || endpoint.User != nil {
+		return ErrURLNotAllowed
+	}
+	if endpoint.Scheme != "https" && (endpoint.Scheme != "http" || !c.config.AllowHTTP) {
+		return ErrURLNotAllowed
+	}
+	if addr, err := netip.ParseAddr(endpoint.Hostname()); err == nil && !c.config.AllowPrivateNetworks && !isPublic(addr) {
+		return ErrURLNotAllowed
+	}
+	return nil
+}
+
+// Post sends body as JSON with the given headers and returns the response status (0 if there was
+// none). Any status outside 2xx is a *StatusError; failures to connect are one of the errors above.
+func (c *Client) Post(ctx context.Context, endpoint string, body []byte, header http.Header) (int, error) {
+	if err := c.CheckURL(endpoint); err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, ErrURLNotAllowed
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		var netErr net.Error
+		switch {
+		case errors.Is(err, ErrAddressNotAllowed):
+			return 0, ErrAddressNotAllowed
+		case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+			return 0, ErrTimeout
+		default:
+			return 0, ErrUnreachable
+		}
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedBody))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode}
+	}
+	return resp.StatusCode, nil
+}
+
+// refusePrivateAddresses runs after DNS resolution, right before each connection is opened.
+func refusePrivateAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrAddressNotAllowed
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !isPublic(addr) {
+		return ErrAddressNotAllowed
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), internal like the private ones.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
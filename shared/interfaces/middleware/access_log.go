@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// AccessLog logs one line per request with its status, size and duration.
+func AccessLog(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			recorder := newStatusRecorder(w)
+			next.ServeHTTP(recorder, r)
+
+			level := slog.LevelInfo
+			if recorder.status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logger.Log(r.Context(), level, "HTTP request",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", recorder.status,
+				"bytes", recorder.bytes,
+				"duration", time.Since(start),
+				"requestID", RequestIDFromContext(r.Context()),
+				"remoteAddr", r.RemoteAddr,
+			)
+		})
+	}
+}
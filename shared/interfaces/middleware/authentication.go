@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain/security"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+const bearerPrefix = "Bearer "
+
+// Authenticate validates the bearer token and stores the principal in the request context.
+// Requests without a valid token are rejected with 401; role checks happen in the use cases.
+func Authenticate(verifier security.TokenVerifier, logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+			if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+				rejectUnauthenticated(w, r, "auth.token_missing")
+				return
+			}
+
+			principal, err := verifier.Verify(r.Context(), strings.TrimSpace(authorization[len(bearerPrefix):]))
+			if err != nil {
+				logger.Warn("Rejected access token", "error", err, "requestID", RequestIDFromContext(r.Context()))
+				rejectUnauthenticated(w, r, "auth.token_invalid")
+				return
+			}
+
+			ctx := security.WithPrincipal(r.Context(), principal)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func rejectUnauthenticated(w http.ResponseWriter, r *http.Request, messageKey string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="employee-management"`)
+	utils.WriteProblem(w, r, http.StatusUnauthorized, "UNAUTHORIZED", messageKey, nil)
+}
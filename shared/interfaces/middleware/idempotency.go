@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// IdempotencyKeyHeader is the header clients use to make a POST safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader marks the responses replayed from a previous request with the same key.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// maxIdempotencyKeyLength matches the idempotency_keys.idempotency_key column.
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyKey stores the client's Idempotency-Key in the context for the use cases wrapped by
+// application.IdempotencyDecorator, and flags replayed responses with Idempotent-Replayed: true.
+func IdempotencyKey() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !isValidIdempotencyKey(key) {
+				utils.WriteProblem(w, r, http.StatusBadRequest, "INVALID_INPUT", "idempotency.key_invalid", nil)
+				return
+			}
+
+			r = r.WithContext(application.WithIdempotencyKey(r.Context(), key))
+			next.ServeHTTP(&replayFlagWriter{ResponseWriter: w, r: r}, r)
+		})
+	}
+}
+
+// replayFlagWriter adds the replay header right before the status line is written,
+// once the use case has already reported whether it replayed the response.
+type replayFlagWriter struct {
+	http.ResponseWriter
+	r           *http.Request
+	wroteHeader bool
+}
+
+func (w *replayFlagWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if application.IsIdempotentReplay(w.r.Context()) {
+			w.Header().Set(IdempotentReplayedHeader, "true")
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *replayFlagWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, deadlines).
+func (w *replayFlagWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func isValidIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for _, c := range key {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/i18n"
+)
+
+// Locale negotiates the response language from Accept-Language (es-PE by default), stores it in
+// the context for the error handler and the controllers, and announces it in Content-Language.
+func Locale() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			locale := i18n.MatchLocale(r.Header.Get("Accept-Language"))
+			w.Header().Set("Content-Language", string(locale))
+			w.Header().Add("Vary", "Accept-Language")
+			next.ServeHTTP(w, r.WithContext(i18n.WithLocale(r.Context(), locale)))
+		})
+	}
+}
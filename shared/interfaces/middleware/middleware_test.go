@@ -0,0 +1,99 @@
+package middleware_test
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/i18n"
+	"github.com/kevinsoras/employee-management/shared/interfaces/middleware"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+func TestChain_RecoveryAndRequestID(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	var seenRequestID string
+	handler := middleware.Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenRequestID = middleware.RequestIDFromContext(r.Context())
+		panic("boom")
+	}), middleware.RequestID(), middleware.Recovery(logger), middleware.AccessLog(logger))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-123")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, utils.ProblemContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, "req-123", seenRequestID)
+	assert.Equal(t, "req-123", rec.Header().Get(middleware.RequestIDHeader))
+}
+
+func TestRecovery_LogsThePanicWithTheRequestID(t *testing.T) {
+	var logs strings.Builder
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	handler := middleware.Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), middleware.RequestID(), middleware.Recovery(logger))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-123")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Contains(t, logs.String(), "Recovered from panic")
+	assert.Contains(t, logs.String(), "requestID=req-123")
+}
+
+func TestRequestID_GeneratesWhenMissing(t *testing.T) {
+	handler := middleware.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.NotEmpty(t, rec.Header().Get(middleware.RequestIDHeader))
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	var seenKey string
+	handler := middleware.IdempotencyKey()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenKey, _ = application.IdempotencyKeyFromContext(r.Context())
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/employee", nil)
+	req.Header.Set(middleware.IdempotencyKeyHeader, "b7d1c5e2-retry")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "b7d1c5e2-retry", seenKey)
+	assert.Empty(t, rec.Header().Get(middleware.IdempotentReplayedHeader))
+
+	req = httptest.NewRequest(http.MethodPost, "/employee", nil)
+	req.Header.Set(middleware.IdempotencyKeyHeader, strings.Repeat("k", 256))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestLocale_NegotiatesAcceptLanguage(t *testing.T) {
+	var seen i18n.Locale
+	handler := middleware.Locale()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = i18n.LocaleFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "en-GB,es;q=0.5")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, i18n.EnglishUS, seen)
+	assert.Equal(t, "en-US", rec.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", rec.Header().Get("Vary"))
+}
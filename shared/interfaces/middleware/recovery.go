@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// Recovery turns a panic in the next handler into a problem+json 500 response instead of dropping the connection.
+func Recovery(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			recorder := newStatusRecorder(w)
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+				logger.Error("Recovered from panic",
+					"panic", rec,
+					"method", r.Method,
+					"path", r.URL.Path,
+					"requestID", RequestIDFromContext(r.Context()),
+					"stack", string(debug.Stack()),
+				)
+				if !recorder.wroteHeader {
+					utils.WriteProblem(recorder, r, http.StatusInternalServerError, utils.CodeInternalError, "error.internal", nil)
+				}
+			}()
+			next.ServeHTTP(recorder, r)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// RequestIDHeader is the header used to receive and propagate the request ID.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds the IDs accepted from clients so they cannot flood the logs.
+const maxRequestIDLength = 128
+
+// RequestID reuses the client's X-Request-ID (or generates one), stores it in the context
+// and echoes it in the response.
+func RequestID() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !isValidRequestID(requestID) {
+				requestID = uuid.NewString()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+			ctx := utils.WithTraceID(r.Context(), requestID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or "" outside a request.
+// It is the same ID reported as traceId in the error responses.
+func RequestIDFromContext(ctx context.Context) string {
+	return utils.TraceIDFromContext(ctx)
+}
+
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/application"
+	"github.com/kevinsoras/employee-management/shared/application/dto"
+	usecases "github.com/kevinsoras/employee-management/shared/application/use-cases"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// PersonController handles person-related operations shared across contexts.
+type PersonController struct {
+	logger              *slog.Logger
+	getPersonUseCase    application.UseCase[usecases.GetPersonQuery, dto.PersonResponse]
+	lookupPersonUseCase application.UseCase[usecases.LookupPersonQuery, dto.PersonLookupResponse]
+	updatePersonUseCase application.UseCase[usecases.UpdatePersonCommand, dto.PersonResponse]
+	findDuplicatesUC    application.UseCase[usecases.FindDuplicatePersonsQuery, []dto.DuplicateCandidateResponse]
+}
+
+// NewPersonController creates a new controller with dependencies wired up.
+func NewPersonController(
+	logger *slog.Logger,
+	getPersonUseCase application.UseCase[usecases.GetPersonQuery, dto.PersonResponse],
+	lookupPersonUseCase application.UseCase[usecases.LookupPersonQuery, dto.PersonLookupResponse],
+	updatePersonUseCase application.UseCase[usecases.UpdatePersonCommand, dto.PersonResponse],
+	findDuplicatesUC application.UseCase[usecases.FindDuplicatePersonsQuery, []dto.DuplicateCandidateResponse],
+) *PersonController {
+	return &PersonController{
+		logger:              logger,
+		getPersonUseCase:    getPersonUseCase,
+		lookupPersonUseCase: lookupPersonUseCase,
+		updatePersonUseCase: updatePersonUseCase,
+		findDuplicatesUC:    findDuplicatesUC,
+	}
+}
+
+// HandleGet returns a person with its version as ETag.
+// @Summary Get a person
+// @Description Returns the person data (masked according to the caller's roles); the ETag is required as If-Match to update it.
+// @Tags Persons
+// @Produce json
+// @Param id path string true "Person ID"
+// @Success 200 {object} utils.APIResponse "Person found"
+// @Header 200 {string} ETag "Current version of the person"
+// @Failure 404 {object} utils.ProblemDetails "Person not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /persons/{id} [get]
+func (c *PersonController) HandleGet(w http.ResponseWriter, r *http.Request) {
+	personID := r.PathValue("id")
+
+	resp, err := c.getPersonUseCase.Execute(r.Context(), usecases.GetPersonQuery{PersonID: personID})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	utils.SetETag(w, resp.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "person.found", resp))
+}
+
+// HandleLookup prefills person data from the public registries (RENIEC/SUNAT).
+// @Summary Look up a person by DNI or RUC
+// @Description Returns the names (DNI) or business data (RUC) registered in the public registries.
+// @Tags Persons
+// @Produce json
+// @Param type query string true "Document type (DNI or RUC)"
+// @Param number query string true "Document number"
+// @Success 200 {object} utils.APIResponse "Person found"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 404 {object} utils.ProblemDetails "Document not found"
+// @Failure 502 {object} utils.ProblemDetails "Lookup provider failure"
+// @Router /persons/lookup [get]
+func (c *PersonController) HandleLookup(w http.ResponseWriter, r *http.Request) {
+
+	lookupDTO := dto.PersonLookupRequest{
+		Type:   strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("type"))),
+		Number: strings.TrimSpace(r.URL.Query().Get("number")),
+	}
+	if err := utils.ValidateStruct(&lookupDTO); err != nil {
+		c.logger.Error("Failed to validate lookup query", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	resp, err := c.lookupPersonUseCase.Execute(r.Context(), usecases.LookupPersonQuery{Data: lookupDTO})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "person.found", resp))
+}
+
+// HandleFindDuplicates lists the persons that are likely duplicates of the given one.
+// @Summary Find duplicate candidates of a person
+// @Description Returns persons with a similar name, the same birth date, the same phone or a mistyped document, ordered by score.
+// @Tags Persons
+// @Produce json
+// @Param id path string true "Person ID"
+// @Success 200 {object} utils.APIResponse "Duplicate candidates"
+// @Failure 404 {object} utils.ProblemDetails "Person not found"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /persons/{id}/duplicates [get]
+func (c *PersonController) HandleFindDuplicates(w http.ResponseWriter, r *http.Request) {
+	personID := r.PathValue("id")
+
+	resp, err := c.findDuplicatesUC.Execute(r.Context(), usecases.FindDuplicatePersonsQuery{PersonID: personID})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "person.duplicates_found", resp))
+}
+
+// HandleUpdate updates the contact information and structured address of a person.
+// @Summary Update a person
+// @Description Update email, phone, address, typed contacts and structured address (with ubigeo) of a person.
+// @Tags Persons
+// @Accept json
+// @Produce json
+// @Param id path string true "Person ID"
+// @Param If-Match header string true "ETag returned when the person was read"
+// @Param person body dto.PersonUpdateRequest true "Person update details"
+// @Success 200 {object} utils.APIResponse "Person updated successfully"
+// @Header 200 {string} ETag "New version of the person"
+// @Failure 400 {object} utils.ProblemDetails "Bad request"
+// @Failure 404 {object} utils.ProblemDetails "Person not found"
+// @Failure 412 {object} utils.ProblemDetails "The person was modified by another user"
+// @Failure 428 {object} utils.ProblemDetails "If-Match header missing"
+// @Failure 500 {object} utils.ProblemDetails "Internal server error"
+// @Router /persons/{id} [put]
+func (c *PersonController) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	personID := r.PathValue("id")
+	expectedVersion, err := utils.IfMatchVersion(r)
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	var updateDTO dto.PersonUpdateRequest
+	if err := utils.ValidateAndBind(r, &updateDTO); err != nil {
+		c.logger.Error("Failed to validate or bind request DTO", "error", err)
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	resp, err := c.updatePersonUseCase.Execute(r.Context(), usecases.UpdatePersonCommand{PersonID: personID, ExpectedVersion: expectedVersion, Data: updateDTO})
+	if err != nil {
+		utils.HandleHTTPError(w, r, c.logger, err)
+		return
+	}
+
+	c.logger.Info("Successfully updated person", "personID", personID, "version", resp.Version)
+	utils.SetETag(w, resp.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(utils.SuccessResponse(r, "person.updated", resp))
+}
@@ -0,0 +1,80 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/kevinsoras/employee-management/shared/interfaces/middleware"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+// Router registers routes with Go 1.22 http.ServeMux patterns ("POST /employee", "PUT /persons/{id}")
+// and runs every request through a middleware chain. Unknown routes and wrong methods answer with
+// the standard JSON error body instead of the mux's plain-text 404/405.
+type Router struct {
+	mux     *http.ServeMux
+	handler http.Handler
+}
+
+// New creates a Router; the middlewares apply to every route, the first one being the outermost.
+func New(middlewares ...middleware.Middleware) *Router {
+	r := &Router{mux: http.NewServeMux()}
+	r.handler = middleware.Chain(http.HandlerFunc(r.dispatch), middlewares...)
+	return r
+}
+
+// HandleFunc registers a handler for a method-qualified pattern, e.g. "GET /persons/{id}".
+func (r *Router) HandleFunc(pattern string, handler http.HandlerFunc) {
+	r.mux.HandleFunc(pattern, handler)
+}
+
+// Handle registers an http.Handler for a pattern.
+func (r *Router) Handle(pattern string, handler http.Handler) {
+	r.mux.Handle(pattern, handler)
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.handler.ServeHTTP(w, req)
+}
+
+func (r *Router) dispatch(w http.ResponseWriter, req *http.Request) {
+	if _, pattern := r.mux.Handler(req); pattern != "" {
+		r.mux.ServeHTTP(w, req)
+		return
+	}
+	r.unmatched(w, req)
+}
+
+// unmatched lets the mux decide between 404, 405 (with its Allow header) or a redirect,
+// and rewrites only the 404/405 bodies as problem details.
+func (r *Router) unmatched(w http.ResponseWriter, req *http.Request) {
+	recorder := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+	r.mux.ServeHTTP(recorder, req)
+
+	switch recorder.status {
+	case http.StatusNotFound:
+		utils.WriteProblem(w, req, http.StatusNotFound, "NOT_FOUND", "request.not_found", nil)
+	case http.StatusMethodNotAllowed:
+		w.Header().Set("Allow", recorder.header.Get("Allow"))
+		utils.WriteProblem(w, req, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "request.method_not_allowed", nil)
+	default:
+		for key, values := range recorder.header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(recorder.status)
+		_, _ = w.Write(recorder.body.Bytes())
+	}
+}
+
+// bufferedResponse captures the mux's own error and redirect responses.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+func (b *bufferedResponse) WriteHeader(status int) { b.status = status }
@@ -0,0 +1,55 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/interfaces/router"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+func newTestRouter() *router.Router {
+	r := router.New()
+	r.HandleFunc("GET /persons/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.PathValue("id")))
+	})
+	r.HandleFunc("PUT /persons/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return r
+}
+
+func TestRouter_PathValue(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestRouter().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/persons/abc", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "abc", rec.Body.String())
+}
+
+func TestRouter_MethodNotAllowedIsProblem(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestRouter().ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/persons/abc", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, utils.ProblemContentType, rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Allow"), http.MethodPut)
+	var body utils.ProblemDetails
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, http.StatusMethodNotAllowed, body.Status)
+	assert.Equal(t, "METHOD_NOT_ALLOWED", body.Code)
+	assert.Equal(t, "/persons/abc", body.Instance)
+}
+
+func TestRouter_NotFoundIsProblem(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestRouter().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, utils.ProblemContentType, rec.Header().Get("Content-Type"))
+}
@@ -10,42 +10,100 @@ import (
 	"github.com/kevinsoras/employee-management/shared/infrastructure"
 )
 
-// HandleHTTPError inspects an error and writes an appropriate HTTP response.
+// Codes of the problems that do not come from a DomainError.
+const (
+	CodeInternalError   = "INTERNAL_ERROR"
+	CodeExternalService = "EXTERNAL_SERVICE_ERROR"
+	CodeRequestTimeout  = "REQUEST_TIMEOUT"
+)
+
+// classifiedError is the problem an error maps to, plus how it must be logged.
+type classifiedError struct {
+	status     int
+	code       string
+	messageKey string
+	params     domain.Params
+	fields     []domain.FieldError
+	logLevel   slog.Level
+	logMsg     string
+	logAttrs   []any
+}
+
+// HandleHTTPError inspects an error and writes the matching application/problem+json response,
+// rendered in the locale negotiated for the request.
 // It centralizes the logic for handling different error types.
-func HandleHTTPError(w http.ResponseWriter, logger *slog.Logger, err error) {
+func HandleHTTPError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
+	c := classifyError(err)
+	logger.Log(r.Context(), c.logLevel, c.logMsg, append(c.logAttrs, "traceId", TraceIDFromContext(r.Context()))...)
+	WriteProblem(w, r, c.status, c.code, c.messageKey, c.params, c.fields...)
+}
+
+// ProblemFromError builds the problem HandleHTTPError would write for err, for responses that
+// report several errors at once (e.g. one per row of an import).
+func ProblemFromError(r *http.Request, err error) ProblemDetails {
+	c := classifyError(err)
+	return NewProblem(r, c.status, c.code, c.messageKey, c.params, c.fields...)
+}
+
+func classifyError(err error) classifiedError {
 	var domainErr *domain.DomainError
+	var fieldErr *domain.FieldError
 	var infraErr *infrastructure.InfrastructureError
 
 	// 1. Check for a rich DomainError first.
 	if errors.As(err, &domainErr) {
-		logger.Warn("Domain error occurred", "code", domainErr.Code, "msg", domainErr.Message, "original_err", domainErr.Unwrap())
-		WriteJSONError(w, domainErr.Message, domainErr.HTTPStatusCode)
-		return
+		fields := domainErr.Fields
+		if len(fields) == 0 && errors.As(domainErr.Unwrap(), &fieldErr) {
+			fields = []domain.FieldError{*fieldErr}
+		}
+		return classifiedError{
+			status: domainErr.HTTPStatusCode, code: domainErr.Code, messageKey: domainErr.MessageKey, params: domainErr.Params, fields: fields,
+			logLevel: slog.LevelWarn, logMsg: "Domain error occurred",
+			logAttrs: []any{"code", domainErr.Code, "key", domainErr.MessageKey, "original_err", domainErr.Unwrap()},
+		}
 	}
 
-	// 2. Check for an Application-level validation error.
+	// 2. Check for an entity Validate failure that reached the handler without a DomainError.
+	if errors.As(err, &fieldErr) {
+		return classifiedError{
+			status: http.StatusBadRequest, code: "INVALID_INPUT", messageKey: "validation.failed", fields: []domain.FieldError{*fieldErr},
+			logLevel: slog.LevelInfo, logMsg: "Entity validation failed",
+			logAttrs: []any{"field", fieldErr.Field, "rule", fieldErr.Rule, "error", err},
+		}
+	}
+
+	// 3. Check for an Application-level validation error.
 	if errors.Is(err, ErrValidation) {
-		logger.Info("Request validation failed", "error", err)
-		WriteJSONError(w, err.Error(), http.StatusBadRequest)
-		return
+		return classifiedError{
+			status: http.StatusBadRequest, code: "INVALID_INPUT", messageKey: "validation.failed",
+			logLevel: slog.LevelInfo, logMsg: "Request validation failed", logAttrs: []any{"error", err},
+		}
 	}
 
-	// 3. Check for common Infrastructure errors.
+	// 4. Check for common Infrastructure errors; their messages are internal and only logged.
 	if errors.As(err, &infraErr) {
-				logger.Error("Infrastructure error occurred", "code", infraErr.Code, "msg", infraErr.Error(), "original_err", infraErr.Unwrap())
-		WriteJSONError(w, infraErr.Error(), http.StatusInternalServerError)
-
-		return
+		c := classifiedError{
+			status: http.StatusInternalServerError, code: CodeInternalError, messageKey: "error.internal",
+			logLevel: slog.LevelError, logMsg: "Infrastructure error occurred",
+			logAttrs: []any{"code", infraErr.Code, "msg", infraErr.Error(), "original_err", infraErr.Unwrap()},
+		}
+		if infraErr.Code == infrastructure.ExternalServiceErrorCode {
+			c.status, c.code, c.messageKey, c.logMsg = http.StatusBadGateway, CodeExternalService, "error.external_service", "External service error occurred"
+		}
+		return c
 	}
 
-	// 4. Check for specific system errors like context timeout.
+	// 5. Check for specific system errors like context timeout.
 	if errors.Is(err, context.DeadlineExceeded) {
-		logger.Error("Request timed out", "error", err)
-		WriteJSONError(w, "La solicitud excedió el tiempo de espera.", http.StatusGatewayTimeout)
-		return
+		return classifiedError{
+			status: http.StatusGatewayTimeout, code: CodeRequestTimeout, messageKey: "error.timeout",
+			logLevel: slog.LevelError, logMsg: "Request timed out", logAttrs: []any{"error", err},
+		}
 	}
 
-	// 5. Fallback for any other unexpected error.
-	logger.Error("Unexpected system error", "error", err)
-	WriteJSONError(w, "Ocurrió un error interno inesperado", http.StatusInternalServerError)
-}
\ No newline at end of file
+	// 6. Fallback for any other unexpected error.
+	return classifiedError{
+		status: http.StatusInternalServerError, code: CodeInternalError, messageKey: "error.internal",
+		logLevel: slog.LevelError, logMsg: "Unexpected system error", logAttrs: []any{"error", err},
+	}
+}
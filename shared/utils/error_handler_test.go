@@ -0,0 +1,123 @@
+package utils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/i18n"
+	"github.com/kevinsoras/employee-management/shared/utils"
+)
+
+func handleError(t *testing.T, locale i18n.Locale, err error) (*httptest.ResponseRecorder, utils.ProblemDetails) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/employee", nil)
+	ctx := utils.WithTraceID(req.Context(), "req-123")
+	req = req.WithContext(i18n.WithLocale(ctx, locale))
+	rec := httptest.NewRecorder()
+	utils.HandleHTTPError(rec, req, slog.New(slog.NewTextHandler(io.Discard, nil)), err)
+
+	var problem utils.ProblemDetails
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	return rec, problem
+}
+
+func TestHandleHTTPError_DomainError(t *testing.T) {
+	rec, problem := handleError(t, i18n.SpanishPeru, domain.NewVersionConflictError("employee.version_conflict", nil))
+
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	assert.Equal(t, utils.ProblemContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, "/problems/version-conflict", problem.Type)
+	assert.Equal(t, "Precondición fallida", problem.Title)
+	assert.Equal(t, "El empleado fue modificado por otro usuario. Vuelva a consultarlo y reintente.", problem.Detail)
+	assert.Equal(t, http.StatusPreconditionFailed, problem.Status)
+	assert.Equal(t, "VERSION_CONFLICT", problem.Code)
+	assert.Equal(t, "/employee", problem.Instance)
+	assert.Equal(t, "req-123", problem.TraceID)
+	assert.Empty(t, problem.Errors)
+}
+
+func TestHandleHTTPError_RendersRequestLocale(t *testing.T) {
+	err := domain.NewNotFoundError("person.not_found_id", nil).WithParams(domain.Params{"id": "0199"})
+
+	_, problem := handleError(t, i18n.EnglishUS, err)
+
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, "The person 0199 does not exist.", problem.Detail)
+	assert.Equal(t, "NOT_FOUND", problem.Code, "the code does not depend on the locale")
+}
+
+func TestHandleHTTPError_FieldErrorFromEntity(t *testing.T) {
+	cause := domain.NewFieldError("salary", "gt", "validation.gt", domain.Params{"min": 0})
+	err := domain.NewInvalidInputError("validation.failed", fmt.Errorf("validating employee: %w", cause))
+
+	_, spanish := handleError(t, i18n.SpanishPeru, err)
+	_, english := handleError(t, i18n.EnglishUS, err)
+
+	assert.Equal(t, "INVALID_INPUT", spanish.Code)
+	assert.Equal(t, []utils.ProblemFieldError{{Field: "salary", Rule: "gt", Message: "salary debe ser mayor que 0."}}, spanish.Errors)
+	assert.Equal(t, []utils.ProblemFieldError{{Field: "salary", Rule: "gt", Message: "salary must be greater than 0."}}, english.Errors)
+}
+
+func TestHandleHTTPError_InfrastructureErrorHidesCause(t *testing.T) {
+	rec, problem := handleError(t, i18n.SpanishPeru, infrastructure.NewDBError("No se pudo guardar el empleado", errors.New("pq: connection refused")))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "INTERNAL_ERROR", problem.Code)
+	assert.Equal(t, "Ocurrió un error interno inesperado.", problem.Detail)
+	assert.NotContains(t, rec.Body.String(), "connection refused")
+}
+
+type registrationRequest struct {
+	Name    string `json:"name" validate:"required"`
+	Address struct {
+		Ubigeo string `json:"ubigeo" validate:"len=6"`
+	} `json:"address"`
+	Salary float64 `json:"salary" validate:"gt=0"`
+}
+
+func TestValidateAndBind_FieldErrors(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/employee", bytes.NewBufferString(`{"address":{"ubigeo":"1501"},"salary":1000}`))
+
+	err := utils.ValidateAndBind(req, &registrationRequest{})
+
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, []domain.FieldError{
+		{Field: "name", Rule: "required", MessageKey: "validation.required"},
+		{Field: "address.ubigeo", Rule: "len", MessageKey: "validation.len", Params: domain.Params{"len": "6"}},
+	}, domainErr.Fields)
+}
+
+func TestValidateAndBind_DecodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want domain.FieldError
+	}{
+		{"wrong type", `{"name":"Ana","salary":"mil"}`, domain.FieldError{Field: "salary", Rule: "type", MessageKey: "validation.type", Params: domain.Params{"type": "float64"}}},
+		{"unknown field", `{"name":"Ana","bonus":1}`, domain.FieldError{Field: "bonus", Rule: "unknown_field", MessageKey: "validation.unknown_field"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/employee", bytes.NewBufferString(tt.body))
+
+			err := utils.ValidateAndBind(req, &registrationRequest{})
+
+			var domainErr *domain.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, []domain.FieldError{tt.want}, domainErr.Fields)
+		})
+	}
+}
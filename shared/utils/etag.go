@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+)
+
+// SetETag exposes the version of the returned resource as a strong ETag (e.g. "3").
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", `"`+strconv.Itoa(version)+`"`)
+}
+
+// IfMatchVersion reads the version the client based its update on. If-Match is mandatory on
+// PUT/PATCH so that concurrent edits fail with 412 instead of silently overwriting each other.
+func IfMatchVersion(r *http.Request) (int, error) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" {
+		return 0, domain.NewPreconditionRequiredError("precondition.if_match_required", nil)
+	}
+	version, err := strconv.Atoi(strings.Trim(ifMatch, `"`))
+	if err != nil || version <= 0 || !strings.HasPrefix(ifMatch, `"`) || !strings.HasSuffix(ifMatch, `"`) {
+		return 0, domain.NewVersionConflictError("precondition.if_match_invalid", err)
+	}
+	return version, nil
+}
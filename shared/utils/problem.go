@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsoras/employee-management/shared/domain"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/i18n"
+)
+
+// ProblemContentType is the media type of the error responses (RFC 9457).
+const ProblemContentType = "application/problem+json"
+
+// problemTypeBase prefixes the relative type URI of every problem, e.g. /problems/version-conflict.
+const problemTypeBase = "/problems/"
+
+// ProblemDetails is the body of every error response (RFC 9457). Code is the stable
+// DomainError code clients can switch on; Errors lists the rejected fields.
+type ProblemDetails struct {
+	Type     string              `json:"type"`
+	Title    string              `json:"title"`
+	Status   int                 `json:"status"`
+	Detail   string              `json:"detail,omitempty"`
+	Instance string              `json:"instance,omitempty"`
+	Code     string              `json:"code"`
+	TraceID  string              `json:"traceId,omitempty"`
+	Errors   []ProblemFieldError `json:"errors,omitempty"`
+}
+
+// ProblemFieldError describes one rejected field of the request.
+type ProblemFieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type traceIDKey struct{}
+
+// WithTraceID stores the ID that correlates the error responses with the logs.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// TraceIDFromContext returns the ID stored by WithTraceID, or "" outside a request.
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey{}).(string)
+	return traceID
+}
+
+// NewProblem builds the problem for a request; the type URI is derived from the code. The title,
+// the detail and the field messages are rendered from the i18n catalog in the request's locale.
+func NewProblem(r *http.Request, status int, code, messageKey string, params domain.Params, fields ...domain.FieldError) ProblemDetails {
+	locale := i18n.LocaleFromContext(r.Context())
+	problem := ProblemDetails{
+		Type:     problemTypeBase + strings.ToLower(strings.ReplaceAll(code, "_", "-")),
+		Title:    i18n.Translate(locale, "http."+strconv.Itoa(status), nil),
+		Status:   status,
+		Detail:   i18n.Translate(locale, messageKey, params),
+		Instance: r.URL.Path,
+		Code:     code,
+		TraceID:  TraceIDFromContext(r.Context()),
+	}
+	for _, field := range fields {
+		fieldParams := domain.Params{"field": field.Field}
+		for name, value := range field.Params {
+			fieldParams[name] = value
+		}
+		problem.Errors = append(problem.Errors, ProblemFieldError{
+			Field:   field.Field,
+			Rule:    field.Rule,
+			Message: i18n.Translate(locale, field.MessageKey, fieldParams),
+		})
+	}
+	return problem
+}
+
+// WriteProblem writes an application/problem+json error response.
+func WriteProblem(w http.ResponseWriter, r *http.Request, status int, code, messageKey string, params domain.Params, fields ...domain.FieldError) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(NewProblem(r, status, code, messageKey, params, fields...))
+}
@@ -1,8 +1,9 @@
 package utils
 
 import (
-	"encoding/json"
 	"net/http"
+
+	"github.com/kevinsoras/employee-management/shared/infrastructure/i18n"
 )
 
 type APIResponse struct {
@@ -11,24 +12,11 @@ type APIResponse struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
-func SuccessResponse(message string, data interface{}) APIResponse {
+// SuccessResponse builds the success envelope with the message rendered in the request's locale.
+func SuccessResponse(r *http.Request, messageKey string, data interface{}) APIResponse {
 	return APIResponse{
 		Status:  "success",
-		Message: message,
+		Message: i18n.T(r.Context(), messageKey, nil),
 		Data:    data,
 	}
 }
-
-func ErrorResponse(message string) APIResponse {
-	return APIResponse{
-		Status:  "error",
-		Message: message,
-	}
-}
-
-// WriteJSONError writes a standard JSON error response to the http.ResponseWriter.
-func WriteJSONError(w http.ResponseWriter, message string, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(ErrorResponse(message))
-}
@@ -2,9 +2,11 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -55,18 +57,107 @@ func RequiredIf(fl validator.FieldLevel) bool {
 
 // ValidateAndBind simplifica el parseo y validación de un request
 func ValidateAndBind(r *http.Request, dst interface{}) error {
-	validate := validator.New()
-
-	// Registrar la validación personalizada required_if si no está registrada
-	_ = validate.RegisterValidation("required_if", RequiredIf)
-
 	decoder := json.NewDecoder(r.Body)
 	decoder.DisallowUnknownFields()
 	if err := decoder.Decode(dst); err != nil {
-		return sharedDomain.NewInvalidInputError(fmt.Sprintf("Cuerpo de la solicitud inválido: %s", err.Error()), err)
+		invalid := sharedDomain.NewInvalidInputError("request.invalid_body", err)
+		if field := decodeFieldError(err); field != nil {
+			invalid = invalid.WithFieldErrors(*field)
+		}
+		return invalid
 	}
+	return ValidateStruct(dst)
+}
+
+// ValidateStruct aplica las reglas `validate` a un struct ya poblado (ej: parámetros de query)
+func ValidateStruct(dst interface{}) error {
+	validate := validator.New()
+	_ = validate.RegisterValidation("required_if", RequiredIf)
+	validate.RegisterTagNameFunc(jsonFieldName)
+
 	if err := validate.Struct(dst); err != nil {
-		return sharedDomain.NewInvalidInputError(fmt.Sprintf("Error de validación: %s", err.Error()), err)
+		invalid := sharedDomain.NewInvalidInputError("validation.failed", err)
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			invalid = invalid.WithFieldErrors(toFieldErrors(validationErrs)...)
+		}
+		return invalid
+	}
+	return nil
+}
+
+// jsonFieldName hace que los errores usen el nombre JSON del campo (ej: structuredAddress.ubigeo)
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// toFieldErrors traduce los errores del validador al formato de errores por campo
+func toFieldErrors(validationErrs validator.ValidationErrors) []sharedDomain.FieldError {
+	fields := make([]sharedDomain.FieldError, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		// El namespace incluye el nombre del struct raíz (ej: EmployeeRegistrationRequest.email)
+		field := fe.Namespace()
+		if i := strings.Index(field, "."); i >= 0 {
+			field = field[i+1:]
+		}
+		key, params := ruleMessage(fe)
+		fields = append(fields, *sharedDomain.NewFieldError(field, fe.Tag(), key, params))
+	}
+	return fields
+}
+
+// ruleMessage devuelve la clave del catálogo i18n (y sus parámetros) de la regla incumplida
+func ruleMessage(fe validator.FieldError) (string, sharedDomain.Params) {
+	isText := fe.Kind() == reflect.String
+	switch fe.Tag() {
+	case "required", "required_if":
+		return "validation.required", nil
+	case "email":
+		return "validation.email", nil
+	case "oneof":
+		return "validation.oneof", sharedDomain.Params{"values": strings.Join(strings.Fields(fe.Param()), ", ")}
+	case "len":
+		return "validation.len", sharedDomain.Params{"len": fe.Param()}
+	case "min":
+		if isText {
+			return "validation.min_length", sharedDomain.Params{"min": fe.Param()}
+		}
+		return "validation.min", sharedDomain.Params{"min": fe.Param()}
+	case "max":
+		if isText {
+			return "validation.max_length", sharedDomain.Params{"max": fe.Param()}
+		}
+		return "validation.max", sharedDomain.Params{"max": fe.Param()}
+	case "gt":
+		return "validation.gt", sharedDomain.Params{"min": fe.Param()}
+	case "gte":
+		return "validation.min", sharedDomain.Params{"min": fe.Param()}
+	case "numeric":
+		return "validation.numeric", nil
+	case "uuid", "uuid4":
+		return "validation.uuid", nil
+	case "datetime":
+		return "validation.datetime", sharedDomain.Params{"format": fe.Param()}
+	default:
+		return "validation.rule", sharedDomain.Params{"rule": fe.Tag()}
+	}
+}
+
+// decodeFieldError identifica el campo que impidió decodificar el JSON, si se puede determinar
+func decodeFieldError(err error) *sharedDomain.FieldError {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return sharedDomain.NewFieldError(typeErr.Field, "type", "validation.type", sharedDomain.Params{"type": typeErr.Type.String()})
+	}
+	if name, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return sharedDomain.NewFieldError(strings.Trim(name, `"`), "unknown_field", "validation.unknown_field", nil)
 	}
 	return nil
 }
@@ -4,13 +4,18 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +23,8 @@ import (
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 
 	"github.com/kevinsoras/employee-management/app"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/auth"
+	"github.com/kevinsoras/employee-management/shared/infrastructure/crypto"
 	"github.com/kevinsoras/employee-management/shared/infrastructure/db"
 	"github.com/kevinsoras/employee-management/shared/infrastructure/logger"
 	"github.com/kevinsoras/employee-management/shared/utils"
@@ -26,8 +33,12 @@ import (
 var (
 	testDB     *sql.DB
 	testServer *httptest.Server
+	testToken  string
 )
 
+// testJWTSecret signs the HS256 tokens used by the E2E tests
+const testJWTSecret = "e2e-test-secret"
+
 // TestMain runs setup and teardown for all E2E tests
 func TestMain(m *testing.M) {
 	ctx := context.Background()
@@ -57,26 +68,43 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 
-	// Run migrations (assuming you have a way to run them programmatically)
-	// For simplicity, we'll just run the up migrations for shared and employee contexts
-	// In a real scenario, you'd use your migration tool (e.g., 'migrate' library)
-	// This is a placeholder for actual migration execution
-	// db.RunMigrations(testDB, "shared/infrastructure/persistence/migrations")
-	// db.RunMigrations(testDB, "contexts/employee/infrastructure/persistence/migrations")
+	// Run the up migrations in the same order as `make migrate-up`
+	if err := runMigrations(testDB); err != nil {
+		slog.Error("Failed to run migrations", "error", err)
+		_ = pgContainer.Terminate(ctx)
+		os.Exit(1)
+	}
 
 	// Assemble the application using the new app.NewApplication function
-	appInstance := app.NewApplication(testDB, slog.Default())
-
-	// Create httptest server
-	testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// This is a simplified handler for the E2E test
-		if r.URL.Path == "/employee" && r.Method == http.MethodPost {
-			// Call the actual controller handler
-			appInstance.EmployeeController.HandleRegister(w, r)
-			return
-		}
-		http.NotFound(w, r)
-	}))
+	cfg := app.LoadConfig()
+	cfg.JWT = auth.JWTConfig{HMACSecret: testJWTSecret}
+	cfg.Encryption = crypto.Config{
+		Keys:          "1:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)),
+		BlindIndexKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)),
+	}
+	cfg.DocumentStorage = "filesystem"
+	cfg.DocumentStorageDir = filepath.Join(os.TempDir(), "e2e-employee-documents")
+	appInstance, err := app.NewApplication(testDB, slog.Default(), cfg)
+	if err != nil {
+		slog.Error("Failed to assemble application", "error", err)
+		_ = pgContainer.Terminate(ctx)
+		os.Exit(1)
+	}
+
+	// Mint an HR_ADMIN token for the requests
+	testToken, err = auth.SignHS256(map[string]any{
+		"sub":   "e2e-hr-admin",
+		"roles": []string{"HR_ADMIN"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}, testJWTSecret)
+	if err != nil {
+		slog.Error("Failed to sign test token", "error", err)
+		_ = pgContainer.Terminate(ctx)
+		os.Exit(1)
+	}
+
+	// Create httptest server with the same routes and middlewares as main
+	testServer = httptest.NewServer(appInstance.Routes())
 
 	// Run tests
 	exitCode := m.Run()
@@ -89,6 +117,35 @@ func TestMain(m *testing.M) {
 	os.Exit(exitCode)
 }
 
+// migrationDirs follows MIGRATION_CONTEXTS in the makefile: shared first, then each context
+var migrationDirs = []string{
+	"../../shared/infrastructure/persistence/migrations",
+	"../../contexts/employee/infrastructure/persistence/migrations",
+	"../../contexts/payroll/infrastructure/persistence/migrations",
+}
+
+// runMigrations applies every up migration, directory by directory and by version inside each
+// one, which is what golang-migrate does with one migrations table per directory
+func runMigrations(db *sql.DB) error {
+	for _, dir := range migrationDirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*.up.sql"))
+		if err != nil {
+			return err
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			query, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			if _, err := db.Exec(string(query)); err != nil {
+				return fmt.Errorf("%s: %w", filepath.Base(file), err)
+			}
+		}
+	}
+	return nil
+}
+
 func TestRegisterEmployeeE2E_Success(t *testing.T) {
 	// Given
 	reqBody := []byte(`{
@@ -125,6 +182,7 @@ func TestRegisterEmployeeE2E_Success(t *testing.T) {
 	req, err := http.NewRequest(http.MethodPost, testServer.URL+"/employee", bytes.NewBuffer(reqBody))
 	require.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+testToken)
 
 	// When
 	resp, err := testServer.Client().Do(req)
@@ -0,0 +1,138 @@
+package migrations_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// repoRoot is where the makefile runs its migrate loop from
+const repoRoot = "../.."
+
+var (
+	migrationFile = regexp.MustCompile(`^(\d+)_\w+\.(up|down)\.sql$`)
+	createTable   = regexp.MustCompile(`(?i)CREATE TABLE (?:IF NOT EXISTS )?(\w+)`)
+	usedTable     = regexp.MustCompile(`(?i)(?:ALTER TABLE (?:IF EXISTS )?|REFERENCES )(\w+)`)
+)
+
+// legacyNoOpVersions are versions kept in shared as empty migrations, so that databases whose
+// schema_migrations stopped there still find them. The real migration lives in a context.
+var legacyNoOpVersions = map[string]bool{
+	"20250905194829": true, // create_employee, now in contexts/employee
+}
+
+type migration struct {
+	context string
+	version string
+	path    string
+}
+
+// migrationContexts reads MIGRATION_CONTEXTS from the makefile, so the tests follow the same
+// order as `make migrate-up`.
+func migrationContexts(t *testing.T) []string {
+	makefile, err := os.ReadFile(filepath.Join(repoRoot, "makefile"))
+	require.NoError(t, err)
+	for _, line := range strings.Split(string(makefile), "\n") {
+		if name, value, ok := strings.Cut(line, "="); ok && strings.TrimSpace(name) == "MIGRATION_CONTEXTS" {
+			return strings.Fields(value)
+		}
+	}
+	t.Fatal("MIGRATION_CONTEXTS not found in the makefile")
+	return nil
+}
+
+// migrationDir mirrors the migration_vars define of the makefile.
+func migrationDir(context string) string {
+	if context == "shared" {
+		return filepath.Join(repoRoot, "shared/infrastructure/persistence/migrations")
+	}
+	return filepath.Join(repoRoot, "contexts", context, "infrastructure/persistence/migrations")
+}
+
+// upMigrations returns the up migrations in the order the makefile applies them: one directory
+// after the other and, inside each, by version, as golang-migrate does with its own table.
+func upMigrations(t *testing.T) []migration {
+	var migrations []migration
+	for _, context := range migrationContexts(t) {
+		entries, err := os.ReadDir(migrationDir(context))
+		if os.IsNotExist(err) {
+			continue
+		}
+		require.NoError(t, err)
+		var found []migration
+		for _, entry := range entries {
+			match := migrationFile.FindStringSubmatch(entry.Name())
+			require.NotNil(t, match, "unexpected file %s/%s", context, entry.Name())
+			if match[2] == "up" {
+				found = append(found, migration{context, match[1], filepath.Join(migrationDir(context), entry.Name())})
+			}
+		}
+		sort.Slice(found, func(i, j int) bool { return found[i].version < found[j].version })
+		migrations = append(migrations, found...)
+	}
+	return migrations
+}
+
+func TestMigrations_VersionsAreUniqueAcrossDirectories(t *testing.T) {
+	seen := map[string]string{}
+	for _, m := range upMigrations(t) {
+		if m.context == "shared" && legacyNoOpVersions[m.version] {
+			continue
+		}
+		if previous, ok := seen[m.version]; ok {
+			t.Errorf("version %s is used by %s and %s", m.version, previous, m.path)
+		}
+		seen[m.version] = m.path
+	}
+}
+
+func TestMigrations_EveryUpHasADown(t *testing.T) {
+	for _, m := range upMigrations(t) {
+		assert.FileExists(t, strings.TrimSuffix(m.path, ".up.sql")+".down.sql")
+	}
+}
+
+func TestMigrations_LegacyVersionsAreNoOps(t *testing.T) {
+	for _, m := range upMigrations(t) {
+		if m.context != "shared" || !legacyNoOpVersions[m.version] {
+			continue
+		}
+		for _, path := range []string{m.path, strings.TrimSuffix(m.path, ".up.sql") + ".down.sql"} {
+			sql, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, "SELECT 1;", withoutComments(string(sql)), "%s must not change the schema", path)
+		}
+	}
+}
+
+// withoutComments drops the SQL line comments and blank lines.
+func withoutComments(sql string) string {
+	var lines []string
+	for _, line := range strings.Split(sql, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestMigrations_MakefileLoopCreatesTablesBeforeUsingThem(t *testing.T) {
+	created := map[string]bool{}
+	for _, m := range upMigrations(t) {
+		sql, err := os.ReadFile(m.path)
+		require.NoError(t, err)
+		for _, match := range createTable.FindAllStringSubmatch(string(sql), -1) {
+			created[strings.ToLower(match[1])] = true
+		}
+		for _, match := range usedTable.FindAllStringSubmatch(string(sql), -1) {
+			assert.True(t, created[strings.ToLower(match[1])],
+				"%s uses table %s before the makefile loop creates it", m.path, match[1])
+		}
+	}
+}